@@ -0,0 +1,36 @@
+package main
+
+import (
+	"time"
+
+	chain "github.com/wetee-dao/tee-dsecret/pkg/chains"
+	"github.com/wetee-dao/tee-dsecret/pkg/chains/mock"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+// startDevChain 使用内存中的主链，本节点是唯一的验证节点
+// DEV_BLOCK_TIME 出块间隔（秒），DEV_EPOCH_SOLT 每个 epoch 的区块数，
+// DEV_CHAIN_FAILURES 注入的主链故障，例如 "SignAndSubmit=0.2,GetEpoch=0.1"
+func startDevChain(nodePriv, p2pKey *model.PrivKey) error {
+	config := mock.DefaultConfig(&model.Validator{
+		NodeID:      0,
+		ValidatorId: *nodePriv.GetPublic(),
+		P2pId:       *p2pKey.GetPublic(),
+	})
+	config.BlockTime = time.Duration(util.GetEnvInt("DEV_BLOCK_TIME", 6)) * time.Second
+	config.EpochSolt = uint32(util.GetEnvInt("DEV_EPOCH_SOLT", 600))
+
+	devChain := mock.NewChain(nodePriv, config)
+	failures, err := mock.ParseFailures(util.GetEnv("DEV_CHAIN_FAILURES", ""))
+	if err != nil {
+		return err
+	}
+	for method, rate := range failures {
+		devChain.SetFailure(method, rate)
+	}
+
+	chain.MainChain = devChain
+	util.LogWithYellow("Main Chain", "dev mode, epoch solt", config.EpochSolt, "block time", config.BlockTime)
+	return nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+// 运维人员的份额恢复工具
+//
+//	recovery keygen
+//	recovery verify  -escrow escrow.json -request request.json [-code-signature <hex>] [-code-signer <hex>] [-allow-no-tee]
+//	recovery approve -escrow escrow.json -request request.json -key <hex> [-code-signature <hex>] [-code-signer <hex>] [-allow-no-tee]
+//
+// 默认使用托管文件中固定的代码度量，enclave 升级后使用 -code-signature/-code-signer 指定链上要求的版本
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "keygen":
+		keygen()
+	case "verify":
+		verify(os.Args[2:])
+	case "approve":
+		approve(os.Args[2:])
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Println("usage: recovery keygen | verify -escrow <file> -request <file> | approve -escrow <file> -request <file> -key <hex>")
+	os.Exit(1)
+}
+
+// keygen 生成运维人员的恢复密钥，私钥离线保存，SS58 地址配置到 SHARE_RECOVERY_OPERATORS
+func keygen() {
+	priv, pub, err := model.GenerateEd25519KeyPair(rand.Reader)
+	if err != nil {
+		fail(err)
+	}
+	fmt.Println("Operator:", pub.SS58())
+	fmt.Println("Key:", priv.String())
+}
+
+func verify(args []string) {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	escrowFile := fs.String("escrow", "", "share escrow json file")
+	requestFile := fs.String("request", "", "recovery request json file")
+	codeSignature := fs.String("code-signature", "", "hex encoded expected code measurement, default is the one pinned in escrow")
+	codeSigner := fs.String("code-signer", "", "hex encoded expected code signer, default is the one pinned in escrow")
+	allowNoTee := fs.Bool("allow-no-tee", false, "accept request issued without TEE, only for test")
+	fs.Parse(args)
+
+	escrow, req := load(*escrowFile, *requestFile)
+	pinCode(escrow, *codeSignature, *codeSigner)
+
+	// 批准前运维人员需要确认固定的度量是期望的版本
+	fmt.Println("Expected code:")
+	printJson(escrow.Code)
+	result, err := dkg.VerifyRecoveryRequest(escrow, req, *allowNoTee)
+	if err != nil {
+		fail(err)
+	}
+	fmt.Println("Request code:")
+	printJson(result)
+}
+
+func approve(args []string) {
+	fs := flag.NewFlagSet("approve", flag.ExitOnError)
+	escrowFile := fs.String("escrow", "", "share escrow json file")
+	requestFile := fs.String("request", "", "recovery request json file")
+	key := fs.String("key", "", "hex encoded operator recovery key")
+	codeSignature := fs.String("code-signature", "", "hex encoded expected code measurement, default is the one pinned in escrow")
+	codeSigner := fs.String("code-signer", "", "hex encoded expected code signer, default is the one pinned in escrow")
+	allowNoTee := fs.Bool("allow-no-tee", false, "accept request issued without TEE, only for test")
+	fs.Parse(args)
+
+	priv, err := model.PrivateKeyFromHex(*key)
+	if err != nil {
+		fail(err)
+	}
+	escrow, req := load(*escrowFile, *requestFile)
+	pinCode(escrow, *codeSignature, *codeSigner)
+	approval, err := dkg.ApproveRecovery(escrow, req, priv, *allowNoTee)
+	if err != nil {
+		fail(err)
+	}
+	printJson(approval)
+}
+
+func load(escrowFile, requestFile string) (*model.ShareEscrow, *model.RecoveryRequest) {
+	escrow := new(model.ShareEscrow)
+	readJson(escrowFile, escrow)
+	req := new(model.RecoveryRequest)
+	readJson(requestFile, req)
+	return escrow, req
+}
+
+// pinCode 使用命令行指定的代码度量替换托管文件中固定的度量
+func pinCode(escrow *model.ShareEscrow, codeSignature, codeSigner string) {
+	if codeSignature == "" && codeSigner == "" {
+		return
+	}
+	if escrow.Code == nil {
+		fail(errors.New("share escrow has no tee type, can not pin code"))
+	}
+
+	code := &model.TeeVerifyResult{TeeType: escrow.Code.TeeType}
+	var err error
+	if code.CodeSignature, err = hex.DecodeString(codeSignature); err != nil {
+		fail(fmt.Errorf("code-signature: %w", err))
+	}
+	if code.CodeSigner, err = hex.DecodeString(codeSigner); err != nil {
+		fail(fmt.Errorf("code-signer: %w", err))
+	}
+	escrow.Code = code
+}
+
+func readJson(file string, v any) {
+	bt, err := os.ReadFile(file)
+	if err != nil {
+		fail(err)
+	}
+	if err := json.Unmarshal(bt, v); err != nil {
+		fail(fmt.Errorf("%s: %w", file, err))
+	}
+}
+
+func printJson(v any) {
+	bt, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		fail(err)
+	}
+	fmt.Println(string(bt))
+}
+
+func fail(err error) {
+	fmt.Println("Error:", err)
+	os.Exit(1)
+}
@@ -39,6 +39,25 @@ func AuthCheck(ctx context.Context, obj interface{}, next graphql.Resolver) (res
 	return next(ctx)
 }
 
+// loginUser 获取 AuthMiddleware 解析的登录用户，未登录返回 nil
+func loginUser(ctx context.Context) *model.PublicUser {
+	user, _ := ctx.Value(loginStatCtxKey).(*model.PublicUser)
+	return user
+}
+
+// loginPubKey 获取登录用户的公钥
+func loginPubKey(ctx context.Context) (*model.PubKey, error) {
+	user := loginUser(ctx)
+	if user == nil {
+		return nil, gqlerror.Errorf("Please log in first.")
+	}
+	pub, err := model.PubKeyFromSS58(user.Address)
+	if err != nil {
+		return nil, gqlerror.Errorf("Decode address error:" + err.Error())
+	}
+	return pub, nil
+}
+
 // Middleware decodes the share session cookie and packs the session into context
 func AuthMiddleware() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -6,11 +6,18 @@ package graph
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
 	sidechain "github.com/wetee-dao/tee-dsecret/side-chain"
+	"go.dedis.ch/kyber/v4/suites"
 )
 
 // StartEpoch is the resolver for the start_epoch field.
@@ -24,6 +31,187 @@ func (r *mutationResolver) StartEpoch(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
+// SubmitEncryptedTx is the resolver for the submit_encrypted_tx field.
+func (r *mutationResolver) SubmitEncryptedTx(ctx context.Context, tx string) (bool, error) {
+	bt, err := hex.DecodeString(strings.TrimPrefix(tx, "0x"))
+	if err != nil {
+		return false, gqlerror.Errorf("Decode tx error:" + err.Error())
+	}
+	envelope := new(model.SecretStore)
+	if err := envelope.Unmarshal(bt); err != nil {
+		return false, gqlerror.Errorf("Unmarshal tx error:" + err.Error())
+	}
+	if err := model.ValidateInlineSecret(envelope, model.MaxEncryptedTxSize); err != nil {
+		return false, gqlerror.Errorf("Invalid tx:" + err.Error())
+	}
+	if err := proxy_reenc.VerifyLabel(suites.MustFind("Ed25519"), envelope, model.EncryptedTxLabel); err != nil {
+		return false, gqlerror.Errorf("VerifyLabel error:" + err.Error())
+	}
+
+	_, err = sidechain.SubmitTx(&model.Tx{
+		Payload: &model.Tx_EncryptedTx{EncryptedTx: envelope},
+	})
+	if err != nil {
+		return false, gqlerror.Errorf("SubmitTx error:" + err.Error())
+	}
+	return true, nil
+}
+
+// SetThresholdPolicy is the resolver for the set_threshold_policy field.
+func (r *mutationResolver) SetThresholdPolicy(ctx context.Context, tx string) (bool, error) {
+	bt, err := hex.DecodeString(strings.TrimPrefix(tx, "0x"))
+	if err != nil {
+		return false, gqlerror.Errorf("Decode tx error:" + err.Error())
+	}
+	ptx := new(model.Tx)
+	if err := ptx.Unmarshal(bt); err != nil {
+		return false, gqlerror.Errorf("Unmarshal tx error:" + err.Error())
+	}
+	if err := sidechain.VerifyThresholdPolicyTx(ptx); err != nil {
+		return false, gqlerror.Errorf("Invalid tx:" + err.Error())
+	}
+
+	_, err = sidechain.SubmitTx(ptx)
+	if err != nil {
+		return false, gqlerror.Errorf("SubmitTx error:" + err.Error())
+	}
+	return true, nil
+}
+
+// SetSecretSweep is the resolver for the set_secret_sweep field.
+func (r *mutationResolver) SetSecretSweep(ctx context.Context, tx string) (bool, error) {
+	bt, err := hex.DecodeString(strings.TrimPrefix(tx, "0x"))
+	if err != nil {
+		return false, gqlerror.Errorf("Decode tx error:" + err.Error())
+	}
+	ptx := new(model.Tx)
+	if err := ptx.Unmarshal(bt); err != nil {
+		return false, gqlerror.Errorf("Unmarshal tx error:" + err.Error())
+	}
+	if err := sidechain.VerifySecretSweepTx(ptx); err != nil {
+		return false, gqlerror.Errorf("Invalid tx:" + err.Error())
+	}
+
+	_, err = sidechain.SubmitTx(ptx)
+	if err != nil {
+		return false, gqlerror.Errorf("SubmitTx error:" + err.Error())
+	}
+	return true, nil
+}
+
+// StartShareRefresh is the resolver for the start_share_refresh field.
+func (r *mutationResolver) StartShareRefresh(ctx context.Context, tx string) (bool, error) {
+	bt, err := hex.DecodeString(strings.TrimPrefix(tx, "0x"))
+	if err != nil {
+		return false, gqlerror.Errorf("Decode tx error:" + err.Error())
+	}
+	ptx := new(model.Tx)
+	if err := ptx.Unmarshal(bt); err != nil {
+		return false, gqlerror.Errorf("Unmarshal tx error:" + err.Error())
+	}
+	if err := sidechain.VerifyShareRefreshStartTx(ptx); err != nil {
+		return false, gqlerror.Errorf("Invalid tx:" + err.Error())
+	}
+
+	_, err = sidechain.SubmitTx(ptx)
+	if err != nil {
+		return false, gqlerror.Errorf("SubmitTx error:" + err.Error())
+	}
+	return true, nil
+}
+
+// SetKeyGroup is the resolver for the set_key_group field.
+func (r *mutationResolver) SetKeyGroup(ctx context.Context, tx string) (bool, error) {
+	bt, err := hex.DecodeString(strings.TrimPrefix(tx, "0x"))
+	if err != nil {
+		return false, gqlerror.Errorf("Decode tx error:" + err.Error())
+	}
+	ptx := new(model.Tx)
+	if err := ptx.Unmarshal(bt); err != nil {
+		return false, gqlerror.Errorf("Unmarshal tx error:" + err.Error())
+	}
+	if err := sideChain.VerifyKeyGroupTx(ptx); err != nil {
+		return false, gqlerror.Errorf("Invalid tx:" + err.Error())
+	}
+
+	_, err = sidechain.SubmitTx(ptx)
+	if err != nil {
+		return false, gqlerror.Errorf("SubmitTx error:" + err.Error())
+	}
+	return true, nil
+}
+
+// AbortDkgRound is the resolver for the abort_dkg_round field.
+func (r *mutationResolver) AbortDkgRound(ctx context.Context, session string) (bool, error) {
+	pub, err := loginPubKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err := sideChain.AbortDkgRound(pub.Byte(), session); err != nil {
+		return false, gqlerror.Errorf("AbortDkgRound error:" + err.Error())
+	}
+	return true, nil
+}
+
+// RestartDkgRound is the resolver for the restart_dkg_round field.
+func (r *mutationResolver) RestartDkgRound(ctx context.Context, session string) (bool, error) {
+	pub, err := loginPubKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err := sideChain.RestartDkgRound(pub.Byte(), session); err != nil {
+		return false, gqlerror.Errorf("RestartDkgRound error:" + err.Error())
+	}
+	return true, nil
+}
+
+// ShareRecoveryRequest is the resolver for the share_recovery_request field.
+func (r *mutationResolver) ShareRecoveryRequest(ctx context.Context, escrow string) (string, error) {
+	pub, err := loginPubKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	e := new(model.ShareEscrow)
+	if err := json.Unmarshal([]byte(escrow), e); err != nil {
+		return "", gqlerror.Errorf("Unmarshal escrow error:" + err.Error())
+	}
+
+	req, err := sideChain.ShareRecoveryRequest(pub.Byte(), e)
+	if err != nil {
+		return "", gqlerror.Errorf("ShareRecoveryRequest error:" + err.Error())
+	}
+	bt, err := json.Marshal(req)
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// RecoverShare is the resolver for the recover_share field.
+func (r *mutationResolver) RecoverShare(ctx context.Context, escrow string, approvals []string) (bool, error) {
+	pub, err := loginPubKey(ctx)
+	if err != nil {
+		return false, err
+	}
+	e := new(model.ShareEscrow)
+	if err := json.Unmarshal([]byte(escrow), e); err != nil {
+		return false, gqlerror.Errorf("Unmarshal escrow error:" + err.Error())
+	}
+	list := make([]*model.RecoveryApproval, 0, len(approvals))
+	for _, a := range approvals {
+		approval := new(model.RecoveryApproval)
+		if err := json.Unmarshal([]byte(a), approval); err != nil {
+			return false, gqlerror.Errorf("Unmarshal approval error:" + err.Error())
+		}
+		list = append(list, approval)
+	}
+
+	if err := sideChain.RecoverShare(pub.Byte(), e, list); err != nil {
+		return false, gqlerror.Errorf("RecoverShare error:" + err.Error())
+	}
+	return true, nil
+}
+
 // Validators is the resolver for the validators field.
 func (r *queryResolver) Validators(ctx context.Context) ([]string, error) {
 	validators, _, err := sideChain.GetValidators()
@@ -39,6 +227,183 @@ func (r *queryResolver) Validators(ctx context.Context) ([]string, error) {
 	return list, nil
 }
 
+// Beacon is the resolver for the beacon field.
+func (r *queryResolver) Beacon(ctx context.Context, round *string) (string, error) {
+	var roundNum uint64
+	if round != nil && *round != "" {
+		n, err := strconv.ParseUint(*round, 10, 64)
+		if err != nil {
+			return "", gqlerror.Errorf("ParseUint error:" + err.Error())
+		}
+		roundNum = n
+	}
+
+	b, err := sidechain.GetBeacon(roundNum)
+	if err != nil {
+		return "", gqlerror.Errorf("GetBeacon error:" + err.Error())
+	}
+	if b == nil {
+		return "", gqlerror.Errorf("Beacon not found")
+	}
+
+	bt, err := json.Marshal(newBeaconView(b))
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// ThresholdPolicy is the resolver for the threshold_policy field.
+func (r *queryResolver) ThresholdPolicy(ctx context.Context) (string, error) {
+	current, n, threshold, err := sideChain.CurrentThresholdPolicy()
+	if err != nil {
+		return "", gqlerror.Errorf("CurrentThresholdPolicy error:" + err.Error())
+	}
+
+	next := sidechain.GetThresholdPolicy()
+	bt, err := json.Marshal(map[string]any{
+		"current": newThresholdPolicyView(current, n, threshold),
+		"next":    newThresholdPolicyView(next, 0, 0),
+	})
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// SecretSweep is the resolver for the secret_sweep field.
+func (r *queryResolver) SecretSweep(ctx context.Context) (string, error) {
+	cfg := sidechain.GetSecretSweepConfig()
+	bt, err := json.Marshal(cfg)
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// DkgRound is the resolver for the dkg_round field.
+func (r *queryResolver) DkgRound(ctx context.Context) (string, error) {
+	round, err := sideChain.DkgRound()
+	if err != nil {
+		return "", gqlerror.Errorf("DkgRound error:" + err.Error())
+	}
+
+	bt, err := json.Marshal(newDkgRoundView(round))
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// ShareRefresh is the resolver for the share_refresh field.
+func (r *queryResolver) ShareRefresh(ctx context.Context) (string, error) {
+	latest, pending, err := sidechain.GetShareRefresh()
+	if err != nil {
+		return "", gqlerror.Errorf("GetShareRefresh error:" + err.Error())
+	}
+
+	bt, err := json.Marshal(map[string]any{
+		"latest":  newShareRefreshView(latest),
+		"pending": newShareRefreshView(pending),
+	})
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// KeyGroups is the resolver for the key_groups field.
+func (r *queryResolver) KeyGroups(ctx context.Context) (string, error) {
+	groups, err := sidechain.GetKeyGroups()
+	if err != nil {
+		return "", gqlerror.Errorf("GetKeyGroups error:" + err.Error())
+	}
+
+	list := make([]*keyGroupView, 0, len(groups))
+	for _, g := range groups {
+		list = append(list, newKeyGroupView(g))
+	}
+	bt, err := json.Marshal(list)
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// DkgKeys is the resolver for the dkg_keys field.
+func (r *queryResolver) DkgKeys(ctx context.Context) (string, error) {
+	pub, err := sidechain.GetDkgPubkey()
+	if err != nil {
+		return "", gqlerror.Errorf("GetDkgPubkey error:" + err.Error())
+	}
+	keys := map[string]*dkgKeyView{
+		"sign": {
+			PubKey: fmt.Sprintf("0x%x", pub.ToBytes()),
+			Epoch:  sideChain.GetEpoch(),
+		},
+		"encrypt": nil,
+	}
+
+	g, err := sidechain.GetKeyGroup(sidechain.EncryptKeyGroup)
+	if err != nil {
+		return "", gqlerror.Errorf("GetKeyGroup error:" + err.Error())
+	}
+	if g != nil && len(g.DkgPub) > 0 {
+		keys["encrypt"] = &dkgKeyView{
+			PubKey:  fmt.Sprintf("0x%x", g.DkgPub),
+			Epoch:   g.Epoch,
+			Pending: g.Pending,
+		}
+	}
+
+	bt, err := json.Marshal(keys)
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// HubSync is the resolver for the hub_sync field.
+func (r *queryResolver) HubSync(ctx context.Context) (string, error) {
+	state, err := sidechain.GetHubSyncState()
+	if err != nil {
+		return "", gqlerror.Errorf("GetHubSyncState error:" + err.Error())
+	}
+	stuck, err := sidechain.GetStuckTxs()
+	if err != nil {
+		return "", gqlerror.Errorf("GetStuckTxs error:" + err.Error())
+	}
+
+	bt, err := json.Marshal(map[string]any{
+		"going":     state.Going,
+		"done":      state.Done,
+		"last_sync": state.LastSync,
+		"stuck":     stuck,
+	})
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// ShareEscrow is the resolver for the share_escrow field.
+func (r *queryResolver) ShareEscrow(ctx context.Context) (string, error) {
+	pub, err := loginPubKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	escrow, err := sideChain.ShareEscrow(pub.Byte())
+	if err != nil {
+		return "", gqlerror.Errorf("ShareEscrow error:" + err.Error())
+	}
+
+	bt, err := json.Marshal(escrow)
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -0,0 +1,156 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.74
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+	sidechain "github.com/wetee-dao/tee-dsecret/side-chain"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+// SealDisclosure is the resolver for the seal_disclosure field.
+func (r *mutationResolver) SealDisclosure(ctx context.Context, owner string, index string, secret string, daoProposal *int, height *string, ownerRelease *bool, signTime string, signature string) (bool, error) {
+	ownerKey, err := DecodeCaller(owner)
+	if err != nil {
+		return false, gqlerror.Errorf("DecodeCaller error:" + err.Error())
+	}
+	indexNum, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return false, gqlerror.Errorf("ParseUint error:" + err.Error())
+	}
+	secretBt, err := hex.DecodeString(strings.TrimPrefix(secret, "0x"))
+	if err != nil {
+		return false, gqlerror.Errorf("Decode secret error:" + err.Error())
+	}
+	store := new(model.SecretStore)
+	if err := store.Unmarshal(secretBt); err != nil {
+		return false, gqlerror.Errorf("Unmarshal secret error:" + err.Error())
+	}
+	cond, err := parseDisclosureCondition(daoProposal, height, ownerRelease)
+	if err != nil {
+		return false, gqlerror.Errorf("ParseCondition error:" + err.Error())
+	}
+	signTimeNum, err := parseSignTime(signTime)
+	if err != nil {
+		return false, gqlerror.Errorf("ParseSignTime error:" + err.Error())
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return false, gqlerror.Errorf("Decode signature error:" + err.Error())
+	}
+
+	// 验证 owner 签名
+	seal := &model.SealDisclosure{
+		Owner:     ownerKey,
+		Index:     indexNum,
+		Secret:    store,
+		Condition: cond,
+		Time:      signTimeNum,
+		Signature: sig,
+	}
+	err = seal.VerifyOwner()
+	if err != nil {
+		return false, gqlerror.Errorf("VerifyOwner error:" + err.Error())
+	}
+	err = proxy_reenc.VerifyLabel(suites.MustFind("Ed25519"), store, model.DisclosureLabel(seal.OwnerH160(), indexNum))
+	if err != nil {
+		return false, gqlerror.Errorf("VerifyLabel error:" + err.Error())
+	}
+
+	// send seal call to side chain
+	err = submitSideCall(&model.TeeCall{Tx: &model.TeeCall_SealDisclosure{SealDisclosure: seal}})
+	if err != nil {
+		return false, gqlerror.Errorf(err.Error())
+	}
+
+	return true, nil
+}
+
+// ReleaseDisclosure is the resolver for the release_disclosure field.
+func (r *mutationResolver) ReleaseDisclosure(ctx context.Context, owner string, index string, signTime string, signature string) (bool, error) {
+	ownerKey, err := DecodeCaller(owner)
+	if err != nil {
+		return false, gqlerror.Errorf("DecodeCaller error:" + err.Error())
+	}
+	indexNum, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return false, gqlerror.Errorf("ParseUint error:" + err.Error())
+	}
+	signTimeNum, err := parseSignTime(signTime)
+	if err != nil {
+		return false, gqlerror.Errorf("ParseSignTime error:" + err.Error())
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return false, gqlerror.Errorf("Decode signature error:" + err.Error())
+	}
+
+	// 验证 owner 签名
+	release := &model.ReleaseDisclosure{
+		Owner:     ownerKey,
+		Index:     indexNum,
+		Time:      signTimeNum,
+		Signature: sig,
+	}
+	err = release.VerifyOwner()
+	if err != nil {
+		return false, gqlerror.Errorf("VerifyOwner error:" + err.Error())
+	}
+
+	// send release call to side chain
+	err = submitSideCall(&model.TeeCall{Tx: &model.TeeCall_ReleaseDisclosure{ReleaseDisclosure: release}})
+	if err != nil {
+		return false, gqlerror.Errorf(err.Error())
+	}
+
+	return true, nil
+}
+
+// Disclosure is the resolver for the disclosure field.
+func (r *queryResolver) Disclosure(ctx context.Context, owner string, index string) (string, error) {
+	ownerAddr, err := decodeGrantee(owner)
+	if err != nil {
+		return "", gqlerror.Errorf("Decode owner error:" + err.Error())
+	}
+	indexNum, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return "", gqlerror.Errorf("ParseUint error:" + err.Error())
+	}
+
+	d, err := sidechain.GetDisclosure(ownerAddr, indexNum)
+	if err != nil {
+		return "", gqlerror.Errorf("GetDisclosure error:" + err.Error())
+	}
+	if d == nil {
+		return "", gqlerror.Errorf("Disclosure not found")
+	}
+
+	bt, err := json.Marshal(newDisclosureView(d))
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// DkgPubKey is the resolver for the dkg_pub_key field.
+func (r *queryResolver) DkgPubKey(ctx context.Context) (string, error) {
+	g, err := sidechain.GetKeyGroup(sidechain.EncryptKeyGroup)
+	if err != nil {
+		return "", gqlerror.Errorf("GetKeyGroup error:" + err.Error())
+	}
+	if g == nil || len(g.DkgPub) == 0 {
+		return "", gqlerror.Errorf("encryption key is not ready")
+	}
+	return fmt.Sprintf("0x%x", g.DkgPub), nil
+}
@@ -3,10 +3,24 @@ package graph
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+	sidechain "github.com/wetee-dao/tee-dsecret/side-chain"
 )
 
+// 授权签名时间允许的最大偏差（秒）
+const grantSignTimeSkew = 300
+
 // rsaDecryptWithKey 直接使用 *rsa.PrivateKey 解密数据
 // 参数:
 //
@@ -37,3 +51,328 @@ func rsaDecryptWithKey(privateKey *rsa.PrivateKey, encryptedBase64 string) ([]by
 
 	return plaintext, nil
 }
+
+// rsaPublicKeyBytes 返回 RSA 公钥的 PKCS#1 DER 编码，secret_rsa 和 tee_report 使用同一编码
+func rsaPublicKeyBytes(privateKey *rsa.PrivateKey) []byte {
+	return x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)
+}
+
+// openUploadPayload 使用 rsa 解密得到的 data key 解密上传的大文件 secret
+func openUploadPayload(dataKey []byte, payload string) ([]byte, error) {
+	if len(dataKey) != proxy_reenc.DataKeySize {
+		return nil, fmt.Errorf("data key must be %d bytes", proxy_reenc.DataKeySize)
+	}
+	bt, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("Base64 decode error: %v", err)
+	}
+	return proxy_reenc.OpenPayload(dataKey, bt)
+}
+
+// parseSecretWindow 解析 secret 的有效期，都未设置时返回 nil
+func parseSecretWindow(notBefore, notAfter *string, byHeight *bool) (*model.SecretWindow, error) {
+	window := &model.SecretWindow{}
+	if notBefore != nil && *notBefore != "" {
+		v, err := strconv.ParseUint(*notBefore, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("not_before: %w", err)
+		}
+		window.NotBefore = v
+	}
+	if notAfter != nil && *notAfter != "" {
+		v, err := strconv.ParseUint(*notAfter, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("not_after: %w", err)
+		}
+		window.NotAfter = v
+	}
+	if window.NotBefore == 0 && window.NotAfter == 0 {
+		return nil, nil
+	}
+	window.ByHeight = byHeight != nil && *byHeight
+
+	return window, window.Validate()
+}
+
+// keyGroupID 可选的密钥组，未设置时使用全网的 DKG 密钥
+func keyGroupID(group *string) string {
+	if group == nil {
+		return ""
+	}
+	return *group
+}
+
+// encodeKeyPublic x509 返回 PEM 编码的 CSR，其他类型返回 hex 公钥
+func encodeKeyPublic(key *model.KeyMaterial) string {
+	if key.Type == model.KeyTypeX509 {
+		return string(key.Public)
+	}
+	if len(key.Public) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("0x%x", key.Public)
+}
+
+// decodeGrantee 支持 20 字节 H160 hex（可带 0x 前缀）或 SS58
+func decodeGrantee(s string) (types.H160, error) {
+	if b, err := hex.DecodeString(strings.TrimPrefix(s, "0x")); err == nil && len(b) == 20 {
+		return types.H160(b), nil
+	}
+	pub, err := model.PubKeyFromSS58(s)
+	if err != nil {
+		return types.H160{}, fmt.Errorf("grantee 需为 20 字节 hex 或 SS58: %w", err)
+	}
+	return pub.H160Address(), nil
+}
+
+// parseSignTime 解析签名时间并检查与本地时间的偏差，防止旧签名被重放
+func parseSignTime(s string) (uint64, error) {
+	t, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now().Unix()
+	if int64(t) < now-grantSignTimeSkew || int64(t) > now+grantSignTimeSkew {
+		return 0, fmt.Errorf("sign time out of range")
+	}
+	return t, nil
+}
+
+// submitSideCall 签发 TEE report 并提交调用到侧链
+func submitSideCall(call *model.TeeCall) error {
+	err := model.IssueReport(sideChain.GetDKG().Signer.ToSigner(), call)
+	if err != nil {
+		return fmt.Errorf("GetReport error: %w", err)
+	}
+
+	_, err = sidechain.SubmitTx(&model.Tx{
+		Payload: &model.Tx_HubCall{
+			HubCall: &model.HubCall{Call: []*model.TeeCall{call}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("SubmitTx error: %w", err)
+	}
+	return nil
+}
+
+// decodeTeeCall 解析 hex 编码的 TeeCall
+func decodeTeeCall(s string) (*model.TeeCall, error) {
+	bt, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	call := new(model.TeeCall)
+	if err := call.Unmarshal(bt); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+// parseDisclosureCondition 条件需且仅需设置一个
+func parseDisclosureCondition(daoProposal *int, height *string, ownerRelease *bool) (*model.DisclosureCondition, error) {
+	conds := make([]*model.DisclosureCondition, 0, 1)
+	if daoProposal != nil {
+		if *daoProposal < 0 {
+			return nil, fmt.Errorf("invalid dao proposal")
+		}
+		conds = append(conds, &model.DisclosureCondition{Kind: &model.DisclosureCondition_DaoProposal{DaoProposal: uint32(*daoProposal)}})
+	}
+	if height != nil && *height != "" {
+		h, err := strconv.ParseUint(*height, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, &model.DisclosureCondition{Kind: &model.DisclosureCondition_Height{Height: h}})
+	}
+	if ownerRelease != nil && *ownerRelease {
+		conds = append(conds, &model.DisclosureCondition{Kind: &model.DisclosureCondition_OwnerRelease{OwnerRelease: true}})
+	}
+	if len(conds) != 1 {
+		return nil, fmt.Errorf("exactly one of dao_proposal, height and owner_release is required")
+	}
+	return conds[0], nil
+}
+
+// disclosureView 条件解密的 JSON 结构
+type disclosureView struct {
+	Status          string  `json:"status"`
+	DaoProposal     *uint32 `json:"dao_proposal,omitempty"`
+	Height          *uint64 `json:"height,omitempty"`
+	OwnerRelease    bool    `json:"owner_release,omitempty"`
+	ReadyHeight     int64   `json:"ready_height,omitempty"`
+	DisclosedHeight int64   `json:"disclosed_height,omitempty"`
+	Plaintext       string  `json:"plaintext,omitempty"`
+	Error           string  `json:"error,omitempty"`
+}
+
+func newDisclosureView(d *model.Disclosure) *disclosureView {
+	view := &disclosureView{
+		ReadyHeight:     d.ReadyHeight,
+		DisclosedHeight: d.DisclosedHeight,
+		Error:           d.Error,
+	}
+	switch d.Status {
+	case model.DisclosurePending:
+		view.Status = "pending"
+	case model.DisclosureReady:
+		view.Status = "ready"
+	case model.DisclosureDisclosed:
+		view.Status = "disclosed"
+	}
+	switch c := d.Seal.GetCondition().GetKind().(type) {
+	case *model.DisclosureCondition_DaoProposal:
+		view.DaoProposal = &c.DaoProposal
+	case *model.DisclosureCondition_Height:
+		view.Height = &c.Height
+	case *model.DisclosureCondition_OwnerRelease:
+		view.OwnerRelease = c.OwnerRelease
+	}
+	if len(d.Plaintext) > 0 {
+		view.Plaintext = fmt.Sprintf("0x%x", d.Plaintext)
+	}
+	return view
+}
+
+// beaconView 随机数信标的 JSON 结构
+type beaconView struct {
+	Round     uint64 `json:"round"`
+	Height    int64  `json:"height"`
+	Finalized int64  `json:"finalized"`
+	Prev      string `json:"prev,omitempty"`
+	Value     string `json:"value"`
+	Epoch     uint32 `json:"epoch"`
+}
+
+func newBeaconView(b *model.Beacon) *beaconView {
+	view := &beaconView{
+		Round:     b.Round,
+		Height:    b.Height,
+		Finalized: b.Finalized,
+		Value:     fmt.Sprintf("0x%x", b.Value),
+		Epoch:     b.Epoch,
+	}
+	if len(b.Prev) > 0 {
+		view.Prev = fmt.Sprintf("0x%x", b.Prev)
+	}
+	return view
+}
+
+// thresholdPolicyView 门限策略的 JSON 结构
+type thresholdPolicyView struct {
+	Num        uint32 `json:"num"`
+	Den        uint32 `json:"den"`
+	QuorumNum  uint32 `json:"quorum_num"`
+	QuorumDen  uint32 `json:"quorum_den"`
+	Validators int    `json:"validators,omitempty"`
+	Threshold  int    `json:"threshold,omitempty"`
+}
+
+func newThresholdPolicyView(p model.ThresholdPolicy, validators, threshold int) *thresholdPolicyView {
+	return &thresholdPolicyView{
+		Num:        p.Num,
+		Den:        p.Den,
+		QuorumNum:  p.QuorumNum,
+		QuorumDen:  p.QuorumDen,
+		Validators: validators,
+		Threshold:  threshold,
+	}
+}
+
+// shareRefreshView 份额刷新的 JSON 结构
+type shareRefreshView struct {
+	Epoch     uint32 `json:"epoch"`
+	Refresh   uint64 `json:"refresh"`
+	Height    int64  `json:"height"`
+	Finalized int64  `json:"finalized,omitempty"`
+	Attempt   uint32 `json:"attempt"`
+}
+
+func newShareRefreshView(r *model.ShareRefresh) *shareRefreshView {
+	if r == nil {
+		return nil
+	}
+	return &shareRefreshView{
+		Epoch:     r.Epoch,
+		Refresh:   r.Refresh,
+		Height:    r.Height,
+		Finalized: r.Finalized,
+		Attempt:   r.Attempt,
+	}
+}
+
+// keyGroupView 密钥组的 JSON 结构
+type keyGroupView struct {
+	Id        string   `json:"id"`
+	Members   []string `json:"members"`
+	RegionId  uint32   `json:"region_id"`
+	Threshold int      `json:"threshold"`
+	Epoch     uint32   `json:"epoch"`
+	DkgPub    string   `json:"dkg_pub,omitempty"`
+	Pending   uint32   `json:"pending,omitempty"`
+	Attempt   uint32   `json:"attempt,omitempty"`
+}
+
+func newKeyGroupView(g *model.KeyGroup) *keyGroupView {
+	members := make([]string, 0, len(g.Members))
+	for _, m := range g.Members {
+		members = append(members, model.PubKeyFromByte(m).SS58())
+	}
+	policy := model.DefaultThresholdPolicy()
+	if g.Policy != nil {
+		policy = *g.Policy
+	}
+
+	v := &keyGroupView{
+		Id:        g.Id,
+		Members:   members,
+		RegionId:  g.RegionId,
+		Threshold: policy.Threshold(len(members)),
+		Epoch:     g.Epoch,
+		Pending:   g.Pending,
+		Attempt:   g.Attempt,
+	}
+	if len(g.DkgPub) > 0 {
+		v.DkgPub = model.PubKeyFromByte(g.DkgPub).SS58()
+	}
+	return v
+}
+
+// dkgKeyView DKG 密钥的 JSON 结构
+type dkgKeyView struct {
+	PubKey  string `json:"pub_key"`
+	Epoch   uint32 `json:"epoch"`
+	Pending uint32 `json:"pending,omitempty"`
+}
+
+// dkgRoundView DKG 轮次状态的 JSON 结构
+type dkgRoundView struct {
+	SessionId      string `json:"session_id"`
+	Phase          string `json:"phase"`
+	Epoch          uint32 `json:"epoch"`
+	Refresh        uint64 `json:"refresh,omitempty"`
+	Sponsor        string `json:"sponsor"`
+	StartTime      int64  `json:"start_time"`
+	Deals          int    `json:"deals"`
+	Responses      int    `json:"responses"`
+	Justifications int    `json:"justifications"`
+	PartialSigs    int    `json:"partial_sigs"`
+}
+
+func newDkgRoundView(r *dkg.RoundInfo) *dkgRoundView {
+	if r == nil {
+		return nil
+	}
+	return &dkgRoundView{
+		SessionId:      r.SessionId,
+		Phase:          r.Phase,
+		Epoch:          r.Epoch,
+		Refresh:        r.Refresh,
+		Sponsor:        r.Sponsor,
+		StartTime:      r.StartTime,
+		Deals:          r.Deals,
+		Responses:      r.Responses,
+		Justifications: r.Justifications,
+		PartialSigs:    r.PartialSigs,
+	}
+}
@@ -6,12 +6,12 @@ package graph
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -21,7 +21,7 @@ import (
 )
 
 // UploadSecret is the resolver for the upload_secret field.
-func (r *mutationResolver) UploadSecret(ctx context.Context, index string, secret string, hash string, user string) (bool, error) {
+func (r *mutationResolver) UploadSecret(ctx context.Context, index string, secret string, hash string, user string, payload *string, notBefore *string, notAfter *string, byHeight *bool, group *string) (bool, error) {
 	pubkey, err := model.PubKeyFromSS58(user)
 	if err != nil {
 		return false, gqlerror.Errorf("PubKeyFromSS58 error:" + err.Error())
@@ -40,6 +40,14 @@ func (r *mutationResolver) UploadSecret(ctx context.Context, index string, secre
 		return false, gqlerror.Errorf("RsaDecryptWithKey error:" + err.Error())
 	}
 
+	// 大文件 secret 使用 data key 加密后上传
+	if payload != nil && *payload != "" {
+		msg, err = openUploadPayload(msg, *payload)
+		if err != nil {
+			return false, gqlerror.Errorf("OpenPayload error:" + err.Error())
+		}
+	}
+
 	h := blake2b.Sum256(msg)
 	hashStr := fmt.Sprintf("0x%x", h)
 	if hashStr != hash {
@@ -47,8 +55,13 @@ func (r *mutationResolver) UploadSecret(ctx context.Context, index string, secre
 		return false, gqlerror.Errorf("Hash not match")
 	}
 
+	window, err := parseSecretWindow(notBefore, notAfter, byHeight)
+	if err != nil {
+		return false, gqlerror.Errorf("Window error:" + err.Error())
+	}
+
 	// encrypt secret
-	encData, err := sideChain.Encrypt(msg)
+	encData, err := sideChain.Encrypt(msg, window, keyGroupID(group))
 	if err != nil {
 		return false, gqlerror.Errorf("EncryptSecret error:" + err.Error())
 	}
@@ -80,7 +93,7 @@ func (r *mutationResolver) UploadSecret(ctx context.Context, index string, secre
 }
 
 // InitDiskKey is the resolver for the init_disk_key field.
-func (r *mutationResolver) InitDiskKey(ctx context.Context, index string, user string) (bool, error) {
+func (r *mutationResolver) InitDiskKey(ctx context.Context, index string, user string, notBefore *string, notAfter *string, byHeight *bool, group *string) (bool, error) {
 	pubkey, err := model.PubKeyFromSS58(user)
 	if err != nil {
 		return false, gqlerror.Errorf("PubKeyFromSS58 error:" + err.Error())
@@ -93,15 +106,20 @@ func (r *mutationResolver) InitDiskKey(ctx context.Context, index string, user s
 		return false, gqlerror.Errorf("ParseUint error:" + err.Error())
 	}
 
-	// 创建一个 32 字节的切片
-	key := make([]byte, 32)
-	_, err = rand.Read(key)
+	window, err := parseSecretWindow(notBefore, notAfter, byHeight)
+	if err != nil {
+		return false, gqlerror.Errorf("Window error:" + err.Error())
+	}
+
+	// 在节点内生成 32 字节的对称密钥
+	material, err := model.GenerateKeyMaterial(model.KeyTypeSymmetric, "")
 	if err != nil {
 		return false, gqlerror.Errorf("生成随机数据失败: %v", err)
 	}
+	key := material.Private
 
 	// encrypt secret
-	encData, err := sideChain.Encrypt(key)
+	encData, err := sideChain.Encrypt(key, window, keyGroupID(group))
 	if err != nil {
 		return false, gqlerror.Errorf("EncryptSecret error:" + err.Error())
 	}
@@ -134,12 +152,221 @@ func (r *mutationResolver) InitDiskKey(ctx context.Context, index string, user s
 	return true, nil
 }
 
+// GenerateSecret is the resolver for the generate_secret field.
+func (r *mutationResolver) GenerateSecret(ctx context.Context, index string, user string, keyType string, subject *string, notBefore *string, notAfter *string, byHeight *bool, group *string) (string, error) {
+	pubkey, err := model.PubKeyFromSS58(user)
+	if err != nil {
+		return "", gqlerror.Errorf("PubKeyFromSS58 error:" + err.Error())
+	}
+	pubAddr := pubkey.H160Address()
+
+	// parse index
+	indexNum, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return "", gqlerror.Errorf("ParseUint error:" + err.Error())
+	}
+
+	window, err := parseSecretWindow(notBefore, notAfter, byHeight)
+	if err != nil {
+		return "", gqlerror.Errorf("Window error:" + err.Error())
+	}
+
+	// 不能覆盖已有的 secret，区块中也会检查
+	exists, err := sidechain.SecretExists(pubAddr, indexNum)
+	if err != nil {
+		return "", gqlerror.Errorf("SecretExists error:" + err.Error())
+	}
+	if exists {
+		return "", gqlerror.Errorf("secret index " + index + " already exists")
+	}
+
+	// 在节点内生成密钥，私钥不离开 TEE
+	csrSubject := ""
+	if subject != nil {
+		csrSubject = *subject
+	}
+	key, err := model.GenerateKeyMaterial(keyType, csrSubject)
+	if err != nil {
+		return "", gqlerror.Errorf("GenerateKeyMaterial error:" + err.Error())
+	}
+
+	// encrypt private key
+	encData, err := sideChain.Encrypt(key.Private, window, keyGroupID(group))
+	if err != nil {
+		return "", gqlerror.Errorf("EncryptSecret error:" + err.Error())
+	}
+
+	h := blake2b.Sum256(key.Private)
+
+	// 私钥和上传的 secret 一样保存
+	err = submitSideCall(&model.TeeCall{Tx: &model.TeeCall_UploadSecret{UploadSecret: &model.UploadSecret{
+		User:    pubAddr[:],
+		Index:   indexNum,
+		Data:    encData,
+		Hash:    h[:],
+		Time:    uint64(time.Now().Unix()),
+		KeyType: key.Type,
+	}}})
+	if err != nil {
+		return "", gqlerror.Errorf(err.Error())
+	}
+
+	bt, err := json.Marshal(map[string]string{
+		"type":   key.Type,
+		"public": encodeKeyPublic(key),
+		"hash":   fmt.Sprintf("0x%x", h),
+	})
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// GrantSecret is the resolver for the grant_secret field.
+func (r *mutationResolver) GrantSecret(ctx context.Context, owner string, index string, disk bool, grantee string, expire string, signTime string, signature string) (bool, error) {
+	ownerKey, err := DecodeCaller(owner)
+	if err != nil {
+		return false, gqlerror.Errorf("DecodeCaller error:" + err.Error())
+	}
+	granteeAddr, err := decodeGrantee(grantee)
+	if err != nil {
+		return false, gqlerror.Errorf("DecodeGrantee error:" + err.Error())
+	}
+	indexNum, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return false, gqlerror.Errorf("ParseUint error:" + err.Error())
+	}
+	expireNum, err := strconv.ParseUint(expire, 10, 64)
+	if err != nil {
+		return false, gqlerror.Errorf("ParseUint error:" + err.Error())
+	}
+	signTimeNum, err := parseSignTime(signTime)
+	if err != nil {
+		return false, gqlerror.Errorf("ParseSignTime error:" + err.Error())
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return false, gqlerror.Errorf("Decode signature error:" + err.Error())
+	}
+
+	// 验证 owner 签名
+	grant := &model.GrantSecret{
+		Owner:     ownerKey,
+		Index:     indexNum,
+		Disk:      disk,
+		Grantee:   granteeAddr[:],
+		Expire:    expireNum,
+		Time:      signTimeNum,
+		Signature: sig,
+	}
+	err = grant.VerifyOwner()
+	if err != nil {
+		return false, gqlerror.Errorf("VerifyOwner error:" + err.Error())
+	}
+
+	// send grant call to side chain
+	err = submitSideCall(&model.TeeCall{Tx: &model.TeeCall_GrantSecret{GrantSecret: grant}})
+	if err != nil {
+		return false, gqlerror.Errorf(err.Error())
+	}
+
+	return true, nil
+}
+
+// RevokeSecret is the resolver for the revoke_secret field.
+func (r *mutationResolver) RevokeSecret(ctx context.Context, owner string, index string, disk bool, grantee string, signTime string, signature string) (bool, error) {
+	ownerKey, err := DecodeCaller(owner)
+	if err != nil {
+		return false, gqlerror.Errorf("DecodeCaller error:" + err.Error())
+	}
+	granteeAddr, err := decodeGrantee(grantee)
+	if err != nil {
+		return false, gqlerror.Errorf("DecodeGrantee error:" + err.Error())
+	}
+	indexNum, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return false, gqlerror.Errorf("ParseUint error:" + err.Error())
+	}
+	signTimeNum, err := parseSignTime(signTime)
+	if err != nil {
+		return false, gqlerror.Errorf("ParseSignTime error:" + err.Error())
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return false, gqlerror.Errorf("Decode signature error:" + err.Error())
+	}
+
+	// 验证 owner 签名
+	revoke := &model.RevokeSecret{
+		Owner:     ownerKey,
+		Index:     indexNum,
+		Disk:      disk,
+		Grantee:   granteeAddr[:],
+		Time:      signTimeNum,
+		Signature: sig,
+	}
+	err = revoke.VerifyOwner()
+	if err != nil {
+		return false, gqlerror.Errorf("VerifyOwner error:" + err.Error())
+	}
+
+	// send revoke call to side chain
+	err = submitSideCall(&model.TeeCall{Tx: &model.TeeCall_RevokeSecret{RevokeSecret: revoke}})
+	if err != nil {
+		return false, gqlerror.Errorf(err.Error())
+	}
+
+	return true, nil
+}
+
+// ThresholdSign is the resolver for the threshold_sign field.
+func (r *mutationResolver) ThresholdSign(ctx context.Context, call string) (string, error) {
+	teeCall, err := decodeTeeCall(call)
+	if err != nil {
+		return "", gqlerror.Errorf("Decode call error:" + err.Error())
+	}
+
+	pub, sig, err := sideChain.ThresholdSign(ctx, teeCall)
+	if err != nil {
+		return "", gqlerror.Errorf("ThresholdSign error:" + err.Error())
+	}
+
+	bt, err := json.Marshal(map[string]string{
+		"public":    fmt.Sprintf("0x%x", pub),
+		"signature": fmt.Sprintf("0x%x", sig),
+	})
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
 // TeeReport is the resolver for the tee_report field.
 func (r *queryResolver) TeeReport(ctx context.Context, hash string) (string, error) {
+	// parse client nonce
+	nonce, err := hex.DecodeString(strings.TrimPrefix(hash, "0x"))
+	if err != nil {
+		return "", gqlerror.Errorf("Decode hash error:" + err.Error())
+	}
+	if len(nonce) == 0 || len(nonce) > 64 {
+		return "", gqlerror.Errorf("Hash length must be 1-64 bytes")
+	}
+
+	// 签发绑定 RSA 公钥哈希和 nonce 的 TEE report
+	pubKey := rsaPublicKeyBytes(rsaKey)
+	call, err := model.IssueKeyReport(sideChain.GetDKG().Signer.ToSigner(), pubKey, nonce)
+	if err != nil {
+		return "", gqlerror.Errorf("IssueKeyReport error:" + err.Error())
+	}
+	report, err := call.Marshal()
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal report:" + err.Error())
+	}
+
 	// 组合报告
-	result := map[string]any{
-		"param":  "",
-		"report": "",
+	result := model.TeeKeyReport{
+		Param:  fmt.Sprintf("0x%x", model.KeyReportData(pubKey, nonce)),
+		Report: report,
 	}
 	bt, err := json.Marshal(result)
 	if err != nil {
@@ -150,14 +377,72 @@ func (r *queryResolver) TeeReport(ctx context.Context, hash string) (string, err
 
 // SecretRsa is the resolver for the secret_rsa field.
 func (r *queryResolver) SecretRsa(ctx context.Context) (string, error) {
-	publicKey := &rsaKey.PublicKey
-
-	publicKeyBytes := x509.MarshalPKCS1PublicKey(publicKey)
 	publicBlock := &pem.Block{
 		Type:  "RSA PUBLIC KEY",
-		Bytes: publicKeyBytes,
+		Bytes: rsaPublicKeyBytes(rsaKey),
 	}
 
 	bt := pem.EncodeToMemory(publicBlock)
 	return string(bt), nil
 }
+
+// ReencryptMetrics is the resolver for the reencrypt_metrics field.
+func (r *queryResolver) ReencryptMetrics(ctx context.Context) (string, error) {
+	bt, err := json.Marshal(sideChain.ReencryptMetrics())
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// SecretAudits is the resolver for the secret_audits field.
+func (r *queryResolver) SecretAudits(ctx context.Context, cursor *string, size int) (string, error) {
+	user := loginUser(ctx)
+	if user == nil {
+		return "", gqlerror.Errorf("Please log in first.")
+	}
+	pub, err := model.PubKeyFromSS58(user.Address)
+	if err != nil {
+		return "", gqlerror.Errorf("Decode address error:" + err.Error())
+	}
+	if size <= 0 || size > 100 {
+		return "", gqlerror.Errorf("Size must be 1-100")
+	}
+
+	var cursorBt []byte
+	if cursor != nil && *cursor != "" {
+		cursorBt, err = hex.DecodeString(*cursor)
+		if err != nil {
+			return "", gqlerror.Errorf("Decode cursor error:" + err.Error())
+		}
+	}
+
+	// 只能查询自己 namespace 的审计记录
+	list, next, err := sidechain.GetAuditLogs(pub.H160Address(), cursorBt, size)
+	if err != nil {
+		return "", gqlerror.Errorf("GetAuditLogs error:" + err.Error())
+	}
+
+	bt, err := json.Marshal(map[string]any{
+		"list":   list,
+		"cursor": hex.EncodeToString(next),
+	})
+	if err != nil {
+		return "", gqlerror.Errorf("Marshal:" + err.Error())
+	}
+	return string(bt), nil
+}
+
+// AppSignKey is the resolver for the app_sign_key field.
+func (r *queryResolver) AppSignKey(ctx context.Context, call string) (string, error) {
+	teeCall, err := decodeTeeCall(call)
+	if err != nil {
+		return "", gqlerror.Errorf("Decode call error:" + err.Error())
+	}
+
+	pub, err := sideChain.AppSignKey(teeCall)
+	if err != nil {
+		return "", gqlerror.Errorf("AppSignKey error:" + err.Error())
+	}
+	return fmt.Sprintf("0x%x", pub), nil
+}
@@ -1,9 +1,17 @@
 package graph
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/json"
+	"os"
 	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	sidechain "github.com/wetee-dao/tee-dsecret/side-chain"
 )
 
 func TestDecryptSecret(t *testing.T) {
@@ -28,3 +36,44 @@ func TestDecryptSecret(t *testing.T) {
 		t.Errorf("解密结果错误: 期望 %s, 实际 %s", expected, string(plaintext))
 	}
 }
+
+func TestSecretAuditsOwnerOnly(t *testing.T) {
+	os.RemoveAll("./chain_data")
+	db, err := model.NewDB()
+	require.NoError(t, err)
+	defer func() {
+		db.Close()
+		os.RemoveAll("./chain_data")
+	}()
+
+	owner, ownerPub, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	_, otherPub, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	ns := owner.GetPublic().H160Address()
+	otherNs := otherPub.H160Address()
+
+	txn := model.DBINS.NewTransaction()
+	_, err = (&sidechain.SideChain{}).SaveAuditLog(&model.AuditLog{Records: []*model.SecretAudit{
+		{ReqId: "owner", NameSpace: ns[:]},
+		{ReqId: "other", NameSpace: otherNs[:]},
+	}}, 1, txn)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+
+	// 未登录不能查询
+	r := &queryResolver{&Resolver{}}
+	_, err = r.SecretAudits(context.Background(), nil, 10)
+	require.Error(t, err)
+
+	// 只返回登录用户 namespace 的记录
+	ctx := context.WithValue(context.Background(), loginStatCtxKey, &model.PublicUser{Address: ownerPub.SS58()})
+	result, err := r.SecretAudits(ctx, nil, 10)
+	require.NoError(t, err)
+	var page struct {
+		List []*model.SecretAudit `json:"list"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(result), &page))
+	require.Len(t, page.List, 1)
+	require.Equal(t, "owner", page.List[0].ReqId)
+}
@@ -0,0 +1,27 @@
+# 本地开发：使用内存中的主链启动单节点，不需要 Substrate 节点和 ink 合约
+# get shell path
+SOURCE="$0"
+while [ -h "$SOURCE"  ]; do
+    DIR="$( cd -P "$( dirname "$SOURCE"  )" && pwd  )"
+    SOURCE="$(readlink "$SOURCE")"
+    [[ $SOURCE != /*  ]] && SOURCE="$DIR/$SOURCE"
+done
+DIR="$( cd -P "$( dirname "$SOURCE"  )" && pwd  )"
+
+mkdir -p $DIR/dev
+cd $DIR/dev
+
+# 生成单节点的创世区块和节点密钥
+if [ ! -f ./chain_data/config/priv_validator_key.json ]; then
+    cometbft init --home ./chain_data
+fi
+
+export SIDE_CHAIN_PORT=61001
+export GQL_PORT=61000
+export DEV_BLOCK_TIME=1
+export DEV_EPOCH_SOLT=60
+export SIDE_CHAIN_EMPTY_BLOCK_INTERVAL=5
+# export DEV_CHAIN_FAILURES=SignAndSubmit=0.2
+
+go build -o dsecret ../../
+./dsecret dev
@@ -57,8 +57,14 @@ func main() {
 	util.LogWithYellow("Mainchain Key", nodePriv.GetPublic().SS58())
 	util.LogWithYellow("P2P Key", p2pKey.GetPublic().SS58())
 
-	// Link to polkadot
-	_, err = chain.ConnectMainChain(chainAddr, nodePriv)
+	// dev 模式使用内存中的主链，不需要 Substrate 节点和 ink 合约
+	if len(os.Args) > 1 && os.Args[1] == "dev" {
+		chainAddr = []string{"mock"}
+		err = startDevChain(nodePriv, p2pKey)
+	} else {
+		// Link to polkadot
+		_, err = chain.ConnectMainChain(chainAddr, nodePriv)
+	}
 	if err != nil {
 		fmt.Println("Connect to chain error:", err)
 		os.Exit(1)
@@ -83,23 +89,49 @@ func main() {
 		node.Wait()
 	}()
 
-	dkgIns, err := dkg.NewDKG(nodePriv, dkgReactor, dkg.Logger{
+	// 全网的 DKG 和密钥组共用 dkg 通道
+	groupMux := dkg.NewGroupMux(dkgReactor)
+	dkgIns, err := dkg.NewDKG(nodePriv, groupMux.Peer(""), dkg.Logger{
 		NodeTag: "DKG",
 	})
 	if err != nil {
 		fmt.Println("Create DKG error:", err)
 		os.Exit(1)
 	}
+
+	// 可选开启份额恢复托管，SHARE_RECOVERY_OPERATORS 为逗号分隔的运维人员 SS58 地址
+	if operators := util.GetEnv("SHARE_RECOVERY_OPERATORS", ""); operators != "" {
+		pubs := make([]*model.PubKey, 0)
+		for _, ss58 := range strings.Split(operators, ",") {
+			pub, err := model.PubKeyFromSS58(strings.TrimSpace(ss58))
+			if err != nil {
+				fmt.Println("Share recovery operator error:", err)
+				os.Exit(1)
+			}
+			pubs = append(pubs, pub)
+		}
+		threshold := util.GetEnvInt("SHARE_RECOVERY_THRESHOLD", len(pubs)/2+1)
+		if err := dkgIns.SetRecovery(pubs, threshold); err != nil {
+			fmt.Println("Share recovery error:", err)
+			os.Exit(1)
+		}
+	}
+
+	// Set DKG to sideChain before start, resumed DKG round needs the callbacks
+	sideChain.SetDKG(dkgIns)
 	go dkgIns.Start()
 	defer dkgIns.Stop()
 
+	// 启动本节点所在的密钥组
+	if err := sideChain.SetKeyGroups(groupMux); err != nil {
+		fmt.Println("Start key groups error:", err)
+		os.Exit(1)
+	}
+
 	if dkgIns.DkgPubKey != nil {
 		util.LogWithYellow("DKG PubKey", dkgIns.DkgPubKey.SS58())
 	}
 
-	// Set DKG to sideChain
-	sideChain.SetDKG(dkgIns)
-
 	// load chains
 	err = sideChain.LoadChains()
 	if err != nil {
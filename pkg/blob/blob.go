@@ -0,0 +1,88 @@
+// Package blob 节点本地的内容寻址 blob 储存，用于保存加密后的 secret payload
+package blob
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+// ErrNotFound blob 不存在
+var ErrNotFound = errors.New("blob not found")
+
+// Backend blob 储存后端
+type Backend interface {
+	Put(c cid.Cid, data []byte) error
+	Get(c cid.Cid) ([]byte, error)
+	Has(c cid.Cid) (bool, error)
+	Delete(c cid.Cid) error
+}
+
+// Store 内容寻址储存，读写时校验数据与 CID 一致
+type Store struct {
+	backend Backend
+}
+
+func NewStore(backend Backend) *Store {
+	return &Store{backend: backend}
+}
+
+// Put 保存数据并返回数据的 CID
+func (s *Store) Put(data []byte) (cid.Cid, error) {
+	c, err := model.CidFromBytes(data)
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	has, err := s.backend.Has(c)
+	if err != nil {
+		return cid.Undef, err
+	}
+	if has {
+		return c, nil
+	}
+
+	return c, s.backend.Put(c, data)
+}
+
+// PutWithCid 保存从其他节点获取的数据，数据必须与 CID 一致
+func (s *Store) PutWithCid(c cid.Cid, data []byte) error {
+	if err := Verify(c, data); err != nil {
+		return err
+	}
+	return s.backend.Put(c, data)
+}
+
+// Get 获取数据，数据被篡改时返回错误
+func (s *Store) Get(c cid.Cid) ([]byte, error) {
+	data, err := s.backend.Get(c)
+	if err != nil {
+		return nil, err
+	}
+	if err := Verify(c, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (s *Store) Has(c cid.Cid) (bool, error) {
+	return s.backend.Has(c)
+}
+
+func (s *Store) Delete(c cid.Cid) error {
+	return s.backend.Delete(c)
+}
+
+// Verify 校验数据与 CID 一致
+func Verify(c cid.Cid, data []byte) error {
+	dc, err := model.CidFromBytes(data)
+	if err != nil {
+		return err
+	}
+	if !dc.Equals(c) {
+		return fmt.Errorf("blob cid mismatch: want %s, got %s", c, dc)
+	}
+	return nil
+}
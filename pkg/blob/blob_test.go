@@ -0,0 +1,41 @@
+package blob
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFsStore(t *testing.T) {
+	backend, err := NewFsBackend(t.TempDir())
+	require.NoError(t, err)
+	store := NewStore(backend)
+
+	data := []byte("encrypted payload")
+	c, err := store.Put(data)
+	require.NoError(t, err)
+
+	has, err := store.Has(c)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	got, err := store.Get(c)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+
+	// 数据被篡改
+	err = os.WriteFile(filepath.Join(backend.dir, c.String()), []byte("other payload"), 0o600)
+	require.NoError(t, err)
+	_, err = store.Get(c)
+	require.Error(t, err)
+
+	// 从其他节点获取的数据必须与 cid 一致
+	require.Error(t, store.PutWithCid(c, []byte("other payload")))
+	require.NoError(t, store.PutWithCid(c, data))
+
+	require.NoError(t, store.Delete(c))
+	_, err = store.Get(c)
+	require.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,73 @@
+package blob
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/ipfs/go-cid"
+)
+
+// FsBackend 文件系统储存后端，每个 blob 保存为 dir/<cid>
+type FsBackend struct {
+	dir string
+}
+
+func NewFsBackend(dir string) (*FsBackend, error) {
+	err := os.MkdirAll(dir, 0o700)
+	if err != nil {
+		return nil, err
+	}
+	return &FsBackend{dir: dir}, nil
+}
+
+func (f *FsBackend) path(c cid.Cid) string {
+	return filepath.Join(f.dir, c.String())
+}
+
+func (f *FsBackend) Put(c cid.Cid, data []byte) error {
+	// 先写临时文件再重命名，避免读到写了一半的数据
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), f.path(c))
+}
+
+func (f *FsBackend) Get(c cid.Cid) ([]byte, error) {
+	data, err := os.ReadFile(f.path(c))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+func (f *FsBackend) Has(c cid.Cid) (bool, error) {
+	_, err := os.Stat(f.path(c))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (f *FsBackend) Delete(c cid.Cid) error {
+	err := os.Remove(f.path(c))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
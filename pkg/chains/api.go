@@ -1,6 +1,7 @@
 package chains
 
 import (
+	"errors"
 
 	// pallets "github.com/wetee-dao/tee-dsecret/pkg/chains/pallets"
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
@@ -11,6 +12,9 @@ import (
 
 var MainChain MainChainApi
 
+// ErrTemporary 主链暂时不可用（网络、超时、限流），可以重试，实现使用 %w 包装返回
+var ErrTemporary = errors.New("main chain temporarily unavailable")
+
 // ChainApi is the interface for the chain
 type ChainApi interface {
 	// query pods by worker
@@ -44,8 +48,6 @@ type ChainApi interface {
 type MainChainApi interface {
 	ChainApi
 
-	// get chain client
-	GetClient() *chain.ChainClient
 	GetChainUrls() []string
 	GetSignerAddress() string
 
@@ -62,6 +64,13 @@ type MainChainApi interface {
 
 	/// query node id
 	GetMintWorker(user types.AccountID) (*model.K8sCluster, error)
+
+	// tx
+	// 账户是否已经在 revive 中映射，未映射的 DKG 账户需要先提交 MapAccount
+	IsAccountMapped(h160 types.H160) (bool, error)
+	BatchCall(callMethod string, calls []types.Call) (*types.Call, error)
+	PartialSign(signer chain.PartialSignerType, call types.Call) ([]byte, error)
+	SignAndSubmit(signer chain.SignerType, call types.Call, untilFinalized bool, nonce uint64) error
 }
 
 // ConnectMainChain 连接主链
@@ -89,6 +89,12 @@ func (c *Contract) GetClient() *chain.ChainClient {
 	return c.ChainClient
 }
 
+// IsAccountMapped check account is mapaccount in revive
+func (c *Contract) IsAccountMapped(h160 types.H160) (bool, error) {
+	_, isSome, err := revive.GetOriginalAccountLatest(c.Api().RPC.State, h160)
+	return isSome, err
+}
+
 func (c *Contract) GetSignerAddress() string {
 	return c.signer.SS58Address(42)
 }
@@ -0,0 +1,326 @@
+// Package mock 内存中的主链，用于没有 Substrate 节点和 ink 合约时的本地开发和测试
+// 模拟 epoch、验证节点、worker、pod 和批量交易的提交，提交的交易需要通过签名者公钥的验证，
+// 侧链的 DKG 账户使用 DSS 门限签名，可以注入查询和提交失败
+package mock
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/wetee-dao/tee-dsecret/pkg/chains"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+// Config 模拟主链的配置
+type Config struct {
+	// 出块间隔，区块高度按时间增长
+	BlockTime time.Duration
+	// 每个 epoch 的区块数
+	EpochSolt uint32
+	// pod 的 mint 间隔
+	MintInterval uint32
+	// 初始的验证节点，也是第一个 epoch 的验证节点
+	Validators []*model.Validator
+	// 侧链的启动节点
+	BootPeers []model.P2PAddr
+}
+
+// DefaultConfig 单节点开发使用的配置
+func DefaultConfig(validators ...*model.Validator) Config {
+	return Config{
+		BlockTime:    6 * time.Second,
+		EpochSolt:    600,
+		MintInterval: 30,
+		Validators:   validators,
+	}
+}
+
+// Chain 内存中的主链，实现 chains.MainChainApi
+type Chain struct {
+	mu     sync.RWMutex
+	signer *model.PrivKey
+	config Config
+	start  time.Time
+
+	// epoch
+	epoch          uint32
+	lastEpochBlock uint32
+	sideChainPub   types.H160
+	validators     []*model.Validator
+	nextValidators []*model.Validator
+
+	// revive 中已映射的账户和账户的 nonce
+	mapped map[types.H160]bool
+	nonces map[types.AccountID]uint64
+
+	// worker、pod 和用户上传的 secret、磁盘
+	workers   map[uint64]*model.K8sCluster
+	pods      map[uint64]*model.Pod
+	podWorker map[uint64]uint64
+	podKeys   map[uint64]types.AccountID
+	secrets   map[types.H160][]uint64
+	disks     map[types.H160]map[uint64]types.H256
+
+	extrinsics []*Extrinsic
+	failures   *failures
+}
+
+var _ chains.MainChainApi = (*Chain)(nil)
+
+// NewChain 创建模拟主链，signer 为本节点的主链账户
+func NewChain(signer *model.PrivKey, config Config) *Chain {
+	if config.BlockTime <= 0 {
+		config.BlockTime = time.Second
+	}
+	if config.EpochSolt == 0 {
+		config.EpochSolt = 1
+	}
+
+	c := &Chain{
+		signer:         signer,
+		config:         config,
+		start:          time.Now(),
+		validators:     config.Validators,
+		nextValidators: config.Validators,
+		mapped:         make(map[types.H160]bool),
+		nonces:         make(map[types.AccountID]uint64),
+		workers:        make(map[uint64]*model.K8sCluster),
+		pods:           make(map[uint64]*model.Pod),
+		podWorker:      make(map[uint64]uint64),
+		podKeys:        make(map[uint64]types.AccountID),
+		secrets:        make(map[types.H160][]uint64),
+		disks:          make(map[types.H160]map[uint64]types.H256),
+		failures:       newFailures(),
+	}
+	c.mapped[signer.GetPublic().H160()] = true
+	return c
+}
+
+// blockNumber 当前区块高度
+func (c *Chain) blockNumber() uint32 {
+	return uint32(time.Since(c.start) / c.config.BlockTime)
+}
+
+func (c *Chain) GetChainUrls() []string {
+	return []string{"mock"}
+}
+
+func (c *Chain) GetSignerAddress() string {
+	return c.signer.GetPublic().SS58()
+}
+
+// nodes
+func (c *Chain) GetBootPeers() ([]model.P2PAddr, error) {
+	if err := c.failures.check("GetBootPeers"); err != nil {
+		return nil, err
+	}
+	return c.config.BootPeers, nil
+}
+
+func (c *Chain) GetNodes() ([]*model.Validator, []*model.PubKey, error) {
+	if err := c.failures.check("GetNodes"); err != nil {
+		return nil, nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	nodes := make([]*model.PubKey, 0, len(c.workers)+len(c.validators))
+	for _, w := range c.workers {
+		nodes = append(nodes, model.PubKeyFromByte(w.P2pId[:]))
+	}
+	for _, v := range c.validators {
+		nodes = append(nodes, &v.P2pId)
+	}
+	return c.validators, nodes, nil
+}
+
+func (c *Chain) GetValidatorList() ([]*model.Validator, error) {
+	if err := c.failures.check("GetValidatorList"); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.validators, nil
+}
+
+// epoch
+func (c *Chain) GetEpoch() (uint32, uint32, uint32, uint32, types.H160, error) {
+	if err := c.failures.check("GetEpoch"); err != nil {
+		return 0, 0, 0, 0, types.H160{}, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.epoch, c.config.EpochSolt, c.lastEpochBlock, c.blockNumber(), c.sideChainPub, nil
+}
+
+func (c *Chain) GetNextEpochValidatorList() ([]*model.Validator, error) {
+	if err := c.failures.check("GetNextEpochValidatorList"); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.nextValidators, nil
+}
+
+// SetNextValidators 设置下一个 epoch 的验证节点
+func (c *Chain) SetNextValidators(validators []*model.Validator) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.nextValidators = validators
+}
+
+// ForceEpoch 不提交交易直接切换到下一个 epoch，account 成为已映射的侧链 DKG 账户
+// 用于不需要新 epoch 部分签名（dkg.WithoutEpochSign）的模拟网络
+func (c *Chain) ForceEpoch(account types.AccountID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	h160 := model.PubKeyFromByte(account[:]).H160()
+	c.mapped[h160] = true
+	c.epoch++
+	c.lastEpochBlock = c.blockNumber()
+	c.sideChainPub = h160
+	c.validators = c.nextValidators
+	util.LogWithGreen("Mock chain", "new epoch", c.epoch)
+}
+
+// Epoch 当前 epoch 和验证节点，不会注入故障
+func (c *Chain) Epoch() (uint32, []*model.Validator) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.epoch, c.validators
+}
+
+// SideChainPub 主链上记录的侧链 DKG 账户
+func (c *Chain) SideChainPub() types.H160 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.sideChainPub
+}
+
+// worker
+func (c *Chain) GetWorker(workerId uint64) (*model.K8sCluster, error) {
+	if err := c.failures.check("GetWorker"); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	w, ok := c.workers[workerId]
+	if !ok {
+		return nil, errors.New("worker not found")
+	}
+	return w, nil
+}
+
+func (c *Chain) GetMintWorker(user types.AccountID) (*model.K8sCluster, error) {
+	if err := c.failures.check("GetMintWorker"); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, w := range c.workers {
+		if w.P2pId == user {
+			return w, nil
+		}
+	}
+	return nil, errors.New("worker not found")
+}
+
+// AddWorker 注册 worker，返回 worker id
+func (c *Chain) AddWorker(w model.K8sCluster) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w.Id = uint64(len(c.workers))
+	w.StartBlock = c.blockNumber()
+	c.workers[w.Id] = &w
+	return w.Id
+}
+
+// pod
+func (c *Chain) GetMintInterval() (uint32, error) {
+	if err := c.failures.check("GetMintInterval"); err != nil {
+		return 0, err
+	}
+	return c.config.MintInterval, nil
+}
+
+func (c *Chain) GetPodsVersionByWorker(workerId uint64) ([]model.PodVersion, error) {
+	if err := c.failures.check("GetPodsVersionByWorker"); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	list := make([]model.PodVersion, 0)
+	for id, pod := range c.pods {
+		if c.podWorker[id] != workerId {
+			continue
+		}
+		list = append(list, model.PodVersion{
+			PodId:    id,
+			Version:  pod.Version,
+			LastMint: pod.LastMintBlockNumber,
+			Status:   pod.Status,
+		})
+	}
+	return list, nil
+}
+
+func (c *Chain) GetPodsByIds(podIds []uint64) ([]model.Pod, error) {
+	if err := c.failures.check("GetPodsByIds"); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	pods := make([]model.Pod, 0, len(podIds))
+	for _, id := range podIds {
+		if pod, ok := c.pods[id]; ok {
+			pods = append(pods, *pod)
+		}
+	}
+	return pods, nil
+}
+
+// AddPod 在 worker 上部署 pod，返回 pod id
+func (c *Chain) AddPod(workerId uint64, pod model.Pod) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.workers[workerId]; !ok {
+		return 0, errors.New("worker not found")
+	}
+	pod.PodId = uint64(len(c.pods))
+	c.pods[pod.PodId] = &pod
+	c.podWorker[pod.PodId] = workerId
+	return pod.PodId, nil
+}
+
+// PodKey 侧链为 pod 提交的 pod key
+func (c *Chain) PodKey(podId uint64) (types.AccountID, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	key, ok := c.podKeys[podId]
+	return key, ok
+}
+
+// Secrets 侧链为用户提交的 secret 序号
+func (c *Chain) Secrets(user types.H160) []uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]uint64(nil), c.secrets[user]...)
+}
+
+// Disk 侧链为用户提交的磁盘 hash
+func (c *Chain) Disk(user types.H160, index uint64) (types.H256, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	hash, ok := c.disks[user][index]
+	return hash, ok
+}
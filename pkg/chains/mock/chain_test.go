@@ -0,0 +1,104 @@
+package mock_test
+
+import (
+	"crypto/rand"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+	"github.com/wetee-dao/ink.go/pallet/revive"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/chains/mock"
+	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/sim"
+)
+
+func TestMockChain(t *testing.T) {
+	os.RemoveAll("./chain_data")
+
+	db, err := model.NewDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// 3 个节点的 DKG 作为侧链账户
+	cluster, err := sim.NewCluster(3)
+	require.NoError(t, err)
+	require.NoError(t, cluster.NextEpoch(0, 1, 2))
+	require.NoError(t, cluster.WaitEpoch())
+	_, validators, dkgPub := cluster.Epoch()
+
+	priv, _, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	c := mock.NewChain(priv, mock.DefaultConfig(validators...))
+
+	// DSS 签名：前 n 个节点部分签名，由节点 0 聚合
+	threshold := cluster.Nodes[0].DKG.Threshold
+	dssSubmit := func(call types.Call, n int) error {
+		sigs := make([][]byte, 0, n)
+		for _, node := range cluster.Nodes[:n] {
+			sig, err := c.PartialSign(dkg.NewDssSigner(node.DKG), call)
+			require.NoError(t, err)
+			sigs = append(sigs, sig)
+		}
+		signer := dkg.NewDssSigner(cluster.Nodes[0].DKG)
+		signer.SetSigs(sigs)
+		return c.SignAndSubmit(signer, call, false, 0)
+	}
+
+	// DKG 账户需要先映射
+	epochCall, err := c.TxCallOfSetNextEpoch(0, dkgPub.AccountID())
+	require.NoError(t, err)
+	require.Error(t, dssSubmit(*epochCall, threshold))
+
+	runtimeCall := revive.MakeMapAccountCall()
+	mapCall, err := runtimeCall.AsCall()
+	require.NoError(t, err)
+	require.NoError(t, dssSubmit(mapCall, threshold))
+	mapped, err := c.IsAccountMapped(dkgPub.H160())
+	require.NoError(t, err)
+	require.True(t, mapped)
+
+	// 切换 epoch 后 DKG 账户成为侧链账户
+	require.NoError(t, dssSubmit(*epochCall, threshold))
+	epoch, _, _, _, sideChainPub, err := c.GetEpoch()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), epoch)
+	require.Equal(t, dkgPub.H160(), sideChainPub)
+
+	// 批量交易
+	user := types.H160{1}
+	upload, err := c.TxCallOfUploadSecret(user, 7, dkgPub.AccountID())
+	require.NoError(t, err)
+	disk, err := c.TxCallOfInitDisk(user, 1, types.H256{2}, dkgPub.AccountID())
+	require.NoError(t, err)
+	batch, err := c.BatchCall("batch_all", []types.Call{*upload, *disk})
+	require.NoError(t, err)
+
+	// 部分签名不足门限时签名无效
+	require.Error(t, dssSubmit(*batch, threshold-1))
+	require.Empty(t, c.Secrets(user))
+
+	// 注入的提交失败
+	c.FailNext("SignAndSubmit", 1)
+	require.True(t, errors.Is(dssSubmit(*batch, threshold), mock.ErrInjected))
+
+	require.NoError(t, dssSubmit(*batch, threshold))
+	require.Equal(t, []uint64{7}, c.Secrets(user))
+	hash, ok := c.Disk(user, 1)
+	require.True(t, ok)
+	require.Equal(t, types.H256{2}, hash)
+
+	// batch_all 中任一调用失败时都不执行
+	upload2, err := c.TxCallOfUploadSecret(user, 8, dkgPub.AccountID())
+	require.NoError(t, err)
+	batch, err = c.BatchCall("batch_all", []types.Call{*upload2, *disk})
+	require.NoError(t, err)
+	require.Error(t, dssSubmit(*batch, threshold))
+	require.Equal(t, []uint64{7}, c.Secrets(user))
+
+	// 不是侧链账户的签名者不能提交
+	require.Error(t, c.SignAndSubmit(priv.ToSigner(), *upload2, false, 0))
+}
@@ -0,0 +1,85 @@
+package mock
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/chains"
+)
+
+// ErrInjected 注入的主链故障，包装 chains.ErrTemporary，侧链会重试
+var ErrInjected = fmt.Errorf("mock chain: injected failure: %w", chains.ErrTemporary)
+
+// failures 按方法名注入的故障，方法名为 MainChainApi 的方法，例如 GetEpoch、SignAndSubmit
+type failures struct {
+	mu   sync.Mutex
+	rand *rand.Rand
+	// 按概率失败
+	rate map[string]float64
+	// 接下来的 n 次调用失败
+	next map[string]int
+}
+
+func newFailures() *failures {
+	return &failures{
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+		rate: make(map[string]float64),
+		next: make(map[string]int),
+	}
+}
+
+func (f *failures) check(method string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.next[method] > 0 {
+		f.next[method]--
+		return fmt.Errorf("%s: %w", method, ErrInjected)
+	}
+	if rate := f.rate[method]; rate > 0 && f.rand.Float64() < rate {
+		return fmt.Errorf("%s: %w", method, ErrInjected)
+	}
+	return nil
+}
+
+// SetFailure method 按 rate 的概率返回 ErrInjected，0 为取消
+func (c *Chain) SetFailure(method string, rate float64) {
+	c.failures.mu.Lock()
+	defer c.failures.mu.Unlock()
+	if rate <= 0 {
+		delete(c.failures.rate, method)
+		return
+	}
+	c.failures.rate[method] = rate
+}
+
+// FailNext method 接下来的 n 次调用返回 ErrInjected
+func (c *Chain) FailNext(method string, n int) {
+	c.failures.mu.Lock()
+	defer c.failures.mu.Unlock()
+	c.failures.next[method] = n
+}
+
+// ParseFailures 解析 "SignAndSubmit=0.2,GetEpoch=0.1" 格式的故障配置
+func ParseFailures(s string) (map[string]float64, error) {
+	list := make(map[string]float64)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		method, rate, ok := strings.Cut(item, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid failure %q", item)
+		}
+		r, err := strconv.ParseFloat(strings.TrimSpace(rate), 64)
+		if err != nil || r < 0 || r > 1 {
+			return nil, fmt.Errorf("invalid failure rate %q", item)
+		}
+		list[strings.TrimSpace(method)] = r
+	}
+	return list, nil
+}
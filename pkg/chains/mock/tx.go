@@ -0,0 +1,476 @@
+package mock
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	chain "github.com/wetee-dao/ink.go"
+	"github.com/wetee-dao/ink.go/pallet/revive"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+// 模拟运行时的调用序号，和真实主链的元数据无关
+const (
+	mockSection    uint8 = 0xf0
+	utilitySection uint8 = 0xf1
+)
+
+const (
+	methodSetNextEpoch uint8 = iota
+	methodStartPod
+	methodMintPod
+	methodUploadSecret
+	methodInitDisk
+)
+
+// Utility 的批量调用方式，和 ChainClient.BatchCall 一致
+var batchMethods = []string{"batch", "batch_all", "force_batch"}
+
+type setNextEpochArgs struct {
+	NodeId uint64
+}
+
+type startPodArgs struct {
+	PodId  uint64
+	PodKey types.AccountID
+}
+
+type mintPodArgs struct {
+	PodId uint64
+	Hash  types.H256
+}
+
+type uploadSecretArgs struct {
+	User  types.H160
+	Index uint64
+}
+
+type initDiskArgs struct {
+	User  types.H160
+	Index uint64
+	Hash  types.H256
+}
+
+// Extrinsic 已提交的交易
+type Extrinsic struct {
+	Block  uint32
+	Signer types.AccountID
+	Call   types.Call
+	Err    error
+}
+
+// Extrinsics 已提交的交易，包括执行失败的交易
+func (c *Chain) Extrinsics() []*Extrinsic {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]*Extrinsic(nil), c.extrinsics...)
+}
+
+func newCall(section, method uint8, args any) (*types.Call, error) {
+	bt, err := codec.Encode(args)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Call{
+		CallIndex: types.CallIndex{SectionIndex: section, MethodIndex: method},
+		Args:      bt,
+	}, nil
+}
+
+// POD
+func (c *Chain) TxCallOfStartPod(nodeId uint64, pod_key types.AccountID, signer types.AccountID) (*types.Call, error) {
+	return newCall(mockSection, methodStartPod, startPodArgs{PodId: nodeId, PodKey: pod_key})
+}
+
+func (c *Chain) DryStartPod(nodeId uint64, pod_key types.AccountID, signer types.AccountID) error {
+	return c.dryRun(signer, startPodArgs{PodId: nodeId, PodKey: pod_key})
+}
+
+func (c *Chain) TxCallOfMintPod(nodeId uint64, hash types.H256, signer types.AccountID) (*types.Call, error) {
+	return newCall(mockSection, methodMintPod, mintPodArgs{PodId: nodeId, Hash: hash})
+}
+
+func (c *Chain) DryMintPod(nodeId uint64, hash types.H256, signer types.AccountID) error {
+	return c.dryRun(signer, mintPodArgs{PodId: nodeId, Hash: hash})
+}
+
+// secret
+func (c *Chain) TxCallOfUploadSecret(user types.H160, index uint64, signer types.AccountID) (*types.Call, error) {
+	return newCall(mockSection, methodUploadSecret, uploadSecretArgs{User: user, Index: index})
+}
+
+func (c *Chain) DryUploadSecret(user types.H160, index uint64, signer types.AccountID) error {
+	return c.dryRun(signer, uploadSecretArgs{User: user, Index: index})
+}
+
+// disk
+func (c *Chain) TxCallOfInitDisk(user types.H160, index uint64, hash types.H256, signer types.AccountID) (*types.Call, error) {
+	return newCall(mockSection, methodInitDisk, initDiskArgs{User: user, Index: index, Hash: hash})
+}
+
+func (c *Chain) DryInitDisk(user types.H160, index uint64, hash types.H256, signer types.AccountID) error {
+	return c.dryRun(signer, initDiskArgs{User: user, Index: index, Hash: hash})
+}
+
+// epoch
+func (c *Chain) TxCallOfSetNextEpoch(nodeId uint64, signer types.AccountID) (*types.Call, error) {
+	return newCall(mockSection, methodSetNextEpoch, setNextEpochArgs{NodeId: nodeId})
+}
+
+// SetNewEpoch 本节点的主链账户直接切换 epoch
+func (c *Chain) SetNewEpoch(nodeId uint64) error {
+	call, err := c.TxCallOfSetNextEpoch(nodeId, c.signer.GetPublic().AccountID())
+	if err != nil {
+		return err
+	}
+	return c.SignAndSubmit(c.signer.ToSigner(), *call, false, 0)
+}
+
+// TEE call to call
+func (c *Chain) TEECallToCall(tcall *model.TeeCall, dkgKey types.AccountID) (*types.Call, error) {
+	switch tx := tcall.Tx.(type) {
+	case *model.TeeCall_PodStart:
+		pod_key, err := types.NewAccountID(tcall.Caller)
+		if err != nil {
+			return nil, errors.New("get pod key error")
+		}
+		return c.TxCallOfStartPod(tx.PodStart.Id, *pod_key, dkgKey)
+	case *model.TeeCall_PodMint:
+		return c.TxCallOfMintPod(tx.PodMint.Id, types.NewH256(tx.PodMint.ReportHash), dkgKey)
+	case *model.TeeCall_UploadSecret:
+		return c.TxCallOfUploadSecret(types.NewH160(tx.UploadSecret.User), tx.UploadSecret.Index, dkgKey)
+	case *model.TeeCall_InitDisk:
+		return c.TxCallOfInitDisk(types.NewH160(tx.InitDisk.User), tx.InitDisk.Index, types.NewH256(tx.InitDisk.Hash), dkgKey)
+	}
+	return nil, errors.New("invalid tee call")
+}
+
+// worker
+func (c *Chain) ResigerCluster(name []byte, p2p_id [32]byte, ip model.Ip, port uint32, level byte, region_id uint32) error {
+	if err := c.failures.check("ResigerCluster"); err != nil {
+		return err
+	}
+
+	c.AddWorker(model.K8sCluster{
+		Name:     name,
+		Owner:    c.signer.GetPublic().H160(),
+		Level:    level,
+		RegionId: region_id,
+		P2pId:    types.AccountID(p2p_id),
+		Ip:       ip,
+		Port:     port,
+		Status:   1,
+	})
+	return nil
+}
+
+// tx
+func (c *Chain) IsAccountMapped(h160 types.H160) (bool, error) {
+	if err := c.failures.check("IsAccountMapped"); err != nil {
+		return false, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.mapped[h160], nil
+}
+
+// BatchCall 批量调用的参数为每个调用编码后的列表
+func (c *Chain) BatchCall(callMethod string, calls []types.Call) (*types.Call, error) {
+	for i, m := range batchMethods {
+		if m != callMethod {
+			continue
+		}
+		list := make([][]byte, 0, len(calls))
+		for _, call := range calls {
+			bt, err := codec.Encode(call)
+			if err != nil {
+				return nil, fmt.Errorf("new BatchCall error: %w", err)
+			}
+			list = append(list, bt)
+		}
+		return newCall(utilitySection, uint8(i), list)
+	}
+	return nil, fmt.Errorf("callMethod %s is not in batchMethods %v", callMethod, batchMethods)
+}
+
+// PartialSign DKG 节点对交易的部分签名，所有节点使用相同的 nonce
+func (c *Chain) PartialSign(signer chain.PartialSignerType, call types.Call) ([]byte, error) {
+	if err := c.failures.check("PartialSign"); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	nonce := c.nonces[signer.AccountID()]
+	c.mu.RUnlock()
+
+	msg, err := signPayload(call, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return signer.PartialSign(msg)
+}
+
+// SignAndSubmit 签名并提交交易，签名需要通过签名者公钥的验证，DKG 账户的签名为聚合后的 DSS 签名
+func (c *Chain) SignAndSubmit(signer chain.SignerType, call types.Call, untilFinalized bool, nonce uint64) error {
+	if err := c.failures.check("SignAndSubmit"); err != nil {
+		return err
+	}
+
+	account := signer.AccountID()
+	c.mu.RLock()
+	if nonce == 0 {
+		nonce = c.nonces[account]
+	}
+	c.mu.RUnlock()
+
+	msg, err := signPayload(call, nonce)
+	if err != nil {
+		return err
+	}
+	sig, err := signer.Sign(msg)
+	if err != nil {
+		return errors.New("sign error: " + err.Error())
+	}
+	if !verify(signer, msg, sig) {
+		return errors.New("invalid signature")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if nonce != c.nonces[account] {
+		return fmt.Errorf("invalid nonce %d, want %d", nonce, c.nonces[account])
+	}
+	c.nonces[account]++
+
+	err = c.apply(account, call)
+	c.extrinsics = append(c.extrinsics, &Extrinsic{
+		Block:  c.blockNumber(),
+		Signer: account,
+		Call:   call,
+		Err:    err,
+	})
+	if err != nil {
+		util.LogWithRed("Mock chain", "extrinsic failed:", err.Error())
+	}
+	return err
+}
+
+// signPayload 交易签名的内容
+func signPayload(call types.Call, nonce uint64) ([]byte, error) {
+	bt, err := codec.Encode(struct {
+		Call  types.Call
+		Nonce uint64
+	}{call, nonce})
+	if err != nil {
+		return nil, err
+	}
+	h := blake2b.Sum256(bt)
+	return h[:], nil
+}
+
+func verify(signer chain.SignerType, msg, sig []byte) bool {
+	// ed25519 签名（包括 DSS 聚合签名）使用公钥独立验证
+	if signer.SignType() == 1 {
+		pub := signer.Public()
+		return len(pub) == ed25519.PublicKeySize && ed25519.Verify(pub, msg, sig)
+	}
+	return signer.Verify(msg, sig)
+}
+
+func (c *Chain) dryRun(signer types.AccountID, args any) error {
+	if err := c.failures.check("DryRun"); err != nil {
+		return err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.exec(signer, args, true)
+}
+
+// apply 执行交易，调用方持有锁
+func (c *Chain) apply(signer types.AccountID, call types.Call) error {
+	if isMapAccount(call) {
+		c.mapped[model.PubKeyFromByte(signer[:]).H160()] = true
+		return nil
+	}
+
+	switch call.CallIndex.SectionIndex {
+	case utilitySection:
+		return c.applyBatch(signer, call)
+	case mockSection:
+		args, err := decodeArgs(call)
+		if err != nil {
+			return err
+		}
+		return c.exec(signer, args, false)
+	}
+	return errors.New("unknown call")
+}
+
+// applyBatch batch 遇到失败的调用时停止，batch_all 任一调用失败时都不执行，force_batch 忽略失败的调用
+func (c *Chain) applyBatch(signer types.AccountID, call types.Call) error {
+	if int(call.CallIndex.MethodIndex) >= len(batchMethods) {
+		return errors.New("unknown batch method")
+	}
+	method := batchMethods[call.CallIndex.MethodIndex]
+
+	var list [][]byte
+	if err := codec.Decode(call.Args, &list); err != nil {
+		return fmt.Errorf("decode batch: %w", err)
+	}
+
+	calls := make([]any, 0, len(list))
+	for _, bt := range list {
+		inner := types.Call{}
+		if err := codec.Decode(bt, &inner); err != nil {
+			return fmt.Errorf("decode batch call: %w", err)
+		}
+		if inner.CallIndex.SectionIndex != mockSection {
+			return errors.New("unknown batch call")
+		}
+		args, err := decodeArgs(inner)
+		if err != nil {
+			return err
+		}
+		calls = append(calls, args)
+	}
+
+	if method == "batch_all" {
+		for i, args := range calls {
+			if err := c.exec(signer, args, true); err != nil {
+				return fmt.Errorf("batch_all call %d: %w", i, err)
+			}
+		}
+	}
+	for i, args := range calls {
+		if err := c.exec(signer, args, false); err != nil {
+			if method == "force_batch" {
+				util.LogWithRed("Mock chain", "force_batch call", i, "failed:", err.Error())
+				continue
+			}
+			return fmt.Errorf("batch call %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func decodeArgs(call types.Call) (any, error) {
+	var err error
+	switch call.CallIndex.MethodIndex {
+	case methodSetNextEpoch:
+		args := setNextEpochArgs{}
+		err = codec.Decode(call.Args, &args)
+		return args, err
+	case methodStartPod:
+		args := startPodArgs{}
+		err = codec.Decode(call.Args, &args)
+		return args, err
+	case methodMintPod:
+		args := mintPodArgs{}
+		err = codec.Decode(call.Args, &args)
+		return args, err
+	case methodUploadSecret:
+		args := uploadSecretArgs{}
+		err = codec.Decode(call.Args, &args)
+		return args, err
+	case methodInitDisk:
+		args := initDiskArgs{}
+		err = codec.Decode(call.Args, &args)
+		return args, err
+	}
+	return nil, errors.New("unknown method")
+}
+
+// exec 检查调用的权限和参数，dry 为 false 时修改状态
+// 切换 epoch 的账户成为侧链 DKG 账户，其他调用只能由侧链 DKG 账户提交
+func (c *Chain) exec(signer types.AccountID, args any, dry bool) error {
+	h160 := model.PubKeyFromByte(signer[:]).H160()
+	if !c.mapped[h160] {
+		return errors.New("account is not mapped")
+	}
+
+	if a, ok := args.(setNextEpochArgs); ok {
+		if c.sideChainPub != (types.H160{}) && c.sideChainPub != h160 {
+			return errors.New("signer is not the side chain key")
+		}
+		if !hasValidator(c.nextValidators, a.NodeId) {
+			return fmt.Errorf("node %d is not a validator", a.NodeId)
+		}
+		if dry {
+			return nil
+		}
+		c.epoch++
+		c.lastEpochBlock = c.blockNumber()
+		c.sideChainPub = h160
+		c.validators = c.nextValidators
+		util.LogWithGreen("Mock chain", "new epoch", c.epoch)
+		return nil
+	}
+
+	if c.sideChainPub != h160 {
+		return errors.New("signer is not the side chain key")
+	}
+
+	switch a := args.(type) {
+	case startPodArgs:
+		if _, ok := c.pods[a.PodId]; !ok {
+			return errors.New("pod not found")
+		}
+		if !dry {
+			c.podKeys[a.PodId] = a.PodKey
+		}
+	case mintPodArgs:
+		pod, ok := c.pods[a.PodId]
+		if !ok {
+			return errors.New("pod not found")
+		}
+		if !dry {
+			pod.LastMintBlockNumber = c.blockNumber()
+		}
+	case uploadSecretArgs:
+		if !dry {
+			c.secrets[a.User] = append(c.secrets[a.User], a.Index)
+		}
+	case initDiskArgs:
+		if _, ok := c.disks[a.User][a.Index]; ok {
+			return errors.New("disk already initialized")
+		}
+		if !dry {
+			if c.disks[a.User] == nil {
+				c.disks[a.User] = make(map[uint64]types.H256)
+			}
+			c.disks[a.User][a.Index] = a.Hash
+		}
+	default:
+		return errors.New("unknown call")
+	}
+	return nil
+}
+
+func hasValidator(validators []*model.Validator, nodeId uint64) bool {
+	for _, v := range validators {
+		if v.NodeID == nodeId {
+			return true
+		}
+	}
+	return false
+}
+
+func isMapAccount(call types.Call) bool {
+	runtimeCall := revive.MakeMapAccountCall()
+	mapCall, err := runtimeCall.AsCall()
+	if err != nil {
+		return false
+	}
+	a, errA := codec.Encode(mapCall)
+	b, errB := codec.Encode(call)
+	return errA == nil && errB == nil && bytes.Equal(a, b)
+}
@@ -0,0 +1,109 @@
+package dkg
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/proof/dleq"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+// 随机数信标（门限 VRF）
+// 每一轮的消息点 M = H(round || prev)，节点输出 s_i·M 并附带 DLEQ 证明 log_G(Y_i) == log_M(s_i·M)
+// 任意 threshold 个有效份额恢复出唯一的 s·M，少于 threshold 个节点无法预测或操纵结果
+// 信标值为 SHA256(s·M)
+
+// BeaconPartial 节点的信标份额
+type BeaconPartial struct {
+	Index uint32
+	V     kyber.Point
+	Proof *dleq.Proof
+}
+
+// BeaconMessage 计算一轮信标的消息点，离散对数未知
+func BeaconMessage(suite suites.Suite, round uint64, prev []byte) kyber.Point {
+	seed := []byte("wetee/beacon")
+	seed = binary.BigEndian.AppendUint64(seed, round)
+	seed = append(seed, prev...)
+	return suite.Point().Pick(suite.XOF(seed))
+}
+
+// NewBeaconPartial 使用本节点的份额生成信标份额
+func NewBeaconPartial(suite suites.Suite, priShare *share.PriShare, msg kyber.Point) (*BeaconPartial, error) {
+	proof, _, v, err := dleq.NewDLEQProof(suite, nil, msg, priShare.V)
+	if err != nil {
+		return nil, err
+	}
+	return &BeaconPartial{Index: priShare.I, V: v, Proof: proof}, nil
+}
+
+// VerifyBeaconPartial 使用节点的公开份额验证信标份额
+func VerifyBeaconPartial(suite suites.Suite, pubPoly *share.PubPoly, msg kyber.Point, p *BeaconPartial) error {
+	if p == nil || p.V == nil || p.Proof == nil {
+		return errors.New("invalid beacon partial")
+	}
+	pubShare := pubPoly.Eval(p.Index).V
+	if err := p.Proof.Verify(suite, suite.Point().Base(), msg, pubShare, p.V); err != nil {
+		return fmt.Errorf("beacon partial of node %d: %w", p.Index, err)
+	}
+	return nil
+}
+
+// RecoverBeacon 从 threshold 个已验证的份额恢复信标值
+func RecoverBeacon(suite suites.Suite, partials []*BeaconPartial, threshold int) ([]byte, error) {
+	shares := make([]*share.PubShare, 0, len(partials))
+	for _, p := range partials {
+		shares = append(shares, &share.PubShare{I: p.Index, V: p.V})
+	}
+	sig, err := share.RecoverCommit(suite, shares, threshold, len(shares))
+	if err != nil {
+		return nil, err
+	}
+
+	bt, err := sig.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(bt)
+	return h[:], nil
+}
+
+// MarshalBeaconProof 编码 DLEQ 证明：C || R || VG || VH
+func MarshalBeaconProof(p *dleq.Proof) ([]byte, error) {
+	var out []byte
+	for _, m := range []interface{ MarshalBinary() ([]byte, error) }{p.C, p.R, p.VG, p.VH} {
+		bt, err := m.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, bt...)
+	}
+	return out, nil
+}
+
+// UnmarshalBeaconProof 解码 DLEQ 证明
+func UnmarshalBeaconProof(suite suites.Suite, bt []byte) (*dleq.Proof, error) {
+	sl, pl := suite.ScalarLen(), suite.PointLen()
+	if len(bt) != 2*sl+2*pl {
+		return nil, errors.New("invalid beacon proof length")
+	}
+
+	p := &dleq.Proof{C: suite.Scalar(), R: suite.Scalar(), VG: suite.Point(), VH: suite.Point()}
+	if err := p.C.UnmarshalBinary(bt[:sl]); err != nil {
+		return nil, err
+	}
+	if err := p.R.UnmarshalBinary(bt[sl : 2*sl]); err != nil {
+		return nil, err
+	}
+	if err := p.VG.UnmarshalBinary(bt[2*sl : 2*sl+pl]); err != nil {
+		return nil, err
+	}
+	if err := p.VH.UnmarshalBinary(bt[2*sl+pl:]); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
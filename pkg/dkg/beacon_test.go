@@ -0,0 +1,57 @@
+package dkg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+func TestBeacon(t *testing.T) {
+	suite := suites.MustFind("Ed25519")
+	n, threshold := 5, 3
+
+	secret := suite.Scalar().Pick(suite.RandomStream())
+	priPoly := share.NewPriPoly(suite, threshold, secret, suite.RandomStream())
+	pubPoly := priPoly.Commit(nil)
+	shares := priPoly.Shares(n)
+
+	msg := BeaconMessage(suite, 1, nil)
+	partials := make([]*BeaconPartial, 0, n)
+	for _, s := range shares {
+		p, err := NewBeaconPartial(suite, s, msg)
+		require.NoError(t, err)
+		require.NoError(t, VerifyBeaconPartial(suite, pubPoly, msg, p))
+
+		// 证明编码
+		bt, err := MarshalBeaconProof(p.Proof)
+		require.NoError(t, err)
+		proof, err := UnmarshalBeaconProof(suite, bt)
+		require.NoError(t, err)
+		p.Proof = proof
+		require.NoError(t, VerifyBeaconPartial(suite, pubPoly, msg, p))
+
+		partials = append(partials, p)
+	}
+
+	// 任意 threshold 个份额得到相同的信标值
+	beacon, err := RecoverBeacon(suite, partials[:threshold], threshold)
+	require.NoError(t, err)
+	other, err := RecoverBeacon(suite, []*BeaconPartial{partials[4], partials[1], partials[3]}, threshold)
+	require.NoError(t, err)
+	require.Equal(t, beacon, other)
+
+	// 不同轮次的信标值不同
+	next := BeaconMessage(suite, 2, beacon)
+	require.False(t, msg.Equal(next))
+
+	// 错误的份额可以被识别
+	require.Error(t, VerifyBeaconPartial(suite, pubPoly, next, partials[0]))
+	partials[0].Index = 1
+	require.Error(t, VerifyBeaconPartial(suite, pubPoly, msg, partials[0]))
+
+	// 份额不足
+	_, err = RecoverBeacon(suite, partials[1:threshold], threshold)
+	require.Error(t, err)
+}
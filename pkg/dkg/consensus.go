@@ -15,6 +15,12 @@ import (
 
 const StartEpoch = 1
 
+// 共识超时时间，以及共识窗口（秒），窗口内不会开始新的共识
+const (
+	consensusTimeout        = time.Second * 30
+	consensusBusyTime int64 = 90
+)
+
 func (dkg *DKG) TryEpochConsensus(
 	msg model.ConsensusMsg,
 	callback func(*DssSigner, uint64),
@@ -26,16 +32,20 @@ func (dkg *DKG) TryEpochConsensus(
 		return errors.New("in consensus")
 	}
 
-	// check old validators length
-	if dkg.AvailableNodeLen() <= dkg.Threshold {
-		util.LogError("DKG Consensus", "validator node exapect >", dkg.Threshold, ", got:", dkg.AvailableNodeLen())
-		return fmt.Errorf("old validators count <= dkg.Threshold")
+	// 新 epoch 的门限策略，未设置时使用默认策略
+	msg.Policy = msg.Policy.OrDefault()
+
+	// check old validators length, old key needs threshold shares to reshare
+	if dkg.AvailableNodeLen() < dkg.Threshold {
+		util.LogError("DKG Consensus", "validator node exapect >=", dkg.Threshold, ", got:", dkg.AvailableNodeLen())
+		return fmt.Errorf("old validators count < dkg.Threshold")
 	}
 
 	// check new nodes validators length
-	if dkg.NewValidatorNodeLen(msg.Validators) <= len(msg.Validators)*3/4 {
-		util.LogError("DKG Consensus", "exapect new validator count:", len(msg.Validators)*3/4, ", got:", dkg.NewValidatorNodeLen(msg.Validators))
-		return fmt.Errorf("new validators count < len(Validators)*3/4")
+	quorum := msg.Policy.Quorum(len(msg.Validators))
+	if dkg.NewValidatorNodeLen(msg.Validators) < quorum {
+		util.LogError("DKG Consensus", "exapect new validator count:", quorum, ", got:", dkg.NewValidatorNodeLen(msg.Validators))
+		return fmt.Errorf("new validators count < quorum %d", quorum)
 	}
 
 	// check local node is in validators, only validator node can start consensus
@@ -92,19 +102,46 @@ func (dkg *DKG) startConsensus(msg model.ConsensusMsg) error {
 		return errors.New("DKG Consensus going")
 	}
 
-	if dkg.Epoch >= msg.Epoch {
+	if dkg.consensusDone(&msg) {
 		return errors.New("DKG Epoch is not need to update")
 	}
 
+	// 已结束的轮次不再加入
+	if dkg.round != nil && dkg.round.SessionId == roundSessionId(&msg) {
+		return errors.New("DKG round " + dkg.round.SessionId + " is " + dkg.round.Phase)
+	}
+
+	round, err := newDkgRound(&msg)
+	if err != nil {
+		return err
+	}
+	dkg.round = round
+	dkg.saveRound()
+
 	dkg.setConsensusBusy()
-	dkg.addConsensusTimeout()
+	dkg.addConsensusTimeout(consensusTimeout)
+
+	if err := dkg.runConsensus(msg); err != nil {
+		return err
+	}
+	dkg.replayEarly()
+	return nil
+}
+
+// run consensus of current round
+func (dkg *DKG) runConsensus(msg model.ConsensusMsg) error {
+	dkg.resetRoundCache()
 
 	if len(msg.ShareCommits.Public) == 0 {
 		util.LogWithGray("InitConsensus Epoch ======> ", msg.Epoch)
 		return dkg.initConsensus(msg)
 	}
 
-	util.LogWithGray("ReConsensus Epoch ======> ", msg.Epoch)
+	if msg.Refresh > 0 {
+		util.LogWithGray("RefreshConsensus Epoch ======> ", msg.Epoch, "refresh", msg.Refresh)
+	} else {
+		util.LogWithGray("ReConsensus Epoch ======> ", msg.Epoch)
+	}
 	return dkg.reConsensus(msg)
 }
 
@@ -115,7 +152,8 @@ func (dkg *DKG) initConsensus(msg model.ConsensusMsg) error {
 	// }
 	dkg.Nodes = msg.Validators
 	dkg.NewNodes = msg.Validators
-	dkg.Threshold = len(msg.Validators) * 2 / 3
+	dkg.NewPolicy = msg.Policy.OrDefault()
+	dkg.Threshold = dkg.newThreshold()
 
 	// 如果已经初始化，则直接返回
 	if dkg.status == 1 {
@@ -143,6 +181,7 @@ func (dkg *DKG) initConsensus(msg model.ConsensusMsg) error {
 		Nonce:     epochToNonce(0),
 		Log:       dkg.log,
 	}
+	dkg.seedConfig(&conf)
 
 	// initialize dealer
 	var err error
@@ -159,6 +198,7 @@ func (dkg *DKG) initConsensus(msg model.ConsensusMsg) error {
 		dkg.finishDkgConsensusStep(false, "dkg.DistKeyGenerator.Deals")
 		return fmt.Errorf("failed to generate key shares: %w", err)
 	}
+	dkg.saveOwnDeal(deal)
 
 	// 开启节点共识
 	// send deal to all nodes
@@ -175,12 +215,20 @@ func (dkg *DKG) initConsensus(msg model.ConsensusMsg) error {
 
 // Re-consensus DKG
 func (dkg *DKG) reConsensus(msg model.ConsensusMsg) error {
-	// old
-	dkg.Threshold = len(msg.OldValidators) * 2 / 3
+	// old, 旧密钥的门限即承诺的数量
+	dkg.Threshold = len(msg.ShareCommits.Public)
 	dkg.Nodes = msg.OldValidators
 	// new
 	dkg.NewNodes = msg.Validators
 	dkg.NewEpoch = msg.Epoch
+	// 刷新份额时空策略表示门限策略之前创建的 epoch，保持原来的门限
+	dkg.NewPolicy = msg.Policy
+	dkg.NewRefresh = msg.Refresh
+	if msg.Refresh > 0 {
+		// 不使用上一次刷新尝试生成的份额
+		dkg.NewDkgPubKey = nil
+		dkg.NewDkgKeyShare = nil
+	}
 
 	// new DKG 节点列表
 	newNodes := make([]pedersen.Node, 0, len(msg.Validators))
@@ -200,7 +248,7 @@ func (dkg *DKG) reConsensus(msg model.ConsensusMsg) error {
 		})
 	}
 
-	newThreshold := len(msg.Validators) * 2 / 3
+	newThreshold := dkg.newThreshold()
 
 	// 初始化协议配置
 	conf := pedersen.Config{
@@ -208,7 +256,7 @@ func (dkg *DKG) reConsensus(msg model.ConsensusMsg) error {
 		OldThreshold: dkg.Threshold,
 		Threshold:    newThreshold,
 		NewNodes:     newNodes,
-		Nonce:        epochToNonce(msg.Epoch),
+		Nonce:        consensusNonce(&msg),
 		Suite:        dkg.Suite,
 		Auth:         schnorr.NewScheme(dkg.Suite),
 		FastSync:     true,
@@ -225,6 +273,7 @@ func (dkg *DKG) reConsensus(msg model.ConsensusMsg) error {
 	} else {
 		conf.PublicCoeffs = msg.ShareCommits.Public
 	}
+	dkg.seedConfig(&conf)
 
 	var err error
 	dkg.DistKeyGenerator, err = pedersen.NewDistKeyHandler(&conf)
@@ -236,11 +285,6 @@ func (dkg *DKG) reConsensus(msg model.ConsensusMsg) error {
 
 	priShare := dkg.DkgKeyShare
 
-	// 重置 DKG Key
-	dkg.deals = map[string]*model.DealBundle{}
-	dkg.responses = map[string]*pedersen.ResponseBundle{}
-	dkg.justifs = []*pedersen.JustificationBundle{}
-
 	// old node not issue deals
 	if priShare == nil {
 		dkg.log.Info("node is not old validator, not send deal")
@@ -253,6 +297,7 @@ func (dkg *DKG) reConsensus(msg model.ConsensusMsg) error {
 		dkg.finishDkgConsensusStep(false, "dkg.DistKeyGenerator.Deals()")
 		return fmt.Errorf("failed to generate key shares: %w", err)
 	}
+	dkg.saveOwnDeal(deal)
 
 	// 开启节点共识
 	newMsg := util.DeepCopy(msg)
@@ -265,27 +310,48 @@ func (dkg *DKG) reConsensus(msg model.ConsensusMsg) error {
 	return nil
 }
 
+// save own deal of current round
+func (dkg *DKG) saveOwnDeal(deal *pedersen.DealBundle) {
+	if dkg.round == nil {
+		return
+	}
+	dkg.round.OwnDeal = &model.DealBundle{DealBundle: deal}
+	dkg.saveRound()
+}
+
 // set consensus time out
-func (dkg *DKG) addConsensusTimeout() {
+// 超时在 DKG 的消息循环中处理，已停止的定时器的超时忽略
+func (dkg *DKG) addConsensusTimeout(timeout time.Duration) {
+	dkg.stopConsensusTimeout()
+
+	var timer *time.Timer
+	timer = time.AfterFunc(timeout, func() {
+		dkg.runLocal(func() {
+			if dkg.failConsensusTimer != timer {
+				return
+			}
+			dkg.finishDkgConsensusStep(false, "timeout")
+		})
+	})
+	dkg.failConsensusTimer = timer
+}
+
+func (dkg *DKG) stopConsensusTimeout() {
 	if dkg.failConsensusTimer != nil {
 		dkg.failConsensusTimer.Stop()
+		dkg.failConsensusTimer = nil
 	}
-	dkg.failConsensusTimer = time.AfterFunc(time.Second*30, func() {
-		dkg.finishDkgConsensusStep(false, "timeout")
-	})
 }
 
 // stop consensus
 func (dkg *DKG) finishDkgConsensusStep(isok bool, tag string) {
-	if dkg.failConsensusTimer != nil {
-		dkg.failConsensusTimer.Stop()
-	}
+	dkg.stopConsensusTimeout()
 
-	dkg.deals = map[string]*model.DealBundle{}
-	dkg.responses = map[string]*pedersen.ResponseBundle{}
-	dkg.justifs = []*pedersen.JustificationBundle{}
+	dkg.resetRoundCache()
 	if !isok {
 		util.LogWithRed("DKG dkg consensus", "failed <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<< New Epoch", dkg.NewEpoch, "error", tag)
+		dkg.setRoundPhase(RoundPhaseAborted)
+		dkg.toNewEpochPending = false
 		dkg.setConsensusFree()
 		if dkg.consensusFailBack != nil {
 			dkg.consensusFailBack(errors.New("DKG dkg consensus failed"))
@@ -293,28 +359,65 @@ func (dkg *DKG) finishDkgConsensusStep(isok bool, tag string) {
 		return
 	}
 
+	// 刷新份额不能改变 DKG 公钥
+	refresh := dkg.round != nil && dkg.round.Msg.Refresh > 0
+	if refresh && (dkg.NewDkgPubKey == nil || dkg.DkgPubKey == nil || dkg.NewDkgPubKey.SS58() != dkg.DkgPubKey.SS58()) {
+		dkg.finishDkgConsensusStep(false, "share refresh changed dkg pub key")
+		return
+	}
+
+	if dkg.round != nil && dkg.groupRound(dkg.round.Msg) {
+		// 初始 DKG 没有设置 NewEpoch
+		dkg.NewEpoch = dkg.round.Msg.Epoch
+	}
+
+	dkg.setRoundPhase(RoundPhaseSign)
 	dkg.saveState()
+	if dkg.round != nil && dkg.ackRound(dkg.round.Msg) {
+		dkg.sendRefreshAck()
+		if dkg.toNewEpochPending {
+			dkg.toNewEpoch()
+		}
+		return
+	}
+
 	// if dkg.DkgPubKey == nil, set new data to init
 	if dkg.DkgPubKey == nil {
 		dkg.Nodes = dkg.NewNodes
 		dkg.Epoch = dkg.NewEpoch
-		dkg.Threshold = len(dkg.NewNodes) * 2 / 3
+		dkg.Threshold = dkg.newThreshold()
+		dkg.Policy = dkg.NewPolicy
 		dkg.DkgPubKey = dkg.NewDkgPubKey
 		dkg.DkgKeyShare = dkg.NewDkgKeyShare
 	}
 	dkg.SendNewEpochPartialSigToSponsor()
+
+	if dkg.toNewEpochPending {
+		dkg.toNewEpoch()
+	}
 }
 
-// to next epoch
+// ToNewEpoch 侧链打包新 epoch 后切换到新份额，在 DKG 的消息循环中执行，可以在其他 goroutine 中调用
 func (dkg *DKG) ToNewEpoch() {
-	if dkg.failConsensusTimer != nil {
-		dkg.failConsensusTimer.Stop()
+	dkg.runLocal(dkg.toNewEpoch)
+}
+
+// to next epoch
+func (dkg *DKG) toNewEpoch() {
+	// 本节点的轮次还在进行中（例如重启后恢复的轮次），完成后再切换
+	if dkg.roundActive() && dkg.round.Phase != RoundPhaseSign {
+		util.LogWithYellow("DKG consensus ToNewEpoch", "wait round", dkg.round.SessionId, "phase", dkg.round.Phase)
+		dkg.toNewEpochPending = true
+		return
 	}
+	dkg.toNewEpochPending = false
+	dkg.stopConsensusTimeout()
 
 	defer dkg.setConsensusFree()
 
 	if dkg.NewDkgKeyShare == nil {
 		util.LogWithRed("DKG consensus ToNewEpoch", "failed <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<< New Epoch", dkg.NewEpoch)
+		dkg.setRoundPhase(RoundPhaseAborted)
 		if dkg.consensusFailBack != nil {
 			dkg.consensusFailBack(errors.New("DKG consensus failed"))
 		}
@@ -323,24 +426,31 @@ func (dkg *DKG) ToNewEpoch() {
 
 	dkg.Nodes = dkg.NewNodes
 	dkg.Epoch = dkg.NewEpoch
-	dkg.Threshold = len(dkg.NewNodes) * 2 / 3
+	dkg.Threshold = dkg.newThreshold()
+	dkg.Policy = dkg.NewPolicy
 	dkg.DkgPubKey = dkg.NewDkgPubKey
 	dkg.DkgKeyShare = dkg.NewDkgKeyShare
+	dkg.Refresh = dkg.NewRefresh
 
 	dkg.NewNodes = nil
 	dkg.NewEpoch = 0
+	dkg.NewRefresh = 0
 	dkg.NewDkgPubKey = nil
 	dkg.NewDkgKeyShare = nil
 
 	// reset cache
+	// 同一秒内开始的下一轮不能使用本轮的部分签名
 	dkg.NewEpochSponsor = nil
+	dkg.NewEpochPartialSigs = nil
 
-	util.LogWithGray("DKG consensus", "successfully <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<< New Epoch", dkg.Epoch)
+	util.LogWithGray("DKG consensus", "successfully <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<< New Epoch", dkg.Epoch, "refresh", dkg.Refresh)
 	dkg.saveState()
+	dkg.saveEscrow()
+	dkg.setRoundPhase(RoundPhaseDone)
 }
 
 func (dkg *DKG) ConsensusIsbusy() bool {
-	return time.Now().Unix()-dkg.lastConsensusTime < 90
+	return time.Now().Unix()-dkg.lastConsensusTime < consensusBusyTime
 }
 
 func (dkg *DKG) setConsensusBusy() {
@@ -351,6 +461,17 @@ func (dkg *DKG) setConsensusFree() {
 	dkg.lastConsensusTime = 0
 }
 
+// reset the deal, response, justification cache of round
+func (dkg *DKG) resetRoundCache() {
+	dkg.deals = map[string]*model.DealBundle{}
+	dkg.responses = map[string]*pedersen.ResponseBundle{}
+	dkg.justifs = []*pedersen.JustificationBundle{}
+	dkg.complaints = nil
+	if dkg.justifTimer != nil {
+		dkg.justifTimer.Stop()
+	}
+}
+
 func (dkg *DKG) NewValidatorNodeLen(nodes []*model.Validator) int {
 	var len int = 1
 	peers := dkg.Peer.AvailableNodes()
@@ -1,6 +1,7 @@
 package dkg
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 
@@ -51,16 +52,37 @@ func (dkg *DKG) handleDeal(OrgId string, data []byte) error {
 	// 开始本地节点的共识
 	// start local node consensus
 	newMsg := util.DeepCopy(*pmessage)
-	dkg.SaveSponsor(newMsg)
 	dkg.startConsensus(*newMsg)
 	// end local node
 
+	// 只处理当前轮次的 deal
+	if !dkg.inSession(pmessage) {
+		return nil
+	}
+	dkg.SaveSponsor(newMsg)
+
+	return dkg.receiveDeal(OrgId, pmessage.DealBundle)
+}
+
+// receiveDeal 保存收到的 deal，收到所有 deal 后处理并广播 response
+func (dkg *DKG) receiveDeal(OrgId string, deal *model.DealBundle) error {
+	round := dkg.round
+	// 已经处理过 deal，忽略重启节点重发的 deal
+	if deal == nil || round.Phase != RoundPhaseDeal {
+		return nil
+	}
+	if _, ok := round.Deals[OrgId]; ok {
+		return nil
+	}
+
 	// 存储deal
-	dkg.deals[OrgId] = pmessage.DealBundle
+	round.Deals[OrgId] = deal
+	dkg.saveRound()
+	dkg.deals[OrgId] = deal
 
 	mustDeals := len(dkg.Nodes)
-	if pmessage.Epoch > StartEpoch {
-		mustDeals = pmessage.ConsensusNodeNum
+	if round.Msg.Epoch > StartEpoch {
+		mustDeals = round.Msg.ConsensusNodeNum
 	}
 	if len(dkg.deals) < mustDeals {
 		// dkg.log.Error("HandleDeal len(dkg.deals)", len(dkg.deals), "=========== mustDeals", mustDeals)
@@ -96,6 +118,7 @@ func (dkg *DKG) handleDeal(OrgId string, data []byte) error {
 		dkg.finishDkgConsensusStep(false, "errNum > 1")
 		return fmt.Errorf("ProcessDeals error: errNum >1")
 	}
+	dkg.setRoundPhase(RoundPhaseResponse)
 
 	// 将响应对象序列化为字节切片
 	bt, _ := json.Marshal(resp)
@@ -109,7 +132,8 @@ func (dkg *DKG) handleDeal(OrgId string, data []byte) error {
 		util.LogError("DEAL", "Send deal_resp error", err)
 	}
 
-	return nil
+	// 处理提前收到的 response
+	return dkg.tryProcessResponses()
 }
 
 // HandleDealResp 处理交易响应消息
@@ -125,9 +149,37 @@ func (dkg *DKG) handleDealResp(OrgId string, data []byte) error {
 		util.LogError("DEAL", err)
 		return err
 	}
+	if !dkg.roundActive() {
+		dkg.cacheEarly("deal_resp", OrgId, data)
+		return nil
+	}
+
+	return dkg.receiveResponse(OrgId, message)
+}
+
+// receiveResponse 保存收到的 response，本节点处理完 deal 之前先缓存
+func (dkg *DKG) receiveResponse(OrgId string, message *pedersen.ResponseBundle) error {
+	if !dkg.roundActive() || !bytes.Equal(message.SessionID, dkg.roundNonce()) {
+		return nil
+	}
+	round := dkg.round
+	if round.Phase != RoundPhaseDeal && round.Phase != RoundPhaseResponse {
+		return nil
+	}
+	if _, ok := round.Responses[OrgId]; ok {
+		return nil
+	}
 
+	round.Responses[OrgId] = message
+	dkg.saveRound()
 	dkg.responses[OrgId] = message
-	if len(dkg.responses) < len(dkg.NewNodes) {
+
+	return dkg.tryProcessResponses()
+}
+
+// tryProcessResponses 处理完 deal 并收到所有 response 后生成密钥份额或进入证明阶段
+func (dkg *DKG) tryProcessResponses() error {
+	if dkg.round.Phase != RoundPhaseResponse || len(dkg.responses) < len(dkg.NewNodes) {
 		// dkg.log.Error("||||||||||||||||  HandleDealResp len(dkg.responses)", len(dkg.responses), "=========== mustDeals", len(dkg.NewNodes))
 		return nil
 	}
@@ -158,112 +210,17 @@ func (dkg *DKG) handleDealResp(OrgId string, data []byte) error {
 		dkg.finishDkgConsensusStep(true, "")
 		return nil
 	}
+	dkg.setRoundPhase(RoundPhaseJustification)
 
-	// Justification 为 nil
-	if justification == nil {
-		// reshare 可能在这里获取私钥
-		res, err := dkg.DistKeyGenerator.ProcessJustifications(nil)
-		if err == nil {
-			dkg.NewDkgKeyShare = &model.DistKeyShare{
-				CommitsWrap:  model.KyberPoints{Public: res.Key.Commits},
-				PriShareWrap: model.PriShare{PriShare: res.Key.Share},
-			}
-			dkg.NewDkgPubKey, _ = model.PubKeyFromPoint(res.Key.Public())
-
-			// 保存密钥份额
-			dkg.saveState()
-			dkg.finishDkgConsensusStep(true, "")
-			return nil
+	// 被投诉的 dealer 需要广播证明
+	if justification != nil {
+		err = dkg.sendJustification(justification)
+		if err != nil {
+			util.LogError("DEAL", "Send justification error", err)
 		}
 	}
 
-	// fmt.Println("ProcessJustifications justification:", justification)
-	// // 将交易信息转换为协议消息格式
-	// pmessage, err := model.JustificationToProtocol(justification)
-	// if err != nil {
-	// 	return err
-	// }
-
-	// // 将协议格式的秘密提交序列化为JSON
-	// bt, err := json.Marshal(pmessage)
-	// if err != nil {
-	// 	// 如果序列化失败，返回错误
-	// 	return fmt.Errorf("HandleDealResp json.Marshal: %w", err)
-	// }
-
-	// // 向所有DKG节点广播秘密提交
-	// for _, node := range dkg.NewNodes {
-	// 	// 发送秘密提交给其他节点
-	// 	err = dkg.sendToNode(&node.P2pId, "dkg", &model.Message{
-	// 		Type:    "justification",
-	// 		Payload: bt,
-	// 	})
-	// 	if err != nil {
-	// 		// 如果发送失败，记录错误
-	// 		util.LogError("DEAL", "Send justification error", err)
-	// 	}
-	// }
-
-	dkg.finishDkgConsensusStep(false, "HandleDealResp not implemented")
-	return fmt.Errorf("HandleDealResp not implemented")
+	// 等待被投诉的 dealer 提交证明，没有投诉时直接完成
+	// reshare 可能在这里获取私钥
+	return dkg.waitJustifications(collectComplaints(dkg.responses))
 }
-
-// handleJustification 处理合理性证明消息
-// 该函数接收一个字节切片数据作为输入，尝试解析并处理合理性证明
-// 如果消息与当前节点相关，则更新内部状态
-// 参数:
-//   - data: 包含合理性证明信息的字节切片
-//
-// 返回值:
-//   - error: 如果解析或处理消息过程中发生错误，则返回该错误
-// func (dkg *DKG) handleJustification(OrgId string, data []byte) error {
-// 	// 加锁以确保线程安全
-// 	dkg.mu.Lock()
-// 	defer dkg.mu.Unlock()
-
-// 	// 初始化交易消息结构体
-// 	pmessage := &model.JustificationBundle{}
-// 	err := json.Unmarshal(data, pmessage)
-// 	if err != nil {
-// 		fmt.Println(err)
-// 		return err
-// 	}
-
-// 	// 将协议消息转换为交易对象
-// 	message, err := model.ProtocolToJustification(dkg.Suite, pmessage)
-// 	if err != nil {
-// 		return err
-// 	}
-
-// 	// 如果消息索引与当前节点ID不匹配，则忽略该消息
-// 	// 这里假设ID为-1表示无效或未初始化的节点
-// 	if dkg.ID() == -1 || uint32(dkg.ID()) != message.DealerIndex {
-// 		return nil
-// 	}
-
-// 	dkg.justifs = append(dkg.justifs, message)
-// 	if len(dkg.responses) < len(dkg.Nodes) {
-// 		// 如果交易数量小于阈值，则返回错误
-// 		return nil
-// 	}
-
-// 	// 调用分布式密钥生成器的 ProcessJustification 方法处理合理性证明消息
-// 	res, err := dkg.DistKeyGenerator.ProcessJustifications(dkg.justifs)
-// 	if err != nil || res == nil {
-// 		// 如果处理过程中发生错误，返回一个带有错误详情的新错误
-// 		return fmt.Errorf("ProcessJustification: %w", err)
-// 	}
-
-// 	dkg.results = res
-// 	dkg.DkgKeyShare = model.DistKeyShare{
-// 		Commits:  res.Key.Commits,
-// 		PriShare: res.Key.Share,
-// 	}
-// 	dkg.DkgPubKey = res.Key.Public()
-
-// 	// 保存密钥份额
-// 	dkg.saveStore()
-
-// 	// 如果一切顺利，返回nil表示没有错误
-// 	return nil
-// }
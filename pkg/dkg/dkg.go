@@ -20,17 +20,23 @@ type DKG struct {
 	Suite suites.Suite
 	// Signer 是用于签名的私钥
 	Signer *model.PrivKey
+	// Group 密钥组，空为全网的 DKG
+	Group string
 	// DistKeyGenerator
 	DistKeyGenerator *pedersen.DistKeyGenerator
 
-	// Threshold 是密钥重建所需的最小份额数量
+	// Threshold 是密钥重建所需的最小份额数量，由 Policy 计算
 	Threshold int
+	// 当前 epoch 的门限策略
+	Policy model.ThresholdPolicy
 
 	// epoch data
 	Nodes       []*model.Validator
 	Epoch       uint32
 	DkgPubKey   *model.PubKey // dkg key
 	DkgKeyShare *model.DistKeyShare
+	// epoch 内已完成的份额刷新序号
+	Refresh uint64
 
 	// next epoch data
 	NewNodes        []*model.Validator
@@ -39,36 +45,88 @@ type DKG struct {
 	NewDkgKeyShare  *model.DistKeyShare
 	NewEpochSponsor *model.Validator
 	NewEpochTime    int64
+	NewPolicy       model.ThresholdPolicy
+	NewRefresh      uint64
 
 	// cache the deal, response, justification, result
 	deals     map[string]*model.DealBundle
 	responses map[string]*pedersen.ResponseBundle
 	justifs   []*pedersen.JustificationBundle
+	// 被投诉的 dealer => 投诉的节点，nil 表示不在证明阶段
+	complaints  map[uint32][]uint32
+	justifTimer *time.Timer
+	// 持久化的轮次状态，重启后恢复
+	round *dkgRound
+	// 本节点开始轮次前收到的 response 和 justification
+	early []*model.DkgMessage
+	// 轮次完成前已经切换到新 epoch
+	toNewEpochPending bool
 
 	// mainChan is the channel to receive out message
 	mainChain *model.PersistChan[*model.DkgMessage]
+	// 本节点产生的命令（证明超时、终止和重启轮次），不经过 p2p，和消息在同一个 goroutine 中执行
+	cmds chan func()
+	// 消息循环已停止
+	stopped chan struct{}
 
 	// Consensus is running
 	lastConsensusTime    int64
 	failConsensusTimer   *time.Timer
 	consensusSuccessBack func(*DssSigner, uint64)
 	consensusFailBack    func(error)
+	refreshDoneBack      func(*model.ShareRefresh)
+	groupDoneBack        func(*model.KeyGroupEpoch)
+
+	// 份额恢复托管的运维人员，nil 表示未开启
+	recoveryOperators []*model.PubKey
+	recoveryThreshold int
 
 	// cache
 	NewEpochPartialSigTime int64
 	NewEpochPartialSigs    map[string]*model.NewEpochMsg
 
+	// 没有主链时新 epoch 不需要主链交易的部分签名，见 WithoutEpochSign
+	skipPartialSign bool
+
 	// 未初始化状态 => 0 | 初始化成功 => 1
 	status uint8
 	// dkg loger
 	log pedersen.Logger
 }
 
+// Option DKG 的可选配置
+type Option func(*DKG)
+
+// WithoutEpochSign 新 epoch 不需要主链交易的部分签名，发起者收到门限数量的节点完成后直接回调成功
+// 用于没有主链的测试和模拟网络
+func WithoutEpochSign() Option {
+	return func(dkg *DKG) {
+		dkg.skipPartialSign = true
+	}
+}
+
 // NewDKG 创建一个新的  DKG 实例
 func NewDKG(
 	NodeSecret *model.PrivKey,
 	peer p2peer.Peer,
 	log pedersen.Logger,
+	opts ...Option,
+) (*DKG, error) {
+	dkg, err := newDKG("", NodeSecret, peer, log)
+	if err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		opt(dkg)
+	}
+	return dkg, nil
+}
+
+func newDKG(
+	group string,
+	NodeSecret *model.PrivKey,
+	peer p2peer.Peer,
+	log pedersen.Logger,
 ) (*DKG, error) {
 	if log == nil {
 		log = NoLogger{}
@@ -78,10 +136,13 @@ func NewDKG(
 	dkg := &DKG{
 		Suite:     suites.MustFind("Ed25519"),
 		Signer:    NodeSecret,
+		Group:     group,
 		Peer:      peer,
 		log:       log,
 		deals:     make(map[string]*model.DealBundle),
 		responses: make(map[string]*pedersen.ResponseBundle),
+		cmds:      make(chan func(), 64),
+		stopped:   make(chan struct{}),
 	}
 
 	dkg.Peer.Sub("dkg", dkg.DkgOutHandler)
@@ -92,7 +153,8 @@ func NewDKG(
 		return nil, fmt.Errorf("restore dkg: %w", err)
 	}
 
-	dkg.mainChain, err = model.NewPersistChan[*model.DkgMessage]("dkg", 1000)
+	// 消息队列按节点保存，同一进程运行多个节点时互不影响
+	dkg.mainChain, err = model.NewPersistChan[*model.DkgMessage]("dkg@"+dkg.storeKey(), 1000)
 	if err != nil {
 		return nil, fmt.Errorf("create dkg persist chan: %w", err)
 	}
@@ -109,12 +171,43 @@ func (dkg *DKG) DkgOutHandler(data any) error {
 // Start DKG service
 func (dkg *DKG) Start() error {
 	util.LogOk("DKG", "Start")
-	dkg.mainChain.Start(dkg.handleDkg)
+
+	// 恢复重启前未完成的轮次
+	if err := dkg.resumeRound(); err != nil {
+		util.LogError("DKG", "resume round error", err)
+	}
+
+	dkg.mainChain.Run(dkg.handleDkg, dkg.cmds)
 	return nil
 }
 
+// runLocal 在 DKG 的消息循环中执行本节点的命令，DKG 已停止时丢弃
+func (dkg *DKG) runLocal(fn func()) {
+	select {
+	case dkg.cmds <- fn:
+	case <-dkg.stopped:
+	}
+}
+
+// RunInLoop 在 DKG 的消息循环中执行 fn 并等待完成，用于在其他 goroutine 中读取 DKG 的状态
+// DKG 已停止时返回 false
+func (dkg *DKG) RunInLoop(fn func()) bool {
+	done := make(chan struct{})
+	dkg.runLocal(func() {
+		fn()
+		close(done)
+	})
+	select {
+	case <-done:
+		return true
+	case <-dkg.stopped:
+		return false
+	}
+}
+
 // Stop DKG
 func (dkg *DKG) Stop() {
+	close(dkg.stopped)
 	dkg.mainChain.Stop()
 }
 
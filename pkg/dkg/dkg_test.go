@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
 
 	"github.com/wetee-dao/tee-dsecret/pkg/model"
 	"github.com/wetee-dao/tee-dsecret/pkg/network/local"
@@ -34,9 +36,6 @@ func TestNetwork(t *testing.T) {
 	}
 	defer db.Close()
 
-	// Skip partial sign
-	skipPartialSign = true
-
 	nodes := []*model.PubKey{}
 	validators := []*model.Validator{}
 	for _, s := range peerSecret {
@@ -64,35 +63,19 @@ func TestNetwork(t *testing.T) {
 
 		dkg, err := NewDKG(nodeSecret, peers[i], Logger{
 			NodeTag: "NODE " + fmt.Sprint(i),
-		})
+		}, WithoutEpochSign())
 		require.NoErrorf(t, err, "failed NewDKG")
 		go dkg.Start()
 
 		dkgs = append(dkgs, dkg)
 	}
 
-	dkg_pubkey := new(model.PubKey)
-	err = dkgs[0].TryEpochConsensus(model.ConsensusMsg{
-		Validators: validators,
-		Epoch:      1,
-	}, func(signer *DssSigner, nodeId uint64) {
-		util.LogWithBlue("CONSENSUS SUCCESS", nodeId)
-		for _, dkg := range dkgs {
-			dkg.ToNewEpoch()
-		}
-	}, func(error) {
-		util.LogWithBlue("CONSENSUS Error", err.Error())
-	})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	time.Sleep(time.Second * 1)
+	tryEpoch(t, dkgs, validators, 1)
 
 	for _, d := range dkgs {
-		util.LogWithYellow("V0 |||", d.DkgKeyShare.PriShare().String())
+		util.LogWithYellow("V0 |||", priShare(d))
 	}
-	dkg_pubkey = dkgs[0].DkgPubKey
+	dkg_pubkey := inLoop(dkgs[0], func() *model.PubKey { return dkgs[0].DkgPubKey })
 
 	util.LogWithGreen("----------------------------------------------------------------------------------------------------")
 
@@ -128,60 +111,214 @@ func TestNetwork(t *testing.T) {
 		// 创建 DKG 实例
 		dkg, err := NewDKG(nodeSecret, peers[3+i], Logger{
 			NodeTag: "NODE " + fmt.Sprint(3+i),
-		})
+		}, WithoutEpochSign())
 		require.NoErrorf(t, err, "failed NewDKG")
 		go dkg.Start()
 
 		dkgs = append(dkgs, dkg)
 	}
 
-	err = dkgs[0].TryEpochConsensus(model.ConsensusMsg{
-		Validators: validators,
-		Epoch:      2,
-	}, func(signer *DssSigner, nodeId uint64) {
-		// util.LogWithBlue("CONSENSUS SUCCESS", pub, sig, "====", Sr25519Verify(pubkey, pub.ToBytes(), sig))
-		for _, dkg := range dkgs {
-			dkg.ToNewEpoch()
-		}
-	}, func(error) {
-		util.LogWithBlue("CONSENSUS Error", err.Error())
-	})
+	tryEpoch(t, dkgs, validators, 2)
+
+	for _, d := range dkgs {
+		util.LogWithCyan("V1 |||", priShare(d))
+	}
+
+	util.LogWithGreen("----------------------------------------------------------------------------------------------------")
+
+	tryEpoch(t, dkgs, validators, 3)
+
+	for _, d := range dkgs {
+		util.LogWithCyan("V2 |||", priShare(d))
+	}
+
+	pubkey := inLoop(dkgs[0], func() string { return dkgs[0].DkgPubKey.SS58() })
+	fmt.Println("dkg pubkey", pubkey)
+	fmt.Println("dkg pubkey", dkg_pubkey.SS58())
+	if pubkey != dkg_pubkey.SS58() {
+		t.Fatal("dkg pubkey error")
+	}
+
+	util.LogWithGreen("----------------------------------------------------------------------------------------------------")
 
+	// 同一 epoch 内刷新份额，DKG 公钥不变
+	oldShare := priShare(dkgs[0])
+	for _, d := range dkgs {
+		d.SetRefreshCallback(func(r *model.ShareRefresh) {
+			for _, d := range dkgs {
+				d.runLocal(func() {
+					if err := d.ApplyRefresh(r.Epoch, r.Refresh, r.Commits); err != nil {
+						t.Error(err)
+					}
+				})
+			}
+		})
+	}
+	err = dkgs[0].TryShareRefresh(1)
 	if err != nil {
 		t.Fatal(err)
 	}
-	time.Sleep(time.Second * 1)
+
+	waitDkgs(t, dkgs, func(d *DKG) bool { return d.Refresh == 1 })
 
 	for _, d := range dkgs {
-		util.LogWithCyan("V1 |||", d.DkgKeyShare.PriShare().String())
+		var (
+			epoch   uint32
+			refresh uint64
+			pubkey  string
+			valid   bool
+		)
+		d.RunInLoop(func() {
+			util.LogWithCyan("R1 |||", d.DkgKeyShare.PriShare().String())
+			epoch, refresh, pubkey = d.Epoch, d.Refresh, d.DkgPubKey.SS58()
+			pubPoly := share.NewPubPoly(d.Suite, nil, d.DkgKeyShare.Commitments())
+			valid = pubPoly.Check(d.DkgKeyShare.PriShare())
+		})
+		require.EqualValues(t, 3, epoch)
+		require.EqualValues(t, 1, refresh)
+		require.Equal(t, dkg_pubkey.SS58(), pubkey)
+		require.True(t, valid)
 	}
+	require.NotEqual(t, oldShare, priShare(dkgs[0]))
+}
 
-	util.LogWithGreen("----------------------------------------------------------------------------------------------------")
+// 门限策略之前创建的 epoch 没有保存策略，升级重启后刷新份额保持原来的门限 n*2/3
+func TestLegacyThreshold(t *testing.T) {
+	os.RemoveAll("./chain_data")
+	db, err := model.NewDB()
+	require.NoError(t, err)
+	defer func() {
+		db.Close()
+		os.RemoveAll("./chain_data")
+	}()
 
-	err = dkgs[0].TryEpochConsensus(model.ConsensusMsg{
+	secrets := append(append([]string{}, peerSecret...), newPeerSecret[0])
+	nodes := []*model.PubKey{}
+	validators := []*model.Validator{}
+	for _, s := range secrets {
+		nodeSecret, _ := model.PrivateKeyFromHex(s)
+		nodes = append(nodes, nodeSecret.GetPublic())
+		validators = append(validators, &model.Validator{
+			ValidatorId: *nodeSecret.GetPublic(),
+			P2pId:       *nodeSecret.GetPublic(),
+		})
+	}
+
+	// 旧版本保存的 epoch 1：4 个节点门限 4*2/3 = 2，默认策略的门限为 3
+	suite := suites.MustFind("Ed25519")
+	poly := share.NewPriPoly(suite, 2, nil, suite.RandomStream())
+	_, commits := poly.Commit(nil).Info()
+	dkgPub, err := model.PubKeyFromPoint(commits[0])
+	require.NoError(t, err)
+	require.Equal(t, 3, model.DefaultThresholdPolicy().Threshold(len(nodes)))
+	for i, pub := range nodes {
+		require.NoError(t, model.SetJson("DKG", pub.SS58(), &DKGStore{
+			Nodes:     validators,
+			Threshold: 2,
+			Epoch:     1,
+			DkgPubKey: dkgPub,
+			DkgKeyShare: &model.DistKeyShare{
+				CommitsWrap:  model.KyberPoints{Public: commits},
+				PriShareWrap: model.PriShare{PriShare: poly.Eval(uint32(i))},
+			},
+		}))
+	}
+
+	dkgs := make([]*DKG, 0, len(nodes))
+	for i, s := range secrets {
+		nodeSecret, _ := model.PrivateKeyFromHex(s)
+		peer, err := local.NewNetwork(nodeSecret, []string{}, nodes, uint32(0), uint32(0))
+		require.NoError(t, err)
+
+		d, err := NewDKG(nodeSecret, peer, Logger{NodeTag: "NODE " + fmt.Sprint(i)}, WithoutEpochSign())
+		require.NoError(t, err)
+		require.Equal(t, 2, d.Threshold)
+		require.True(t, d.Policy.IsLegacy())
+		go d.Start()
+		defer d.Stop()
+		dkgs = append(dkgs, d)
+	}
+
+	for _, d := range dkgs {
+		d.SetRefreshCallback(func(r *model.ShareRefresh) {
+			for _, d := range dkgs {
+				d.runLocal(func() {
+					if err := d.ApplyRefresh(r.Epoch, r.Refresh, r.Commits); err != nil {
+						t.Error(err)
+					}
+				})
+			}
+		})
+	}
+	require.NoError(t, inLoop(dkgs[0], func() error { return dkgs[0].TryShareRefresh(1) }))
+	waitDkgs(t, dkgs, func(d *DKG) bool { return d.Refresh == 1 })
+
+	for _, d := range dkgs {
+		var (
+			threshold, degree int
+			legacy            bool
+			pubkey            string
+		)
+		d.RunInLoop(func() {
+			threshold, legacy = d.Threshold, d.Policy.IsLegacy()
+			degree = len(d.DkgKeyShare.Commitments())
+			pubkey = d.DkgPubKey.SS58()
+		})
+		require.Equal(t, 2, threshold)
+		require.Equal(t, 2, degree)
+		require.True(t, legacy)
+		require.Equal(t, dkgPub.SS58(), pubkey)
+	}
+}
+
+// tryEpoch 发起新 epoch 的共识，和侧链打包 EpochEnd 一样，发起者完成后所有节点切换到新份额
+func tryEpoch(t *testing.T, dkgs []*DKG, validators []*model.Validator, epoch uint32) {
+	done := make(chan error, 1)
+	err := dkgs[0].TryEpochConsensus(model.ConsensusMsg{
 		Validators: validators,
-		Epoch:      3,
+		Epoch:      epoch,
 	}, func(signer *DssSigner, nodeId uint64) {
-		// util.LogWithBlue("CONSENSUS SUCCESS", pub, sig, "====", Sr25519Verify(pubkey, pub.ToBytes(), sig))
-		for _, dkg := range dkgs {
-			dkg.ToNewEpoch()
+		util.LogWithBlue("CONSENSUS SUCCESS", nodeId)
+		select {
+		case done <- nil:
+		default:
+		}
+	}, func(err error) {
+		select {
+		case done <- err:
+		default:
 		}
-	}, func(error) {
-		util.LogWithBlue("CONSENSUS Error", err.Error())
 	})
-	if err != nil {
-		t.Fatal(err)
-	}
+	require.NoError(t, err)
 
-	time.Sleep(time.Second * 1)
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatalf("epoch %d timeout", epoch)
+	}
 
+	// 门限之外的节点可能还没有完成
+	waitDkgs(t, dkgs, func(d *DKG) bool { return d.NewDkgKeyShare != nil })
 	for _, d := range dkgs {
-		util.LogWithCyan("V2 |||", d.DkgKeyShare.PriShare().String())
+		d.ToNewEpoch()
 	}
+	waitDkgs(t, dkgs, func(d *DKG) bool { return d.NewDkgKeyShare == nil })
+}
 
-	fmt.Println("dkg pubkey", dkgs[0].DkgPubKey.SS58())
-	fmt.Println("dkg pubkey", dkg_pubkey.SS58())
-	if dkgs[0].DkgPubKey.SS58() != dkg_pubkey.SS58() {
-		t.Fatal("dkg pubkey error")
-	}
+// waitDkgs 等待所有节点满足 cond，cond 在节点的消息循环中执行
+func waitDkgs(t *testing.T, dkgs []*DKG, cond func(d *DKG) bool) {
+	require.Eventually(t, func() bool {
+		for _, d := range dkgs {
+			if !inLoop(d, func() bool { return cond(d) }) {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+// priShare 在消息循环中读取本节点的份额
+func priShare(d *DKG) string {
+	return inLoop(d, func() string { return d.DkgKeyShare.PriShare().String() })
 }
@@ -125,7 +125,7 @@ func (d *DssSigner) PubList() ([]kyber.Point, *model.DistKeyShare, *model.DistKe
 		for _, k := range d.dkg.NewNodes {
 			pubs = append(pubs, k.ValidatorId.Point())
 		}
-		return pubs, d.dkg.NewDkgKeyShare, d.dkg.NewDkgKeyShare, len(d.dkg.NewNodes) * 2 / 3
+		return pubs, d.dkg.NewDkgKeyShare, d.dkg.NewDkgKeyShare, d.dkg.newThreshold()
 	}
 
 	for _, k := range d.dkg.Nodes {
@@ -10,15 +10,13 @@ import (
 	"github.com/wetee-dao/tee-dsecret/pkg/util"
 )
 
-var skipPartialSign = false
-
 func (dkg *DKG) SendNewEpochPartialSigToSponsor() {
 	if dkg.NewEpochSponsor == nil {
 		return
 	}
 
 	// skip partial sign return []byte for util test
-	if skipPartialSign {
+	if dkg.skipPartialSign {
 		msg := model.NewEpochMsg{}
 		bt, _ := json.Marshal(msg)
 		dkg.sendToNode(model.SendToNode(&dkg.NewEpochSponsor.P2pId), &model.DkgMessage{
@@ -33,12 +31,12 @@ func (dkg *DKG) SendNewEpochPartialSigToSponsor() {
 		return
 	}
 
-	client := chains.MainChain.GetClient()
+	client := chains.MainChain
 
 	h160 := dkg.NewDkgPubKey.H160()
-	_, isSome, err := revive.GetOriginalAccountLatest(client.Api().RPC.State, h160)
+	isSome, err := client.IsAccountMapped(h160)
 	if err != nil {
-		util.LogWithRed("DKG SendNewEpochPartialSigToSponsor", "GetOriginalAccountLatest error:"+err.Error())
+		util.LogWithRed("DKG SendNewEpochPartialSigToSponsor", "IsAccountMapped error:"+err.Error())
 		return
 	}
 
@@ -94,18 +92,35 @@ func (dkg *DKG) RevPartialSig(OrgId string, data []byte) error {
 		return err
 	}
 
+	return dkg.receivePartialSig(OrgId, msg)
+}
+
+// receivePartialSig 保存新 epoch 的部分签名，达到门限后提交到主链
+func (dkg *DKG) receivePartialSig(OrgId string, msg *model.NewEpochMsg) error {
+	if !dkg.ConsensusIsbusy() {
+		return nil
+	}
+
 	if dkg.NewEpochPartialSigs == nil || dkg.NewEpochPartialSigTime != dkg.NewEpochTime {
 		dkg.NewEpochPartialSigs = make(map[string]*model.NewEpochMsg)
 		dkg.NewEpochPartialSigTime = dkg.NewEpochTime
 	}
 
 	dkg.NewEpochPartialSigs[OrgId] = msg
-	if len(dkg.NewEpochPartialSigs) <= dkg.Threshold {
+	if dkg.roundActive() {
+		dkg.round.PartialSigs[OrgId] = msg
+		dkg.saveRound()
+	}
+	if len(dkg.NewEpochPartialSigs) < dkg.newThreshold() {
 		return nil
 	}
 
 	// add for test
-	if skipPartialSign {
+	// 只在刚好达到门限时回调一次，之后迟到的部分签名忽略
+	if dkg.skipPartialSign {
+		if len(dkg.NewEpochPartialSigs) != dkg.newThreshold() {
+			return nil
+		}
 		dkg.consensusSuccessBack(&DssSigner{}, dkg.NewEpochSponsor.NodeID)
 		return nil
 	}
@@ -118,11 +133,11 @@ func (dkg *DKG) RevPartialSig(OrgId string, data []byte) error {
 	}
 
 	// check key is has been mapped
-	client := chains.MainChain.GetClient()
+	client := chains.MainChain
 	h160 := dkg.NewDkgPubKey.H160()
-	_, isSome, err := revive.GetOriginalAccountLatest(client.Api().RPC.State, h160)
+	isSome, err := client.IsAccountMapped(h160)
 	if err != nil {
-		dkg.consensusFailBack(errors.New("SendNewEpochPartialSigToSponsor GetOriginalAccountLatest error:" + err.Error()))
+		dkg.consensusFailBack(errors.New("SendNewEpochPartialSigToSponsor IsAccountMapped error:" + err.Error()))
 		return errors.New("SendNewEpochPartialSigToSponsor error:" + err.Error())
 	}
 
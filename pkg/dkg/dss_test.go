@@ -53,29 +53,14 @@ func TestDSS(t *testing.T) {
 
 		dkg, err := NewDKG(nodeSecret, peers[i], Logger{
 			NodeTag: "NODE " + fmt.Sprint(i),
-		})
+		}, WithoutEpochSign())
 		require.NoErrorf(t, err, "failed NewDKG")
 		go dkg.Start()
 
 		dkgs = append(dkgs, dkg)
 	}
 
-	err = dkgs[0].TryEpochConsensus(model.ConsensusMsg{
-		Validators: validators,
-		Epoch:      1,
-	}, func(signer *DssSigner, nodeId uint64) {
-		util.LogWithBlue("CONSENSUS SUCCESS", nodeId)
-		for _, dkg := range dkgs {
-			dkg.ToNewEpoch()
-		}
-	}, func(error) {
-		util.LogWithBlue("CONSENSUS Error", err.Error())
-	})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	time.Sleep(time.Second * 1)
+	tryEpoch(t, dkgs, validators, 1)
 
 	msg := []byte("hello word")
 	signers := []DssSigner{}
@@ -177,7 +162,7 @@ func TestDssSubmitTx(t *testing.T) {
 			panic(err)
 		}
 
-		client := chains.MainChain.GetClient()
+		client := chains.MainChain
 		err = client.SignAndSubmit(signer, *call, false, 0)
 		fmt.Println(err)
 
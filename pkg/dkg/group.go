@@ -0,0 +1,146 @@
+package dkg
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	p2peer "github.com/wetee-dao/tee-dsecret/pkg/network"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	pedersen "go.dedis.ch/kyber/v4/share/dkg/pedersen"
+)
+
+// 密钥组
+// 每个密钥组使用部分验证节点运行单独的 DKG 实例，有自己的门限策略和 epoch
+// 所有实例共用 P2P 的 dkg 通道，消息使用 DkgMessage.Group 区分，空为全网的 DKG
+// 密钥组的 DKG 完成后节点通知发起者，发起者收到 quorum 个节点后提交 KeyGroupEpoch 交易，交易提交后切换到新份额
+
+// GroupMux 按密钥组分发 dkg 通道的消息
+type GroupMux struct {
+	peer     p2peer.Peer
+	mu       sync.RWMutex
+	handlers map[string]func(any) error
+}
+
+// NewGroupMux 接管 peer 的 dkg 通道
+func NewGroupMux(peer p2peer.Peer) *GroupMux {
+	m := &GroupMux{
+		peer:     peer,
+		handlers: make(map[string]func(any) error),
+	}
+	peer.Sub("dkg", m.dispatch)
+	return m
+}
+
+// Peer 密钥组使用的 peer，发送的 dkg 消息带上密钥组
+func (m *GroupMux) Peer(group string) p2peer.Peer {
+	return &groupPeer{mux: m, group: group}
+}
+
+func (m *GroupMux) dispatch(data any) error {
+	msg, ok := data.(*model.DkgMessage)
+	if !ok {
+		return errors.New("group mux: invalid dkg message")
+	}
+
+	m.mu.RLock()
+	handler := m.handlers[msg.Group]
+	m.mu.RUnlock()
+
+	// 本节点不是密钥组成员
+	if handler == nil {
+		util.LogWithGray("DKG Group", "drop message of unknown group", msg.Group, msg.Type)
+		return nil
+	}
+	return handler(msg)
+}
+
+type groupPeer struct {
+	mux   *GroupMux
+	group string
+}
+
+func (p *groupPeer) Send(to *model.To, message any) error {
+	if msg, ok := message.(*model.DkgMessage); ok {
+		msg.Group = p.group
+	}
+	return p.mux.peer.Send(to, message)
+}
+
+func (p *groupPeer) Sub(topic string, handler func(any) error) error {
+	if topic != "dkg" {
+		return p.mux.peer.Sub(topic, handler)
+	}
+
+	p.mux.mu.Lock()
+	defer p.mux.mu.Unlock()
+	p.mux.handlers[p.group] = handler
+	return nil
+}
+
+func (p *groupPeer) AvailableNodes() []*model.PubKey {
+	return p.mux.peer.AvailableNodes()
+}
+
+func (p *groupPeer) AllNodes() []*model.PubKey {
+	return p.mux.peer.AllNodes()
+}
+
+// NewGroupDKG 创建密钥组的 DKG 实例，状态按密钥组保存
+func NewGroupDKG(
+	group string,
+	NodeSecret *model.PrivKey,
+	mux *GroupMux,
+	log pedersen.Logger,
+) (*DKG, error) {
+	if group == "" {
+		return nil, errors.New("key group id is empty")
+	}
+	return newDKG(group, NodeSecret, mux.Peer(group), log)
+}
+
+// storeKey 本节点 DKG 状态的数据库 key
+func (dkg *DKG) storeKey() string {
+	if dkg.Group == "" {
+		return dkg.Signer.GetPublic().SS58()
+	}
+	return dkg.Signer.GetPublic().SS58() + "@" + dkg.Group
+}
+
+// groupRound 密钥组轮次完成后和份额刷新一样等待发起者提交交易
+func (dkg *DKG) groupRound(msg *model.ConsensusMsg) bool {
+	return dkg.Group != "" && msg.Refresh == 0
+}
+
+// TryGroupEpoch 本节点作为发起者，使用新的成员和门限策略开始密钥组的下一个 epoch
+func (dkg *DKG) TryGroupEpoch(members []*model.Validator, epoch uint32, policy model.ThresholdPolicy) error {
+	if dkg.Group == "" {
+		return errors.New("dkg is not a key group")
+	}
+	if epoch <= dkg.Epoch {
+		return fmt.Errorf("key group %s epoch %d is done", dkg.Group, epoch)
+	}
+
+	return dkg.TryEpochConsensus(model.ConsensusMsg{
+		Validators: members,
+		Epoch:      epoch,
+		Policy:     policy,
+	}, dkg.consensusSuccessBack, dkg.consensusFailBack)
+}
+
+// ApplyGroupEpoch KeyGroupEpoch 交易提交后切换到新份额，本节点还未完成时完成后切换
+func (dkg *DKG) ApplyGroupEpoch(epoch uint32, commits []byte) error {
+	if dkg.Epoch >= epoch {
+		return nil
+	}
+	if dkg.NewEpoch != epoch {
+		return fmt.Errorf("node did not join key group %s epoch %d", dkg.Group, epoch)
+	}
+	return dkg.applyRound(commits)
+}
+
+// SetGroupCallback 设置密钥组 DKG 完成的回调，发起者收到 quorum 个节点后调用
+func (dkg *DKG) SetGroupCallback(done func(*model.KeyGroupEpoch)) {
+	dkg.groupDoneBack = done
+}
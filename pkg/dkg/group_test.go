@@ -0,0 +1,112 @@
+package dkg
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v4/share"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/network/local"
+)
+
+func TestKeyGroup(t *testing.T) {
+	os.RemoveAll("./chain_data")
+
+	db, err := model.NewDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	secrets := make([]*model.PrivKey, 4)
+	nodes := make([]*model.PubKey, 4)
+	validators := make([]*model.Validator, 4)
+	for i := range secrets {
+		secrets[i], nodes[i], err = model.GenerateEd25519KeyPair(rand.Reader)
+		require.NoError(t, err)
+		validators[i] = &model.Validator{ValidatorId: *nodes[i], P2pId: *nodes[i]}
+	}
+
+	muxes := make([]*GroupMux, 4)
+	for i, s := range secrets {
+		peer, err := local.NewNetwork(s, []string{}, nodes, uint32(0), uint32(0))
+		require.NoError(t, err)
+		muxes[i] = NewGroupMux(peer)
+	}
+
+	// 密钥组 a 为节点 0,1,2，密钥组 b 为节点 1,2,3，其他节点丢弃不属于自己的消息
+	groups := map[string][]*DKG{}
+	join := func(group string, i int) {
+		d, err := NewGroupDKG(group, secrets[i], muxes[i], Logger{NodeTag: group + " NODE " + fmt.Sprint(i)})
+		require.NoError(t, err)
+		d.SetGroupCallback(func(r *model.KeyGroupEpoch) {
+			require.Equal(t, group, r.Id)
+			for _, d := range groups[group] {
+				d.runLocal(func() {
+					if err := d.ApplyGroupEpoch(r.Epoch, r.DkgCommits); err != nil {
+						t.Error(err)
+					}
+				})
+			}
+		})
+		go d.Start()
+		groups[group] = append(groups[group], d)
+	}
+	for _, i := range []int{0, 1, 2} {
+		join("a", i)
+	}
+	for _, i := range []int{1, 2, 3} {
+		join("b", i)
+	}
+
+	require.NoError(t, groups["a"][0].TryGroupEpoch(validators[:3], 1, model.ThresholdPolicy{}))
+	require.NoError(t, groups["b"][0].TryGroupEpoch(validators[1:], 1, model.ThresholdPolicy{}))
+
+	groupDone := func(group string, epoch uint32) bool {
+		for _, d := range groups[group] {
+			if !inLoop(d, func() bool { return d.Epoch == epoch && d.DkgKeyShare != nil }) {
+				return false
+			}
+		}
+		return true
+	}
+	// 在消息循环中读取公钥，检查份额和承诺一致
+	keyState := func(d *DKG) (string, bool) {
+		var valid bool
+		pub := inLoop(d, func() string {
+			pubPoly := share.NewPubPoly(d.Suite, nil, d.DkgKeyShare.Commitments())
+			valid = pubPoly.Check(d.DkgKeyShare.PriShare())
+			return d.DkgPubKey.SS58()
+		})
+		return pub, valid
+	}
+	require.Eventually(t, func() bool { return groupDone("a", 1) && groupDone("b", 1) }, 10*time.Second, 100*time.Millisecond)
+
+	pubA, _ := keyState(groups["a"][0])
+	pubB, _ := keyState(groups["b"][0])
+	require.NotEqual(t, pubA, pubB)
+	for group, list := range groups {
+		first, _ := keyState(groups[group][0])
+		for _, d := range list {
+			pub, valid := keyState(d)
+			require.Equal(t, first, pub)
+			require.True(t, valid)
+		}
+	}
+
+	// 节点 3 加入密钥组 a，reshare 后公钥不变
+	join("a", 3)
+	require.NoError(t, groups["a"][1].TryGroupEpoch(validators, 2, model.ThresholdPolicy{}))
+	require.Eventually(t, func() bool { return groupDone("a", 2) }, 10*time.Second, 100*time.Millisecond)
+
+	for _, d := range groups["a"] {
+		require.Len(t, inLoop(d, func() []*model.Validator { return d.Nodes }), 4)
+		pub, valid := keyState(d)
+		require.Equal(t, pubA, pub)
+		require.True(t, valid)
+	}
+	require.EqualValues(t, 1, inLoop(groups["b"][0], func() uint32 { return groups["b"][0].Epoch }))
+}
@@ -9,6 +9,7 @@ import (
 )
 
 // HandleDkg 处理不同的DKG消息类型
+// 证明超时、终止和重启轮次是本节点的命令，通过 runLocal 执行，不接受其他节点发送
 // msg: 被处理的消息对象
 // 返回：可能的错误
 func (dkg *DKG) handleDkg(msg *model.DkgMessage) error {
@@ -29,6 +30,13 @@ func (dkg *DKG) handleDkg(msg *model.DkgMessage) error {
 			util.LogError("DEAL <<<<<<<< ERROR", "SideKeyRebuild:", err)
 		}
 		return err
+	case "refresh_done":
+		// 发起者收集完成份额刷新的节点
+		err := dkg.handleRefreshAck(msg.From, msg.Payload)
+		if err != nil {
+			util.LogError("DEAL <<<<<<<< ERROR", "HandleRefreshAck:", err)
+		}
+		return err
 	case "deal":
 		// 处理交易消息
 		err := dkg.handleDeal(msg.From, msg.Payload)
@@ -43,13 +51,13 @@ func (dkg *DKG) handleDkg(msg *model.DkgMessage) error {
 			util.LogError("DEAL <<<<<<<<<<<<<<<< ERROR", "HandleDealResp:", err)
 		}
 		return err
-	// case "justification":
-	// 	// 处理证明消息
-	// 	err := dkg.HandleJustification(msg.From, msg.Payload)
-	// 	if err != nil {
-	// 		util.LogError("DEAL <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<< ERROR", "HandleJustification:", err)
-	// 	}
-	// 	return err
+	case "justification":
+		// 处理证明消息
+		err := dkg.handleJustification(msg.From, msg.Payload)
+		if err != nil {
+			util.LogError("DEAL <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<< ERROR", "HandleJustification:", err)
+		}
+		return err
 	default:
 		// 如果消息类型未知，返回错误
 		return fmt.Errorf("unknown message type: %s", msg.Type)
@@ -0,0 +1,319 @@
+package dkg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/share"
+	pedersen "go.dedis.ch/kyber/v4/share/dkg/pedersen"
+	"go.dedis.ch/kyber/v4/sign/schnorr"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+// 投诉/证明阶段
+// 节点收到错误的 deal 后在 response 中投诉 dealer，被投诉的 dealer 需要公开被投诉的份额作为证明
+// 所有被投诉的 dealer 都提交了证明或超时后，使用合格的 dealer 完成 DKG
+// 无法证明的 dealer 通过 consensusFailBack 报告，由侧链提交交易保存到状态中用于惩罚
+
+// 等待 dealer 提交证明的时间，需要小于共识超时时间
+var justificationTimeout = time.Second * 10
+
+// DealerFault 无法证明 deal 合法的 dealer
+type DealerFault struct {
+	Epoch       uint32
+	DealerIndex uint32
+	Validator   *model.Validator
+	Reason      string
+}
+
+// DealerFaultError 通过 consensusFailBack 报告作恶的 dealer
+type DealerFaultError struct {
+	Epoch uint32
+	// 密钥组，全网的 DKG 为空
+	Group  string
+	Faults []*DealerFault
+}
+
+func (e *DealerFaultError) Error() string {
+	dealers := make([]string, 0, len(e.Faults))
+	for _, f := range e.Faults {
+		dealers = append(dealers, fmt.Sprintf("%d(%s)", f.DealerIndex, f.Reason))
+	}
+	return fmt.Sprintf("DKG epoch %d dealer faults: %s", e.Epoch, strings.Join(dealers, ", "))
+}
+
+// sendJustification 向所有新节点广播本节点的证明
+func (dkg *DKG) sendJustification(justification *pedersen.JustificationBundle) error {
+	pmessage, err := model.JustificationToProtocol(justification)
+	if err != nil {
+		return err
+	}
+	bt, err := json.Marshal(pmessage)
+	if err != nil {
+		return fmt.Errorf("sendJustification json.Marshal: %w", err)
+	}
+
+	return dkg.sendToNode(model.SendToNodes(dkg.NewNetIds()), &model.DkgMessage{
+		Type:    "justification",
+		Payload: bt,
+	})
+}
+
+// handleJustification 处理 dealer 的证明
+func (dkg *DKG) handleJustification(OrgId string, data []byte) error {
+	pmessage := &model.JustificationBundle{}
+	err := json.Unmarshal(data, pmessage)
+	if err != nil {
+		return err
+	}
+	if !dkg.roundActive() {
+		dkg.cacheEarly("justification", OrgId, data)
+		return nil
+	}
+
+	return dkg.receiveJustification(OrgId, pmessage)
+}
+
+// receiveJustification 保存 dealer 的证明
+// 证明可能在本节点处理完 response 之前到达，所以先缓存
+func (dkg *DKG) receiveJustification(OrgId string, pmessage *model.JustificationBundle) error {
+	if !dkg.roundActive() || dkg.round.Phase == RoundPhaseSign {
+		return nil
+	}
+	if _, ok := dkg.round.Justifs[OrgId]; ok {
+		return nil
+	}
+
+	justification, err := model.ProtocolToJustification(dkg.Suite, pmessage)
+	if err != nil {
+		return err
+	}
+
+	// 只接受 dealer 本身签名的证明，重复的证明会导致 dealer 被驱逐
+	if err := dkg.verifyJustification(OrgId, justification); err != nil {
+		return err
+	}
+	for _, j := range dkg.justifs {
+		if j.DealerIndex == justification.DealerIndex {
+			return nil
+		}
+	}
+	dkg.round.Justifs[OrgId] = pmessage
+	dkg.saveRound()
+	dkg.justifs = append(dkg.justifs, justification)
+
+	return dkg.tryProcessJustifications(false)
+}
+
+// verifyJustification 验证证明由 dealer 发送并签名
+func (dkg *DKG) verifyJustification(OrgId string, justification *pedersen.JustificationBundle) error {
+	if int(justification.DealerIndex) >= len(dkg.Nodes) {
+		return fmt.Errorf("justification dealer index %d out of range", justification.DealerIndex)
+	}
+	dealer := dkg.Nodes[justification.DealerIndex]
+	if dealer.P2pId.String() != OrgId {
+		return fmt.Errorf("justification of dealer %d is not sent by dealer", justification.DealerIndex)
+	}
+
+	hash, err := justification.Hash()
+	if err != nil {
+		return err
+	}
+	err = schnorr.Verify(dkg.Suite, dealer.ValidatorId.Point(), hash, justification.Signature)
+	if err != nil {
+		return fmt.Errorf("justification of dealer %d: %w", justification.DealerIndex, err)
+	}
+	return nil
+}
+
+// waitJustifications 进入证明阶段，超时后使用已收到的证明完成 DKG
+func (dkg *DKG) waitJustifications(complaints map[uint32][]uint32) error {
+	dkg.complaints = complaints
+	if len(complaints) > 0 {
+		epoch := dkg.NewEpoch
+		if dkg.justifTimer != nil {
+			dkg.justifTimer.Stop()
+		}
+		dkg.justifTimer = time.AfterFunc(justificationTimeout, func() {
+			dkg.runLocal(func() {
+				if err := dkg.handleJustificationTimeout(epoch); err != nil {
+					util.LogError("DEAL <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<< ERROR", "HandleJustificationTimeout:", err)
+				}
+			})
+		})
+	}
+
+	return dkg.tryProcessJustifications(false)
+}
+
+// handleJustificationTimeout 证明阶段超时
+func (dkg *DKG) handleJustificationTimeout(epoch uint32) error {
+	if epoch != dkg.NewEpoch {
+		return nil
+	}
+	return dkg.tryProcessJustifications(true)
+}
+
+// tryProcessJustifications 所有被投诉的 dealer 都提交了证明或超时后，使用合格的 dealer 完成 DKG
+func (dkg *DKG) tryProcessJustifications(timeout bool) error {
+	// 尚未进入证明阶段
+	if dkg.complaints == nil || dkg.DistKeyGenerator == nil {
+		return nil
+	}
+	if !timeout {
+		for dealer := range dkg.complaints {
+			if !slices.ContainsFunc(dkg.justifs, func(j *pedersen.JustificationBundle) bool {
+				return j.DealerIndex == dealer
+			}) {
+				return nil
+			}
+		}
+	}
+	if dkg.justifTimer != nil {
+		dkg.justifTimer.Stop()
+	}
+
+	faults := dkg.dealerFaults()
+	justifs := dkg.justifs
+	dkg.complaints = nil
+	dkg.justifs = []*pedersen.JustificationBundle{}
+
+	res, err := dkg.DistKeyGenerator.ProcessJustifications(justifs)
+	dkg.reportDealerFaults(faults)
+	if err != nil || res == nil {
+		dkg.finishDkgConsensusStep(false, "dkg.DistKeyGenerator.ProcessJustifications")
+		if err == nil {
+			err = errors.New("no result")
+		}
+		return fmt.Errorf("ProcessJustifications: %w", err)
+	}
+
+	dkg.NewDkgKeyShare = &model.DistKeyShare{
+		CommitsWrap:  model.KyberPoints{Public: res.Key.Commits},
+		PriShareWrap: model.PriShare{PriShare: res.Key.Share},
+	}
+	dkg.NewDkgPubKey, _ = model.PubKeyFromPoint(res.Key.Public())
+
+	// 保存密钥份额
+	dkg.saveState()
+	dkg.finishDkgConsensusStep(true, "")
+	return nil
+}
+
+// dealerFaults 当前轮次中无法证明 deal 合法的 dealer
+func (dkg *DKG) dealerFaults() []*DealerFault {
+	publics := make(map[uint32][]kyber.Point, len(dkg.deals))
+	for _, d := range dkg.deals {
+		if d != nil && d.DealBundle != nil {
+			publics[d.DealerIndex] = d.Public
+		}
+	}
+
+	reasons := unjustifiedDealers(dkg.Suite, dkg.complaints, publics, dkg.justifs, dkg.newThreshold())
+	faults := make([]*DealerFault, 0, len(reasons))
+	for dealer, reason := range reasons {
+		fault := &DealerFault{
+			Epoch:       dkg.NewEpoch,
+			DealerIndex: dealer,
+			Reason:      reason,
+		}
+		if int(dealer) < len(dkg.Nodes) {
+			fault.Validator = dkg.Nodes[dealer]
+		}
+		faults = append(faults, fault)
+	}
+	slices.SortFunc(faults, func(a, b *DealerFault) int {
+		return int(a.DealerIndex) - int(b.DealerIndex)
+	})
+	return faults
+}
+
+// reportDealerFaults 通过 consensusFailBack 报告作恶的 dealer，由侧链提交交易
+func (dkg *DKG) reportDealerFaults(faults []*DealerFault) {
+	if len(faults) == 0 {
+		return
+	}
+
+	ferr := &DealerFaultError{Epoch: dkg.NewEpoch, Group: dkg.Group, Faults: faults}
+	util.LogWithRed("DKG", ferr.Error())
+	if dkg.consensusFailBack != nil {
+		dkg.consensusFailBack(ferr)
+	}
+}
+
+// newThreshold 新节点使用的门限
+func (dkg *DKG) newThreshold() int {
+	return dkg.NewPolicy.Threshold(len(dkg.NewNodes))
+}
+
+// collectComplaints 从 response 中收集被投诉的 dealer => 投诉的节点
+func collectComplaints(responses map[string]*pedersen.ResponseBundle) map[uint32][]uint32 {
+	complaints := map[uint32][]uint32{}
+	for _, bundle := range responses {
+		if bundle == nil {
+			continue
+		}
+		for _, r := range bundle.Responses {
+			if r.Status == pedersen.Complaint && !slices.Contains(complaints[r.DealerIndex], bundle.ShareIndex) {
+				complaints[r.DealerIndex] = append(complaints[r.DealerIndex], bundle.ShareIndex)
+			}
+		}
+	}
+	return complaints
+}
+
+// unjustifiedDealers 返回无法证明 deal 合法的 dealer 及原因
+// complaints 为被投诉的 dealer => 投诉的节点，publics 为 dealer 的公开多项式承诺
+func unjustifiedDealers(
+	suite suites.Suite,
+	complaints map[uint32][]uint32,
+	publics map[uint32][]kyber.Point,
+	justifs []*pedersen.JustificationBundle,
+	threshold int,
+) map[uint32]string {
+	faults := map[uint32]string{}
+	for dealer, holders := range complaints {
+		// 投诉数量达到门限时 dealer 的多项式可以被恢复，直接驱逐
+		if len(holders) >= threshold {
+			faults[dealer] = "too many complaints"
+			continue
+		}
+
+		coeffs, ok := publics[dealer]
+		if !ok {
+			faults[dealer] = "missing deal"
+			continue
+		}
+		idx := slices.IndexFunc(justifs, func(j *pedersen.JustificationBundle) bool {
+			return j.DealerIndex == dealer
+		})
+		if idx < 0 {
+			faults[dealer] = "no justification"
+			continue
+		}
+
+		pubPoly := share.NewPubPoly(suite, nil, coeffs)
+		for _, holder := range holders {
+			jidx := slices.IndexFunc(justifs[idx].Justifications, func(j pedersen.Justification) bool {
+				return j.ShareIndex == holder
+			})
+			if jidx < 0 {
+				faults[dealer] = fmt.Sprintf("no justification for share %d", holder)
+				break
+			}
+			commit := suite.Point().Mul(justifs[idx].Justifications[jidx].Share, nil)
+			if !commit.Equal(pubPoly.Eval(holder).V) {
+				faults[dealer] = fmt.Sprintf("invalid justification for share %d", holder)
+				break
+			}
+		}
+	}
+	return faults
+}
@@ -0,0 +1,168 @@
+package dkg
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/share"
+	pedersen "go.dedis.ch/kyber/v4/share/dkg/pedersen"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+func TestUnjustifiedDealers(t *testing.T) {
+	suite := suites.MustFind("Ed25519")
+	threshold := 3
+
+	// 4 个 dealer 的多项式
+	polys := make([]*share.PriPoly, 4)
+	publics := map[uint32][]kyber.Point{}
+	for i := range polys {
+		polys[i] = share.NewPriPoly(suite, threshold, nil, suite.RandomStream())
+		_, commits := polys[i].Commit(nil).Info()
+		publics[uint32(i)] = commits
+	}
+
+	responses := map[string]*pedersen.ResponseBundle{
+		"n1": {ShareIndex: 1, Responses: []pedersen.Response{
+			{DealerIndex: 0, Status: pedersen.Complaint},
+			{DealerIndex: 1, Status: pedersen.Complaint},
+			{DealerIndex: 2, Status: pedersen.Complaint},
+			{DealerIndex: 3, Status: pedersen.Complaint},
+		}},
+		"n2": {ShareIndex: 2, Responses: []pedersen.Response{
+			{DealerIndex: 0, Status: pedersen.Success},
+			{DealerIndex: 3, Status: pedersen.Complaint},
+		}},
+		"n4": {ShareIndex: 4, Responses: []pedersen.Response{
+			{DealerIndex: 3, Status: pedersen.Complaint},
+		}},
+	}
+	complaints := collectComplaints(responses)
+	require.Len(t, complaints, 4)
+	require.Len(t, complaints[3], 3)
+
+	justifs := []*pedersen.JustificationBundle{
+		// 正确的证明
+		{DealerIndex: 0, Justifications: []pedersen.Justification{
+			{ShareIndex: 1, Share: polys[0].Eval(1).V},
+		}},
+		// 错误的份额
+		{DealerIndex: 1, Justifications: []pedersen.Justification{
+			{ShareIndex: 1, Share: polys[1].Eval(2).V},
+		}},
+		// dealer 3 的投诉达到门限，证明无效
+		{DealerIndex: 3, Justifications: []pedersen.Justification{
+			{ShareIndex: 1, Share: polys[3].Eval(1).V},
+			{ShareIndex: 2, Share: polys[3].Eval(2).V},
+			{ShareIndex: 4, Share: polys[3].Eval(4).V},
+		}},
+	}
+
+	faults := unjustifiedDealers(suite, complaints, publics, justifs, threshold)
+	require.Len(t, faults, 3)
+	require.NotContains(t, faults, uint32(0))
+	require.Equal(t, "invalid justification for share 1", faults[1])
+	require.Equal(t, "no justification", faults[2])
+	require.Equal(t, "too many complaints", faults[3])
+
+	// 没有投诉
+	require.Empty(t, unjustifiedDealers(suite, collectComplaints(nil), publics, nil, threshold))
+}
+
+// badDeal 修改发给 victim 的 deal，victim 无法解密自己的份额后投诉 dealer
+func badDeal(victim *model.PubKey, index uint32) func(*model.PubKey, *model.DkgMessage) *model.DkgMessage {
+	return func(to *model.PubKey, msg *model.DkgMessage) *model.DkgMessage {
+		if msg.Type != "deal" || to.SS58() != victim.SS58() {
+			return msg
+		}
+		cmsg := &model.ConsensusMsg{}
+		if err := json.Unmarshal(msg.Payload, cmsg); err != nil {
+			return msg
+		}
+		for i, d := range cmsg.DealBundle.Deals {
+			if d.ShareIndex == index {
+				cmsg.DealBundle.Deals[i].EncryptedShare[len(d.EncryptedShare)-1] ^= 1
+			}
+		}
+		msg.Payload, _ = json.Marshal(cmsg)
+		return msg
+	}
+}
+
+func TestJustificationExcludesDealer(t *testing.T) {
+	os.RemoveAll("./chain_data")
+	db, err := model.NewDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	timeout := justificationTimeout
+	justificationTimeout = time.Second
+	defer func() { justificationTimeout = timeout }()
+
+	g := newTestGroup(t, 4)
+
+	// dealer 1 给节点 2 错误的份额，被投诉后提交证明
+	g.peers[1].modify = badDeal(g.nodes[2], 2)
+	// dealer 3 给节点 0 错误的份额，不提交证明
+	// dealer 3 自己认为已经证明，份额和其他节点不一致
+	g.faulty = []int{3}
+	bad := badDeal(g.nodes[0], 0)
+	g.peers[3].modify = func(to *model.PubKey, msg *model.DkgMessage) *model.DkgMessage {
+		if msg.Type == "justification" {
+			return nil
+		}
+		return bad(to, msg)
+	}
+
+	var mu sync.Mutex
+	faults := map[int][]*DealerFault{}
+	for i, d := range g.dkgs {
+		d.SetConsensusCallback(nil, func(err error) {
+			var ferr *DealerFaultError
+			if errors.As(err, &ferr) {
+				mu.Lock()
+				faults[i] = append(faults[i], ferr.Faults...)
+				mu.Unlock()
+			}
+		})
+	}
+
+	// 门限 3，quorum 3，驱逐 dealer 3 后其他节点可以完成
+	policy := model.ThresholdPolicy{Num: 1, Den: 2, QuorumNum: 1, QuorumDen: 2}
+	require.NoError(t, g.dkgs[0].TryGroupEpoch(g.validators, 1, policy))
+	require.Eventually(t, func() bool { return g.done(1) }, 10*time.Second, 100*time.Millisecond)
+
+	// 所有节点都驱逐了 dealer 3
+	mu.Lock()
+	require.Len(t, faults, 4)
+	for _, list := range faults {
+		require.Len(t, list, 1)
+		require.EqualValues(t, 3, list[0].DealerIndex)
+		require.Equal(t, "no justification", list[0].Reason)
+	}
+	mu.Unlock()
+
+	// 公钥由 dealer 0,1,2 的多项式组成，dealer 1 仍然合格
+	expected := g.dkgs[0].Suite.Point().Null()
+	deals := inLoop(g.dkgs[1], func() map[string]*model.DealBundle { return g.dkgs[1].round.Deals })
+	require.Len(t, deals, 4)
+	for _, d := range deals {
+		if d.DealerIndex != 3 {
+			expected = expected.Add(expected, d.Public[0])
+		}
+	}
+	for _, d := range g.dkgs[:3] {
+		pub := inLoop(d, func() *model.PubKey { return d.DkgPubKey })
+		require.True(t, expected.Equal(pub.Point()))
+		keyShare := inLoop(d, func() *model.DistKeyShare { return d.DkgKeyShare })
+		pubPoly := share.NewPubPoly(d.Suite, nil, keyShare.Commitments())
+		require.True(t, pubPoly.Check(keyShare.PriShare()))
+	}
+}
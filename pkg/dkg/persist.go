@@ -14,6 +14,9 @@ type DKGStore struct {
 	Threshold int
 	// DKG epoch
 	Epoch uint32
+	// 门限策略
+	Policy    model.ThresholdPolicy
+	NewPolicy model.ThresholdPolicy
 
 	// DistPubKey globle public key
 	DkgPubKey *model.PubKey
@@ -21,6 +24,7 @@ type DKGStore struct {
 	DkgKeyShare  *model.DistKeyShare
 	SideKeyPub   types.AccountID
 	SideKeyShare []byte
+	Refresh      uint64
 
 	// next epoch data
 	NewNodes        []*model.Validator
@@ -29,36 +33,41 @@ type DKGStore struct {
 	NewDkgKeyShare  *model.DistKeyShare
 	NewSideKeyPub   types.AccountID // side key
 	NewSideKeyShare []byte
+	NewRefresh      uint64
 
 	status uint8
 }
 
 // Restore dkg state
 func (dkg *DKG) reState() error {
-	from, err := model.GetJson[DKGStore]("DKG", dkg.Signer.GetPublic().SS58())
+	from, err := model.GetJson[DKGStore]("DKG", dkg.storeKey())
 	if err != nil {
 		return fmt.Errorf("get dkg: %w", err)
 	}
 
 	if from == nil {
-		return nil
+		return dkg.loadRound()
 	}
 
 	to := dkg
 
 	to.Threshold = from.Threshold
+	to.Policy = from.Policy
+	to.NewPolicy = from.NewPolicy
 	to.Epoch = from.Epoch
 	to.status = from.status
 	to.Nodes = from.Nodes
 	to.DkgPubKey = from.DkgPubKey
 	to.DkgKeyShare = from.DkgKeyShare
+	to.Refresh = from.Refresh
 
 	to.NewNodes = from.NewNodes
 	to.NewEpoch = from.NewEpoch
 	to.NewDkgPubKey = from.NewDkgPubKey
 	to.NewDkgKeyShare = from.NewDkgKeyShare
+	to.NewRefresh = from.NewRefresh
 
-	return nil
+	return dkg.loadRound()
 }
 
 func (dkg *DKG) saveState() error {
@@ -66,16 +75,20 @@ func (dkg *DKG) saveState() error {
 	from := dkg
 
 	to.Threshold = from.Threshold
+	to.Policy = from.Policy
+	to.NewPolicy = from.NewPolicy
 	to.Epoch = from.Epoch
 	to.status = from.status
 	to.Nodes = from.Nodes
 	to.DkgPubKey = from.DkgPubKey
 	to.DkgKeyShare = from.DkgKeyShare
+	to.Refresh = from.Refresh
 
 	to.NewNodes = from.NewNodes
 	to.NewEpoch = from.NewEpoch
 	to.NewDkgPubKey = from.NewDkgPubKey
 	to.NewDkgKeyShare = from.NewDkgKeyShare
+	to.NewRefresh = from.NewRefresh
 
-	return model.SetJson("DKG", dkg.Signer.GetPublic().SS58(), &to)
+	return model.SetJson("DKG", dkg.storeKey(), &to)
 }
@@ -0,0 +1,432 @@
+package dkg
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/vault/shamir"
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+// 份额灾难恢复（可选）
+// 开启后每次切换份额时生成托管文件，由运维人员保存在节点之外，见 model.ShareEscrow
+
+// 运维人员数量上限，shamir 最多拆分 255 份
+const maxRecoveryOperators = 255
+
+// escrowedShare 托管的份额，以及恢复 DKG 需要的 epoch 数据
+type escrowedShare struct {
+	Nodes     []*model.Validator
+	Policy    model.ThresholdPolicy
+	DkgPubKey *model.PubKey
+	Share     *model.DistKeyShare
+}
+
+// SetRecovery 开启份额恢复托管，threshold 个运维人员批准后可以恢复份额
+func (dkg *DKG) SetRecovery(operators []*model.PubKey, threshold int) error {
+	if err := checkRecoveryOperators(operators, threshold); err != nil {
+		return err
+	}
+	dkg.recoveryOperators = operators
+	dkg.recoveryThreshold = threshold
+	return nil
+}
+
+func checkRecoveryOperators(operators []*model.PubKey, threshold int) error {
+	if len(operators) < 2 || len(operators) > maxRecoveryOperators {
+		return fmt.Errorf("share recovery: operators count must be in [2, %d]", maxRecoveryOperators)
+	}
+	if threshold < 2 || threshold > len(operators) {
+		return fmt.Errorf("share recovery: threshold must be in [2, %d]", len(operators))
+	}
+	seen := map[string]bool{}
+	for _, op := range operators {
+		if seen[op.SS58()] {
+			return errors.New("share recovery: duplicate operator " + op.SS58())
+		}
+		seen[op.SS58()] = true
+	}
+	return nil
+}
+
+// saveEscrow 份额切换后生成新的托管文件
+func (dkg *DKG) saveEscrow() {
+	if len(dkg.recoveryOperators) == 0 || dkg.DkgKeyShare == nil {
+		return
+	}
+
+	// 固定本节点的代码度量，运维人员只批准相同代码的 enclave
+	code, err := model.LocalTeeCode(dkg.Signer.ToSigner())
+	if err != nil {
+		util.LogError("DKG Recovery", "get local code measurement error", err)
+		return
+	}
+	escrow, err := newShareEscrow(dkg.Suite, dkg.Signer.GetPublic(), dkg.Epoch, dkg.Refresh, &escrowedShare{
+		Nodes:     dkg.Nodes,
+		Policy:    dkg.Policy,
+		DkgPubKey: dkg.DkgPubKey,
+		Share:     dkg.DkgKeyShare,
+	}, code, dkg.recoveryOperators, dkg.recoveryThreshold)
+	if err != nil {
+		util.LogError("DKG Recovery", "create share escrow error", err)
+		return
+	}
+	if err := model.SetJson("DKG_ESCROW", dkg.storeKey(), escrow); err != nil {
+		util.LogError("DKG Recovery", "save share escrow error", err)
+		return
+	}
+	util.LogWithYellow("DKG Recovery", "share escrow of epoch", dkg.Epoch, "refresh", dkg.Refresh, "is ready, export it off-node")
+}
+
+// ShareEscrow 本节点最新的份额托管，未开启时返回 nil
+func (dkg *DKG) ShareEscrow() (*model.ShareEscrow, error) {
+	return model.GetJson[model.ShareEscrow]("DKG_ESCROW", dkg.storeKey())
+}
+
+// recoverySession 本节点进行中的恢复请求和临时恢复密钥
+type recoverySession struct {
+	Request *model.RecoveryRequest
+	Key     *model.PrivKey
+}
+
+// NewRecoveryRequest 新的 enclave 为托管文件生成恢复请求，恢复密钥保存在本节点
+func (dkg *DKG) NewRecoveryRequest(escrow *model.ShareEscrow) (*model.RecoveryRequest, error) {
+	if escrow.Validator == nil || escrow.Validator.SS58() != dkg.Signer.GetPublic().SS58() {
+		return nil, errors.New("share escrow is not of this validator")
+	}
+
+	key, pub, err := model.GenerateEd25519KeyPair(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	req := &model.RecoveryRequest{
+		Validator:   escrow.Validator,
+		Epoch:       escrow.Epoch,
+		Refresh:     escrow.Refresh,
+		RecoveryKey: pub,
+		Time:        time.Now().Unix(),
+	}
+	call, err := model.IssueKeyReport(dkg.Signer.ToSigner(), pub.Byte(), req.ReportNonce())
+	if err != nil {
+		return nil, fmt.Errorf("issue recovery report: %w", err)
+	}
+	req.Report, err = call.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := model.SetJson("DKG_RECOVERY", dkg.storeKey(), &recoverySession{Request: req, Key: key}); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// RecoverShare 使用运维人员的批准恢复份额，份额必须和链上的承诺一致
+func (dkg *DKG) RecoverShare(escrow *model.ShareEscrow, approvals []*model.RecoveryApproval, commits *model.KyberPoints) error {
+	session, err := model.GetJson[recoverySession]("DKG_RECOVERY", dkg.storeKey())
+	if err != nil {
+		return err
+	}
+	if session == nil {
+		return errors.New("no share recovery request of this node")
+	}
+	if dkg.DkgKeyShare != nil && dkg.Epoch >= escrow.Epoch {
+		return errors.New("node already has the key share of epoch " + fmt.Sprint(dkg.Epoch))
+	}
+
+	recovered, err := recoverEscrow(dkg.Suite, escrow, session.Request, session.Key, approvals)
+	if err != nil {
+		return err
+	}
+	if err := checkRecoveredShare(dkg.Suite, recovered, commits); err != nil {
+		return err
+	}
+
+	dkg.Nodes = recovered.Nodes
+	dkg.Epoch = escrow.Epoch
+	dkg.Refresh = escrow.Refresh
+	dkg.Policy = recovered.Policy
+	dkg.Threshold = recovered.Policy.Threshold(len(recovered.Nodes))
+	dkg.DkgPubKey = recovered.DkgPubKey
+	dkg.DkgKeyShare = recovered.Share
+	if err := dkg.saveState(); err != nil {
+		return err
+	}
+	util.LogWithGreen("DKG Recovery", "recovered key share of epoch", escrow.Epoch, "refresh", escrow.Refresh)
+
+	return model.DeleteKey("DKG_RECOVERY", dkg.storeKey())
+}
+
+// checkRecoveredShare 恢复的份额必须是链上承诺的份额
+func checkRecoveredShare(suite suites.Suite, recovered *escrowedShare, commits *model.KyberPoints) error {
+	if recovered.Share == nil || recovered.DkgPubKey == nil || commits == nil {
+		return errors.New("recovered share is incomplete")
+	}
+	local := recovered.Share.Commitments()
+	if len(local) == 0 || len(local) != len(commits.Public) {
+		return errors.New("recovered share commits mismatch")
+	}
+	for i := range local {
+		if !local[i].Equal(commits.Public[i]) {
+			return errors.New("recovered share commits mismatch")
+		}
+	}
+	if !recovered.DkgPubKey.Point().Equal(local[0]) {
+		return errors.New("recovered dkg pub key mismatch")
+	}
+	if !share.NewPubPoly(suite, nil, local).Check(recovered.Share.PriShare()) {
+		return errors.New("recovered share does not match commits")
+	}
+	return nil
+}
+
+// newShareEscrow 加密份额并把数据密钥按 k-of-m 拆分给运维人员
+func newShareEscrow(
+	suite suites.Suite,
+	validator *model.PubKey,
+	epoch uint32,
+	refresh uint64,
+	s *escrowedShare,
+	code *model.TeeVerifyResult,
+	operators []*model.PubKey,
+	threshold int,
+) (*model.ShareEscrow, error) {
+	if err := checkRecoveryOperators(operators, threshold); err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	key := make([]byte, proxy_reenc.DataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate data key: %w", err)
+	}
+	payload, err := proxy_reenc.SealPayload(key, data)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := shamir.Split(key, len(operators), threshold)
+	if err != nil {
+		return nil, fmt.Errorf("split data key: %w", err)
+	}
+	pieces := make([]*model.EscrowPiece, 0, len(operators))
+	for i, op := range operators {
+		piece, err := sealPiece(suite, op, parts[i])
+		if err != nil {
+			return nil, err
+		}
+		piece.Operator = op
+		pieces = append(pieces, piece)
+	}
+
+	return &model.ShareEscrow{
+		Validator: validator,
+		Epoch:     epoch,
+		Refresh:   refresh,
+		Threshold: threshold,
+		Payload:   payload,
+		Pieces:    pieces,
+		Code:      code,
+		Time:      time.Now().Unix(),
+	}, nil
+}
+
+// VerifyRecoveryRequest 检查恢复请求属于托管文件，验证恢复公钥的 TEE report，
+// 并检查 report 的代码度量和托管文件中固定的度量一致
+// 没有 TEE 的 report 只有 allowNoTee 时接受
+func VerifyRecoveryRequest(escrow *model.ShareEscrow, req *model.RecoveryRequest, allowNoTee bool) (*model.TeeVerifyResult, error) {
+	if req.Validator == nil || req.RecoveryKey == nil || escrow.Validator == nil {
+		return nil, errors.New("recovery request is incomplete")
+	}
+	if req.Validator.SS58() != escrow.Validator.SS58() || req.Epoch != escrow.Epoch || req.Refresh != escrow.Refresh {
+		return nil, errors.New("recovery request does not match share escrow")
+	}
+
+	result, err := model.VerifyKeyReport(req.Report, req.RecoveryKey.Byte(), req.ReportNonce(), allowNoTee)
+	if err != nil {
+		return nil, err
+	}
+	if err := model.SameTeeCode(escrow.Code, result); err != nil {
+		return nil, fmt.Errorf("recovery request: %w", err)
+	}
+	return result, nil
+}
+
+// ApproveRecovery 运维人员解密自己的分片，重新加密到请求的恢复公钥并签名
+func ApproveRecovery(escrow *model.ShareEscrow, req *model.RecoveryRequest, operator *model.PrivKey, allowNoTee bool) (*model.RecoveryApproval, error) {
+	if _, err := VerifyRecoveryRequest(escrow, req, allowNoTee); err != nil {
+		return nil, err
+	}
+
+	suite := suites.MustFind("Ed25519")
+	pub := operator.GetPublic()
+	var own *model.EscrowPiece
+	for _, p := range escrow.Pieces {
+		if p.Operator != nil && p.Operator.SS58() == pub.SS58() {
+			own = p
+		}
+	}
+	if own == nil {
+		return nil, errors.New("operator " + pub.SS58() + " is not in share escrow")
+	}
+
+	part, err := openPiece(suite, operator.Scalar(), own)
+	if err != nil {
+		return nil, err
+	}
+	piece, err := sealPiece(suite, req.RecoveryKey, part)
+	if err != nil {
+		return nil, err
+	}
+	piece.Operator = pub
+
+	approval := &model.RecoveryApproval{
+		Operator:    pub,
+		Validator:   req.Validator,
+		Epoch:       req.Epoch,
+		Refresh:     req.Refresh,
+		RecoveryKey: req.RecoveryKey,
+		Piece:       piece,
+	}
+	msg, err := approval.SignBytes()
+	if err != nil {
+		return nil, err
+	}
+	approval.Signature, err = operator.ToSigner().Sign(msg)
+	if err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+// recoverEscrow 验证 threshold 个运维人员的批准，合并数据密钥并解密份额
+func recoverEscrow(
+	suite suites.Suite,
+	escrow *model.ShareEscrow,
+	req *model.RecoveryRequest,
+	recoveryKey *model.PrivKey,
+	approvals []*model.RecoveryApproval,
+) (*escrowedShare, error) {
+	if req.Validator.SS58() != escrow.Validator.SS58() || req.Epoch != escrow.Epoch || req.Refresh != escrow.Refresh {
+		return nil, errors.New("recovery request does not match share escrow")
+	}
+
+	operators := map[string]bool{}
+	for _, p := range escrow.Pieces {
+		if p.Operator != nil {
+			operators[p.Operator.SS58()] = true
+		}
+	}
+
+	parts := make([][]byte, 0, len(approvals))
+	used := map[string]bool{}
+	for _, a := range approvals {
+		if err := checkApproval(a, req, operators); err != nil {
+			util.LogWithYellow("DKG Recovery", "skip approval:", err.Error())
+			continue
+		}
+		if used[a.Operator.SS58()] {
+			continue
+		}
+		part, err := openPiece(suite, recoveryKey.Scalar(), a.Piece)
+		if err != nil {
+			util.LogWithYellow("DKG Recovery", "skip approval of", a.Operator.SS58(), err.Error())
+			continue
+		}
+		used[a.Operator.SS58()] = true
+		parts = append(parts, part)
+	}
+	if len(parts) < escrow.Threshold {
+		return nil, fmt.Errorf("share recovery needs %d approvals, got %d", escrow.Threshold, len(parts))
+	}
+
+	key, err := shamir.Combine(parts)
+	if err != nil {
+		return nil, fmt.Errorf("combine data key: %w", err)
+	}
+	data, err := proxy_reenc.OpenPayload(key, escrow.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	recovered := new(escrowedShare)
+	if err := json.Unmarshal(data, recovered); err != nil {
+		return nil, err
+	}
+	return recovered, nil
+}
+
+// checkApproval 批准必须由托管文件中的运维人员为本次请求签名
+func checkApproval(a *model.RecoveryApproval, req *model.RecoveryRequest, operators map[string]bool) error {
+	if a == nil || a.Operator == nil || a.Piece == nil || a.Validator == nil || a.RecoveryKey == nil {
+		return errors.New("approval is incomplete")
+	}
+	if !operators[a.Operator.SS58()] {
+		return errors.New("operator " + a.Operator.SS58() + " is not in share escrow")
+	}
+	if a.Validator.SS58() != req.Validator.SS58() || a.Epoch != req.Epoch || a.Refresh != req.Refresh ||
+		!bytes.Equal(a.RecoveryKey.Byte(), req.RecoveryKey.Byte()) {
+		return errors.New("approval of " + a.Operator.SS58() + " is not for this request")
+	}
+
+	msg, err := a.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !model.SignVerify(a.Operator.Byte(), msg, a.Signature) {
+		return errors.New("approval of " + a.Operator.SS58() + " has invalid signature")
+	}
+	return nil
+}
+
+// sealPiece 把分片加密到公钥
+func sealPiece(suite suites.Suite, to *model.PubKey, data []byte) (*model.EscrowPiece, error) {
+	encCmt, encKey, payload, err := proxy_reenc.EncryptEnvelope(suite, to.Point(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	piece := &model.EscrowPiece{Payload: payload}
+	piece.EncCmt, err = encCmt.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range encKey {
+		bt, err := k.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		piece.EncKey = append(piece.EncKey, bt)
+	}
+	return piece, nil
+}
+
+// openPiece 使用私钥解密分片
+func openPiece(suite suites.Suite, sk kyber.Scalar, piece *model.EscrowPiece) ([]byte, error) {
+	encCmt := suite.Point()
+	if err := encCmt.UnmarshalBinary(piece.EncCmt); err != nil {
+		return nil, fmt.Errorf("escrow piece: %w", err)
+	}
+	encKey := make([]kyber.Point, 0, len(piece.EncKey))
+	for _, bt := range piece.EncKey {
+		k := suite.Point()
+		if err := k.UnmarshalBinary(bt); err != nil {
+			return nil, fmt.Errorf("escrow piece: %w", err)
+		}
+		encKey = append(encKey, k)
+	}
+	return proxy_reenc.OpenEnvelope(suite, encCmt, encKey, sk, piece.Payload)
+}
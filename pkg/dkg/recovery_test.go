@@ -0,0 +1,121 @@
+package dkg
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+func TestShareRecovery(t *testing.T) {
+	suite := suites.MustFind("Ed25519")
+
+	validator, _, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	poly := share.NewPriPoly(suite, 2, nil, suite.RandomStream())
+	_, commits := poly.Commit(nil).Info()
+	keyShare := &model.DistKeyShare{
+		CommitsWrap:  model.KyberPoints{Public: commits},
+		PriShareWrap: model.PriShare{PriShare: poly.Shares(3)[1]},
+	}
+	dkgPub, err := model.PubKeyFromPoint(commits[0])
+	require.NoError(t, err)
+	escrowed := &escrowedShare{
+		Nodes:     []*model.Validator{{ValidatorId: *validator.GetPublic(), P2pId: *validator.GetPublic()}},
+		Policy:    model.ThresholdPolicy{},
+		DkgPubKey: dkgPub,
+		Share:     keyShare,
+	}
+
+	// 3 个运维人员，2 个批准即可恢复
+	operators := make([]*model.PrivKey, 3)
+	operatorPubs := make([]*model.PubKey, 3)
+	for i := range operators {
+		operators[i], operatorPubs[i], err = model.GenerateEd25519KeyPair(rand.Reader)
+		require.NoError(t, err)
+	}
+	code, err := model.LocalTeeCode(validator.ToSigner())
+	require.NoError(t, err)
+	escrow, err := newShareEscrow(suite, validator.GetPublic(), 2, 1, escrowed, code, operatorPubs, 2)
+	require.NoError(t, err)
+	require.Len(t, escrow.Pieces, 3)
+
+	// 新的 enclave 生成恢复公钥
+	recoveryKey, recoveryPub, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	req := &model.RecoveryRequest{
+		Validator:   validator.GetPublic(),
+		Epoch:       2,
+		Refresh:     1,
+		RecoveryKey: recoveryPub,
+		Time:        time.Now().Unix(),
+	}
+	call, err := model.IssueKeyReport(validator.ToSigner(), recoveryPub.Byte(), req.ReportNonce())
+	require.NoError(t, err)
+	req.Report, err = call.Marshal()
+	require.NoError(t, err)
+
+	// 没有 TEE 的请求默认拒绝
+	_, err = ApproveRecovery(escrow, req, operators[0], false)
+	require.Error(t, err)
+
+	// 代码度量和托管文件中固定的不一致
+	pinned := escrow.Code
+	escrow.Code = &model.TeeVerifyResult{TeeType: 1, CodeSignature: []byte("other")}
+	_, err = ApproveRecovery(escrow, req, operators[0], true)
+	require.Error(t, err)
+	escrow.Code = nil
+	_, err = ApproveRecovery(escrow, req, operators[0], true)
+	require.Error(t, err)
+	escrow.Code = pinned
+
+	approvals := make([]*model.RecoveryApproval, 0, 3)
+	for _, op := range operators {
+		a, err := ApproveRecovery(escrow, req, op, true)
+		require.NoError(t, err)
+		approvals = append(approvals, a)
+	}
+
+	// 批准不足
+	_, err = recoverEscrow(suite, escrow, req, recoveryKey, approvals[:1])
+	require.Error(t, err)
+
+	// 重复的批准只计算一次
+	_, err = recoverEscrow(suite, escrow, req, recoveryKey, []*model.RecoveryApproval{approvals[0], approvals[0]})
+	require.Error(t, err)
+
+	// 篡改签名的批准被忽略
+	tampered := *approvals[1]
+	tampered.Epoch = 3
+	_, err = recoverEscrow(suite, escrow, req, recoveryKey, []*model.RecoveryApproval{approvals[0], &tampered})
+	require.Error(t, err)
+
+	// 不在托管文件中的运维人员
+	outsider, _, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	_, err = ApproveRecovery(escrow, req, outsider, true)
+	require.Error(t, err)
+
+	// 其他恢复公钥无法解密分片
+	otherKey, _, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	_, err = recoverEscrow(suite, escrow, req, otherKey, approvals[1:])
+	require.Error(t, err)
+
+	recovered, err := recoverEscrow(suite, escrow, req, recoveryKey, approvals[1:])
+	require.NoError(t, err)
+	require.Equal(t, escrowed.DkgPubKey.Byte(), recovered.DkgPubKey.Byte())
+	require.True(t, recovered.Share.PriShare().V.Equal(keyShare.PriShare().V))
+	require.NoError(t, checkRecoveredShare(suite, recovered, &model.KyberPoints{Public: commits}))
+
+	// 份额必须和链上的承诺一致
+	otherPoly := share.NewPriPoly(suite, 2, nil, suite.RandomStream())
+	_, otherCommits := otherPoly.Commit(nil).Info()
+	require.Error(t, checkRecoveredShare(suite, recovered, &model.KyberPoints{Public: otherCommits}))
+}
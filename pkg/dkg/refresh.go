@@ -0,0 +1,294 @@
+package dkg
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	pedersen "go.dedis.ch/kyber/v4/share/dkg/pedersen"
+)
+
+// 主动份额刷新
+// 同一 epoch 内相同的验证节点使用 pedersen reshare 重新随机化份额，DKG 公钥不变
+// 节点完成后通知发起者，发起者收到 quorum 个节点后提交刷新交易，交易提交后所有节点切换到新份额
+
+// consensusNonce 共识的 session id，首次 DKG 为 0，份额刷新加入刷新序号
+func consensusNonce(msg *model.ConsensusMsg) []byte {
+	if len(msg.ShareCommits.Public) == 0 {
+		return epochToNonce(0)
+	}
+	if msg.Refresh == 0 {
+		return epochToNonce(msg.Epoch)
+	}
+
+	var nonce [pedersen.NonceLength]byte
+	copy(nonce[:], fmt.Appendf(nil, "%d-%d", msg.Epoch, msg.Refresh))
+	return nonce[:]
+}
+
+// consensusDone 共识的 epoch 或份额刷新已经完成
+func (dkg *DKG) consensusDone(msg *model.ConsensusMsg) bool {
+	if msg.Refresh > 0 {
+		return dkg.Epoch != msg.Epoch || dkg.Refresh >= msg.Refresh
+	}
+	return dkg.Epoch >= msg.Epoch
+}
+
+// TryShareRefresh 本节点作为发起者，使用当前的验证节点刷新份额
+func (dkg *DKG) TryShareRefresh(refresh uint64) error {
+	if dkg.ConsensusIsbusy() {
+		return errors.New("in consensus")
+	}
+	if dkg.DkgKeyShare == nil || dkg.DkgPubKey == nil {
+		return errors.New("node has no key share, cannot refresh")
+	}
+	if refresh <= dkg.Refresh {
+		return fmt.Errorf("share refresh %d of epoch %d is done", refresh, dkg.Epoch)
+	}
+
+	// 刷新需要 threshold 个旧份额，以及 quorum 个节点接收新份额
+	if dkg.AvailableNodeLen() < dkg.Threshold {
+		return fmt.Errorf("validators count < dkg.Threshold")
+	}
+	// 刷新不改变门限，门限策略之前创建的 epoch 继续使用空策略
+	quorum := dkg.Policy.Quorum(len(dkg.Nodes))
+	if dkg.NewValidatorNodeLen(dkg.Nodes) < quorum {
+		return fmt.Errorf("validators count < quorum %d", quorum)
+	}
+
+	var sponsor *model.Validator
+	dkgSigner := dkg.Signer.GetPublic().SS58()
+	for _, v := range dkg.Nodes {
+		if v.ValidatorId.SS58() == dkgSigner {
+			sponsor = v
+		}
+	}
+	if sponsor == nil {
+		return errors.New("node is not validator, cannot refresh")
+	}
+
+	msg := model.ConsensusMsg{
+		Sponsor:          sponsor,
+		Epoch:            dkg.Epoch,
+		EpochTime:        time.Now().Unix(),
+		ShareCommits:     *util.DeepCopy(dkg.DkgKeyShare.CommitsWrap),
+		OldValidators:    *util.DeepCopy(dkg.Nodes),
+		Validators:       *util.DeepCopy(dkg.Nodes),
+		ConsensusNodeNum: len(dkg.Nodes),
+		Policy:           dkg.Policy,
+		Refresh:          refresh,
+	}
+
+	bt, _ := json.Marshal(msg)
+	return dkg.DkgOutHandler(&model.DkgMessage{
+		Type:    "consensus",
+		Payload: bt,
+	})
+}
+
+// sendRefreshAck 完成份额刷新或密钥组 DKG 后通知发起者，并签名确认新的份额承诺
+func (dkg *DKG) sendRefreshAck() {
+	sponsor := dkg.round.Msg.Sponsor
+	if sponsor == nil {
+		return
+	}
+
+	ack, err := dkg.signRoundAck()
+	if err != nil {
+		util.LogError("DKG Refresh", "sign refresh ack error", err)
+		return
+	}
+	bt, _ := json.Marshal(model.NewEpochMsg{Time: dkg.round.Msg.EpochTime, Ack: ack})
+	err = dkg.sendToNode(model.SendToNode(&sponsor.P2pId), &model.DkgMessage{
+		Type:    "refresh_done",
+		Payload: bt,
+	})
+	if err != nil {
+		util.LogError("DKG Refresh", "send refresh_done error", err)
+	}
+
+	// 其他节点可能先完成
+	if err := dkg.tryReportRound(); err != nil {
+		util.LogError("DKG Refresh", "report round error", err)
+	}
+}
+
+func (dkg *DKG) handleRefreshAck(OrgId string, data []byte) error {
+	msg := new(model.NewEpochMsg)
+	if err := json.Unmarshal(data, msg); err != nil {
+		return err
+	}
+	return dkg.receiveRefreshAck(OrgId, msg)
+}
+
+// receiveRefreshAck 发起者保存完成的节点
+func (dkg *DKG) receiveRefreshAck(OrgId string, msg *model.NewEpochMsg) error {
+	if !dkg.roundActive() {
+		return nil
+	}
+	round := dkg.round
+	if !dkg.ackRound(round.Msg) || msg.Time != round.Msg.EpochTime {
+		return nil
+	}
+	if _, ok := round.PartialSigs[OrgId]; ok {
+		return nil
+	}
+
+	round.PartialSigs[OrgId] = msg
+	dkg.saveRound()
+	return dkg.tryReportRound()
+}
+
+// ackRound 轮次完成后需要等待发起者提交交易
+func (dkg *DKG) ackRound(msg *model.ConsensusMsg) bool {
+	return msg.Refresh > 0 || dkg.groupRound(msg)
+}
+
+// tryReportRound 发起者完成并收到新节点中 quorum 个节点后提交份额刷新或密钥组交易
+func (dkg *DKG) tryReportRound() error {
+	round := dkg.round
+	if round == nil || round.Phase != RoundPhaseSign || round.Reported {
+		return nil
+	}
+	sponsor := round.Msg.Sponsor
+	if sponsor == nil || sponsor.ValidatorId.SS58() != dkg.Signer.GetPublic().SS58() {
+		return nil
+	}
+	if len(round.PartialSigs) < dkg.NewPolicy.Quorum(len(dkg.NewNodes)) {
+		return nil
+	}
+	refresh := round.Msg.Refresh > 0
+	if refresh && dkg.refreshDoneBack == nil {
+		return errors.New("share refresh callback is not set")
+	}
+	if !refresh && dkg.groupDoneBack == nil {
+		return errors.New("key group callback is not set")
+	}
+
+	commits, err := json.Marshal(dkg.NewDkgKeyShare.CommitsWrap)
+	if err != nil {
+		return err
+	}
+	// 只计算对相同承诺签名的节点
+	acks := dkg.roundAcks(commits)
+	if len(acks) < dkg.NewPolicy.Quorum(len(dkg.NewNodes)) {
+		return nil
+	}
+	round.Reported = true
+	dkg.saveRound()
+
+	if refresh {
+		util.LogWithGreen("DKG Refresh", "report share refresh", round.SessionId)
+		dkg.refreshDoneBack(&model.ShareRefresh{
+			Epoch:   round.Msg.Epoch,
+			Refresh: round.Msg.Refresh,
+			Commits: commits,
+			Acks:    acks,
+		})
+		return nil
+	}
+
+	util.LogWithGreen("DKG Group", "report key group", dkg.Group, round.SessionId)
+	dkg.groupDoneBack(&model.KeyGroupEpoch{
+		Id:         dkg.Group,
+		Epoch:      round.Msg.Epoch,
+		DkgPub:     dkg.NewDkgPubKey.Byte(),
+		DkgCommits: commits,
+		Acks:       acks,
+	})
+	return nil
+}
+
+// roundAckBytes 节点确认轮次结果时签名的数据，链上使用相同的数据验证
+func (dkg *DKG) roundAckBytes(commits []byte) []byte {
+	msg := dkg.round.Msg
+	if dkg.groupRound(msg) {
+		return (&model.KeyGroupEpoch{Id: dkg.Group, Epoch: msg.Epoch, DkgCommits: commits}).AckBytes()
+	}
+	return (&model.ShareRefresh{Epoch: msg.Epoch, Refresh: msg.Refresh, Commits: commits}).AckBytes()
+}
+
+// signRoundAck 使用本节点的验证节点密钥签名确认新的份额承诺
+func (dkg *DKG) signRoundAck() (*model.RoundAck, error) {
+	if dkg.NewDkgKeyShare == nil {
+		return nil, errors.New("node has no new key share")
+	}
+	commits, err := json.Marshal(dkg.NewDkgKeyShare.CommitsWrap)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := dkg.Signer.ToSigner().Sign(dkg.roundAckBytes(commits))
+	if err != nil {
+		return nil, err
+	}
+	return &model.RoundAck{Validator: dkg.Signer.GetPublic().Byte(), Signature: sig}, nil
+}
+
+// roundAcks 发起者收到的、由新节点对 commits 签名的确认，按验证节点排序
+func (dkg *DKG) roundAcks(commits []byte) []*model.RoundAck {
+	msg := dkg.roundAckBytes(commits)
+	acks := make([]*model.RoundAck, 0, len(dkg.round.PartialSigs))
+	for OrgId, ack := range dkg.round.PartialSigs {
+		if ack == nil || ack.Ack == nil {
+			continue
+		}
+		idx := slices.IndexFunc(dkg.NewNodes, func(v *model.Validator) bool {
+			return v.P2pId.String() == OrgId
+		})
+		if idx < 0 || !bytes.Equal(dkg.NewNodes[idx].ValidatorId.Byte(), ack.Ack.Validator) {
+			continue
+		}
+		if !model.SignVerify(ack.Ack.Validator, msg, ack.Ack.Signature) {
+			util.LogWithYellow("DKG Refresh", "invalid ack from", OrgId)
+			continue
+		}
+		acks = append(acks, ack.Ack)
+	}
+	slices.SortFunc(acks, func(a, b *model.RoundAck) int {
+		return bytes.Compare(a.Validator, b.Validator)
+	})
+	return acks
+}
+
+// ApplyRefresh 刷新交易提交后切换到新份额，本节点还未完成刷新时完成后切换
+func (dkg *DKG) ApplyRefresh(epoch uint32, refresh uint64, commits []byte) error {
+	if dkg.Epoch != epoch || dkg.Refresh >= refresh {
+		return nil
+	}
+	if dkg.NewRefresh != refresh {
+		return fmt.Errorf("node did not join share refresh %d of epoch %d", refresh, epoch)
+	}
+	return dkg.applyRound(commits)
+}
+
+// applyRound 切换到交易中的新份额，本节点的轮次还在进行中时完成后切换
+func (dkg *DKG) applyRound(commits []byte) error {
+	if dkg.roundActive() && dkg.round.Phase != RoundPhaseSign {
+		dkg.toNewEpoch()
+		return nil
+	}
+
+	if dkg.NewDkgKeyShare == nil {
+		return fmt.Errorf("dkg round of epoch %d failed on node", dkg.NewEpoch)
+	}
+	local, err := json.Marshal(dkg.NewDkgKeyShare.CommitsWrap)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(local, commits) {
+		return fmt.Errorf("dkg round of epoch %d commits mismatch", dkg.NewEpoch)
+	}
+
+	dkg.toNewEpoch()
+	return nil
+}
+
+// SetRefreshCallback 设置份额刷新完成的回调，发起者收到 quorum 个节点后调用
+func (dkg *DKG) SetRefreshCallback(done func(*model.ShareRefresh)) {
+	dkg.refreshDoneBack = done
+}
@@ -0,0 +1,358 @@
+package dkg
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	pedersen "go.dedis.ch/kyber/v4/share/dkg/pedersen"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+// DKG 轮次状态持久化
+// 轮次中收到的 deal、response、justification 和新 epoch 部分签名在收到时写入数据库
+// 节点在共识过程中重启后，使用保存的种子重新生成相同的多项式，并重放已收到的消息恢复轮次
+
+const (
+	RoundPhaseDeal          = "deal"
+	RoundPhaseResponse      = "response"
+	RoundPhaseJustification = "justification"
+	RoundPhaseSign          = "sign"
+	RoundPhaseAborted       = "aborted"
+	RoundPhaseDone          = "done"
+)
+
+type dkgRound struct {
+	// 轮次 id => epoch-发起时间
+	SessionId string
+	Phase     string
+	// 生成本节点多项式的随机种子
+	Seed []byte
+	// 发起者的共识消息
+	Msg *model.ConsensusMsg
+	// 本节点的 deal
+	OwnDeal *model.DealBundle
+	// 发送节点 => 收到的消息
+	Deals       map[string]*model.DealBundle
+	Responses   map[string]*pedersen.ResponseBundle
+	Justifs     map[string]*model.JustificationBundle
+	PartialSigs map[string]*model.NewEpochMsg
+	StartTime   int64
+	// 份额刷新或密钥组 DKG 已提交到侧链
+	Reported bool
+}
+
+// RoundInfo DKG 轮次状态
+type RoundInfo struct {
+	SessionId      string
+	Phase          string
+	Epoch          uint32
+	Refresh        uint64
+	Sponsor        string
+	StartTime      int64
+	Deals          int
+	Responses      int
+	Justifications int
+	PartialSigs    int
+}
+
+func roundSessionId(msg *model.ConsensusMsg) string {
+	if msg.Refresh > 0 {
+		return fmt.Sprintf("%d.%d-%d", msg.Epoch, msg.Refresh, msg.EpochTime)
+	}
+	return fmt.Sprintf("%d-%d", msg.Epoch, msg.EpochTime)
+}
+
+func newDkgRound(msg *model.ConsensusMsg) (*dkgRound, error) {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, fmt.Errorf("round seed: %w", err)
+	}
+
+	roundMsg := util.DeepCopy(*msg)
+	roundMsg.DealBundle = nil
+	return &dkgRound{
+		SessionId:   roundSessionId(msg),
+		Phase:       RoundPhaseDeal,
+		Seed:        seed,
+		Msg:         roundMsg,
+		Deals:       map[string]*model.DealBundle{},
+		Responses:   map[string]*pedersen.ResponseBundle{},
+		Justifs:     map[string]*model.JustificationBundle{},
+		PartialSigs: map[string]*model.NewEpochMsg{},
+		StartTime:   time.Now().Unix(),
+	}, nil
+}
+
+// roundActive 是否有进行中的轮次
+func (dkg *DKG) roundActive() bool {
+	return dkg.round != nil && dkg.round.Phase != RoundPhaseAborted && dkg.round.Phase != RoundPhaseDone
+}
+
+// inSession 消息是否属于当前进行中的轮次
+func (dkg *DKG) inSession(msg *model.ConsensusMsg) bool {
+	return dkg.roundActive() && dkg.round.SessionId == roundSessionId(msg)
+}
+
+// 开始轮次前最多缓存的消息数量
+const maxEarlyMessages = 256
+
+// cacheEarly 新节点收到第一个 deal 才开始轮次，其他节点的 response 和 justification 可能先到达
+func (dkg *DKG) cacheEarly(msgType string, OrgId string, data []byte) {
+	if len(dkg.early) >= maxEarlyMessages {
+		dkg.early = dkg.early[1:]
+	}
+	dkg.early = append(dkg.early, &model.DkgMessage{
+		Type:    msgType,
+		From:    OrgId,
+		Payload: data,
+	})
+}
+
+// replayEarly 轮次开始后处理提前收到的消息
+func (dkg *DKG) replayEarly() {
+	early := dkg.early
+	dkg.early = nil
+	for _, msg := range early {
+		dkg.handleDkg(msg)
+	}
+}
+
+// roundNonce 当前轮次 deal、response 和 justification 使用的 session id
+func (dkg *DKG) roundNonce() []byte {
+	return consensusNonce(dkg.round.Msg)
+}
+
+func (dkg *DKG) setRoundPhase(phase string) {
+	if dkg.round == nil {
+		return
+	}
+	dkg.round.Phase = phase
+	dkg.saveRound()
+}
+
+func (dkg *DKG) saveRound() {
+	if dkg.round == nil {
+		return
+	}
+	if err := model.SetJson("DKG_ROUND", dkg.storeKey(), dkg.round); err != nil {
+		util.LogError("DKG Round", "save round error", err)
+	}
+}
+
+func (dkg *DKG) loadRound() error {
+	round, err := model.GetJson[dkgRound]("DKG_ROUND", dkg.storeKey())
+	if err != nil {
+		return fmt.Errorf("get dkg round: %w", err)
+	}
+	dkg.round = round
+	return nil
+}
+
+// seedConfig 使用轮次种子生成本节点的多项式，重启后可以重新生成相同的 deal
+func (dkg *DKG) seedConfig(conf *pedersen.Config) {
+	if dkg.round == nil {
+		return
+	}
+	conf.Suite = newSeededSuite(dkg.Suite, dkg.round.Seed)
+
+	// 首次 DKG 的秘密由 Reader 生成
+	if conf.Share == nil && len(conf.PublicCoeffs) == 0 {
+		conf.Reader = dkg.Suite.XOF(append([]byte("secret"), dkg.round.Seed...))
+		conf.UserReaderOnly = true
+	}
+}
+
+// seededSuite 第一次调用 RandomStream 时返回由种子生成的随机流，之后使用系统随机数
+// pedersen.NewDistKeyHandler 只在生成多项式时调用一次 RandomStream
+type seededSuite struct {
+	suites.Suite
+	mu     sync.Mutex
+	stream cipher.Stream
+}
+
+func newSeededSuite(suite suites.Suite, seed []byte) *seededSuite {
+	return &seededSuite{Suite: suite, stream: suite.XOF(seed)}
+}
+
+func (s *seededSuite) RandomStream() cipher.Stream {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stream == nil {
+		return s.Suite.RandomStream()
+	}
+	stream := s.stream
+	s.stream = nil
+	return stream
+}
+
+// resumeRound 节点重启后恢复未完成的轮次
+func (dkg *DKG) resumeRound() error {
+	if !dkg.roundActive() {
+		return nil
+	}
+	round := dkg.round
+
+	// 签名阶段只需要在共识窗口内完成，其他阶段需要在共识超时前完成
+	elapsed := time.Now().Unix() - round.StartTime
+	remain := int64(consensusTimeout/time.Second) - elapsed
+	if round.Phase == RoundPhaseSign {
+		remain = consensusBusyTime - elapsed
+	}
+	if remain <= 0 || dkg.consensusDone(round.Msg) {
+		util.LogWithYellow("DKG Round", "drop expired session", round.SessionId, "phase", round.Phase)
+		dkg.setRoundPhase(RoundPhaseAborted)
+		return nil
+	}
+	util.LogWithYellow("DKG Round", "resume session", round.SessionId, "phase", round.Phase)
+
+	deals, responses, justifs, sigs := round.Deals, round.Responses, round.Justifs, round.PartialSigs
+	round.Phase = RoundPhaseDeal
+	round.Deals = map[string]*model.DealBundle{}
+	round.Responses = map[string]*pedersen.ResponseBundle{}
+	round.Justifs = map[string]*model.JustificationBundle{}
+	round.PartialSigs = map[string]*model.NewEpochMsg{}
+
+	dkg.lastConsensusTime = round.StartTime
+	dkg.addConsensusTimeout(time.Duration(remain) * time.Second)
+	dkg.SaveSponsor(round.Msg)
+	err := dkg.runConsensus(*round.Msg)
+	if err != nil {
+		return err
+	}
+
+	// 重放已收到的消息
+	for orgId, deal := range deals {
+		if err := dkg.receiveDeal(orgId, deal); err != nil {
+			return err
+		}
+	}
+	for orgId, resp := range responses {
+		if err := dkg.receiveResponse(orgId, resp); err != nil {
+			return err
+		}
+	}
+	for orgId, justif := range justifs {
+		if err := dkg.receiveJustification(orgId, justif); err != nil {
+			return err
+		}
+	}
+	for orgId, sig := range sigs {
+		if dkg.ackRound(round.Msg) {
+			err = dkg.receiveRefreshAck(orgId, sig)
+		} else {
+			err = dkg.receivePartialSig(orgId, sig)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Round 当前 DKG 轮次状态，没有轮次时返回 nil
+func (dkg *DKG) Round() *RoundInfo {
+	round := dkg.round
+	if round == nil {
+		return nil
+	}
+
+	info := &RoundInfo{
+		SessionId:      round.SessionId,
+		Phase:          round.Phase,
+		Epoch:          round.Msg.Epoch,
+		Refresh:        round.Msg.Refresh,
+		StartTime:      round.StartTime,
+		Deals:          len(round.Deals),
+		Responses:      len(round.Responses),
+		Justifications: len(round.Justifs),
+		PartialSigs:    len(round.PartialSigs),
+	}
+	if round.Msg.Sponsor != nil {
+		info.Sponsor = round.Msg.Sponsor.ValidatorId.SS58()
+	}
+	return info
+}
+
+// roundCmd 本节点对轮次的命令，只能通过 AbortRound 和 RestartRound 发起
+type roundCmd string
+
+const (
+	roundAbort   roundCmd = "round_abort"
+	roundRestart roundCmd = "round_restart"
+)
+
+// AbortRound 终止本节点指定的 DKG 轮次
+func (dkg *DKG) AbortRound(sessionId string) error {
+	return dkg.pushRoundCmd(roundAbort, sessionId)
+}
+
+// RestartRound 终止本节点指定的 DKG 轮次，本节点是发起者时使用相同的验证节点重新发起共识
+func (dkg *DKG) RestartRound(sessionId string) error {
+	return dkg.pushRoundCmd(roundRestart, sessionId)
+}
+
+func (dkg *DKG) pushRoundCmd(cmd roundCmd, sessionId string) error {
+	round := dkg.round
+	if round == nil || round.SessionId != sessionId {
+		return fmt.Errorf("dkg round %s not found", sessionId)
+	}
+	if round.Phase == RoundPhaseDone || (cmd == roundAbort && !dkg.roundActive()) {
+		return fmt.Errorf("dkg round %s is %s", sessionId, round.Phase)
+	}
+
+	dkg.runLocal(func() {
+		if err := dkg.handleRoundCmd(cmd, sessionId); err != nil {
+			util.LogError("DEAL <<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<< ERROR", "HandleRoundCmd:", err)
+		}
+	})
+	return nil
+}
+
+// handleRoundCmd 处理终止、重启轮次
+func (dkg *DKG) handleRoundCmd(cmd roundCmd, sessionId string) error {
+	round := dkg.round
+	if round == nil || round.SessionId != sessionId {
+		return fmt.Errorf("dkg round %s not found", sessionId)
+	}
+
+	if dkg.roundActive() {
+		util.LogWithYellow("DKG Round", cmd, sessionId)
+		dkg.finishDkgConsensusStep(false, string(cmd)+" "+sessionId)
+	}
+	if cmd != roundRestart {
+		return nil
+	}
+
+	// 只有发起者重新发起共识，其他节点收到新的 deal 后加入
+	sponsor := round.Msg.Sponsor
+	if sponsor == nil || sponsor.ValidatorId.SS58() != dkg.Signer.GetPublic().SS58() {
+		return nil
+	}
+	if round.Msg.Refresh > 0 {
+		return dkg.TryShareRefresh(round.Msg.Refresh)
+	}
+	if dkg.Group != "" {
+		return dkg.TryGroupEpoch(round.Msg.Validators, round.Msg.Epoch, round.Msg.Policy)
+	}
+	if dkg.consensusSuccessBack == nil || dkg.consensusFailBack == nil {
+		return errors.New("dkg consensus callback is not set")
+	}
+
+	return dkg.TryEpochConsensus(model.ConsensusMsg{
+		Validators: round.Msg.Validators,
+		Epoch:      round.Msg.Epoch,
+		Policy:     round.Msg.Policy,
+	}, dkg.consensusSuccessBack, dkg.consensusFailBack)
+}
+
+// SetConsensusCallback 设置共识回调，重启后恢复的轮次和重新发起的轮次使用
+func (dkg *DKG) SetConsensusCallback(callback func(*DssSigner, uint64), fail func(error)) {
+	dkg.consensusSuccessBack = callback
+	dkg.consensusFailBack = fail
+}
@@ -0,0 +1,309 @@
+package dkg
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	p2peer "github.com/wetee-dao/tee-dsecret/pkg/network"
+	"github.com/wetee-dao/tee-dsecret/pkg/network/local"
+	"go.dedis.ch/kyber/v4/share"
+	pedersen "go.dedis.ch/kyber/v4/share/dkg/pedersen"
+	"go.dedis.ch/kyber/v4/sign/schnorr"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+func TestRoundSeed(t *testing.T) {
+	suite := suites.MustFind("Ed25519")
+
+	privs := make([]*model.PrivKey, 0, len(peerSecret))
+	nodes := make([]pedersen.Node, 0, len(peerSecret))
+	validators := make([]*model.Validator, 0, len(peerSecret))
+	for i, s := range peerSecret {
+		priv, err := model.PrivateKeyFromHex(s)
+		require.NoError(t, err)
+		privs = append(privs, priv)
+		nodes = append(nodes, pedersen.Node{Index: uint32(i), Public: priv.GetPublic().Point()})
+		validators = append(validators, &model.Validator{ValidatorId: *priv.GetPublic(), P2pId: *priv.GetPublic()})
+	}
+
+	round, err := newDkgRound(&model.ConsensusMsg{Epoch: 1, EpochTime: 100, Validators: validators})
+	require.NoError(t, err)
+	require.Equal(t, "1-100", round.SessionId)
+
+	deal := func(round *dkgRound) *pedersen.DealBundle {
+		dkg := &DKG{Suite: suite, round: round}
+		conf := pedersen.Config{
+			Suite:     suite,
+			NewNodes:  nodes,
+			Threshold: 2,
+			Auth:      schnorr.NewScheme(suite),
+			FastSync:  true,
+			Longterm:  privs[0].Scalar(),
+			Nonce:     epochToNonce(0),
+		}
+		dkg.seedConfig(&conf)
+		gen, err := pedersen.NewDistKeyHandler(&conf)
+		require.NoError(t, err)
+		d, err := gen.Deals()
+		require.NoError(t, err)
+		return d
+	}
+
+	// 保存后恢复的轮次生成相同的多项式
+	first := deal(round)
+	round.OwnDeal = &model.DealBundle{DealBundle: first}
+	round.Deals["n1"] = &model.DealBundle{DealBundle: first}
+	round.Responses["n1"] = &pedersen.ResponseBundle{ShareIndex: 1, SessionID: epochToNonce(0)}
+	round.PartialSigs["n1"] = &model.NewEpochMsg{Time: 100, PartialSig: []byte{1}}
+
+	bt, err := json.Marshal(round)
+	require.NoError(t, err)
+	restored := new(dkgRound)
+	require.NoError(t, json.Unmarshal(bt, restored))
+	require.Equal(t, round.SessionId, restored.SessionId)
+	require.Equal(t, round.Msg.Epoch, restored.Msg.Epoch)
+	require.Len(t, restored.Msg.Validators, len(validators))
+	require.Equal(t, round.Responses["n1"], restored.Responses["n1"])
+	require.Equal(t, round.PartialSigs["n1"], restored.PartialSigs["n1"])
+	require.Len(t, restored.Deals["n1"].Public, len(first.Public))
+
+	second := deal(restored)
+	require.Len(t, second.Public, len(first.Public))
+	for i := range first.Public {
+		require.True(t, first.Public[i].Equal(second.Public[i]))
+		require.True(t, first.Public[i].Equal(restored.OwnDeal.Public[i]))
+	}
+
+	// 不同的种子生成不同的多项式
+	other, err := newDkgRound(&model.ConsensusMsg{Epoch: 1, EpochTime: 100})
+	require.NoError(t, err)
+	require.False(t, first.Public[0].Equal(deal(other).Public[0]))
+}
+
+// testPeer 包装节点的 p2p，可以暂缓发送或按接收节点修改 DKG 消息
+type testPeer struct {
+	p2peer.Peer
+
+	mu   sync.Mutex
+	hold bool
+	held []func()
+	// 返回 nil 时丢弃发给 to 的消息
+	modify func(to *model.PubKey, msg *model.DkgMessage) *model.DkgMessage
+}
+
+func (p *testPeer) Send(to *model.To, message any) error {
+	msg, ok := message.(*model.DkgMessage)
+	if !ok || p.modify == nil {
+		return p.send(to, message)
+	}
+
+	nodes := p.AllNodes()
+	switch t := to.Payload.(type) {
+	case *model.To_Node:
+		nodes = []*model.PubKey{model.PubKeyFromByte(t.Node)}
+	case *model.To_Nodes:
+		nodes = make([]*model.PubKey, 0, len(t.Nodes.L))
+		for _, n := range t.Nodes.L {
+			nodes = append(nodes, model.PubKeyFromByte(n))
+		}
+	}
+	for _, node := range nodes {
+		if m := p.modify(node, proto.Clone(msg).(*model.DkgMessage)); m != nil {
+			if err := p.send(model.SendToNode(node), m); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *testPeer) send(to *model.To, message any) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.hold {
+		p.held = append(p.held, func() { p.Peer.Send(to, message) })
+		return nil
+	}
+	return p.Peer.Send(to, message)
+}
+
+// release 发送暂缓的消息
+func (p *testPeer) release() {
+	p.mu.Lock()
+	held := p.held
+	p.hold, p.held = false, nil
+	p.mu.Unlock()
+
+	for _, fn := range held {
+		fn()
+	}
+}
+
+// testGroup 模拟网络中的密钥组 a，节点 0 为发起者
+type testGroup struct {
+	net        *local.Network
+	secrets    []*model.PrivKey
+	nodes      []*model.PubKey
+	validators []*model.Validator
+	peers      []*testPeer
+	dkgs       []*DKG
+	// 结果和其他节点不一致的节点，不切换到新份额
+	faulty []int
+}
+
+func newTestGroup(t *testing.T, n int) *testGroup {
+	g := &testGroup{
+		net:        local.NewMemNetwork(),
+		secrets:    make([]*model.PrivKey, n),
+		nodes:      make([]*model.PubKey, n),
+		validators: make([]*model.Validator, n),
+		peers:      make([]*testPeer, n),
+		dkgs:       make([]*DKG, n),
+	}
+	var err error
+	for i := range n {
+		g.secrets[i], g.nodes[i], err = model.GenerateEd25519KeyPair(rand.Reader)
+		require.NoError(t, err)
+		g.validators[i] = &model.Validator{ValidatorId: *g.nodes[i], P2pId: *g.nodes[i]}
+	}
+	for i := range n {
+		g.peers[i] = &testPeer{Peer: g.net.NewPeer(g.secrets[i], g.nodes)}
+		g.start(t, i)
+	}
+	return g
+}
+
+// start 创建并启动节点 i 的 DKG，重启的节点从数据库恢复
+func (g *testGroup) start(t *testing.T, i int) {
+	d, err := NewGroupDKG("a", g.secrets[i], NewGroupMux(g.peers[i]), Logger{NodeTag: "a NODE " + fmt.Sprint(i)})
+	require.NoError(t, err)
+	// 每个节点在自己的消息循环中切换到新份额
+	d.SetGroupCallback(func(r *model.KeyGroupEpoch) {
+		for i, d := range g.dkgs {
+			if slices.Contains(g.faulty, i) {
+				continue
+			}
+			d.runLocal(func() {
+				if err := d.ApplyGroupEpoch(r.Epoch, r.DkgCommits); err != nil {
+					t.Error(err)
+				}
+			})
+		}
+	})
+	g.dkgs[i] = d
+	go d.Start()
+}
+
+func (g *testGroup) done(epoch uint32) bool {
+	for i, d := range g.dkgs {
+		if slices.Contains(g.faulty, i) {
+			continue
+		}
+		if !inLoop(d, func() bool { return d.Epoch == epoch && d.DkgKeyShare != nil }) {
+			return false
+		}
+	}
+	return true
+}
+
+// inLoop 在 DKG 的消息循环中读取状态，避免和消息处理并发
+func inLoop[T any](d *DKG, fn func() T) T {
+	res := make(chan T, 1)
+	d.runLocal(func() { res <- fn() })
+	return <-res
+}
+
+func roundPhase(d *DKG) string {
+	return inLoop(d, func() string {
+		if d.round == nil {
+			return ""
+		}
+		return d.round.Phase
+	})
+}
+
+func roundDeals(d *DKG) int {
+	return inLoop(d, func() int {
+		if d.round == nil {
+			return 0
+		}
+		return len(d.round.Deals)
+	})
+}
+
+func TestRoundPeerCmdIgnored(t *testing.T) {
+	os.RemoveAll("./chain_data")
+	db, err := model.NewDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// 节点 3 的 deal 暂缓发送，轮次停在 deal 阶段
+	g := newTestGroup(t, 4)
+	g.peers[3].hold = true
+	require.NoError(t, g.dkgs[0].TryGroupEpoch(g.validators, 1, model.ThresholdPolicy{}))
+	require.Eventually(t, func() bool { return roundDeals(g.dkgs[0]) == 3 }, 10*time.Second, 50*time.Millisecond)
+	sessionId := inLoop(g.dkgs[0], func() string { return g.dkgs[0].round.SessionId })
+
+	// 其他节点发送的终止和重启命令不会执行
+	payload, err := json.Marshal(sessionId)
+	require.NoError(t, err)
+	for _, ty := range []string{"round_abort", "round_restart", "justification_timeout"} {
+		require.NoError(t, g.dkgs[1].Peer.Send(model.SendToNode(g.nodes[0]), &model.DkgMessage{Type: ty, Payload: payload}))
+	}
+	time.Sleep(300 * time.Millisecond)
+	require.Equal(t, RoundPhaseDeal, roundPhase(g.dkgs[0]))
+	require.Equal(t, 3, roundDeals(g.dkgs[0]))
+
+	// 本节点可以终止
+	require.Error(t, g.dkgs[0].AbortRound("0-0"))
+	require.NoError(t, g.dkgs[0].AbortRound(sessionId))
+	require.Eventually(t, func() bool { return roundPhase(g.dkgs[0]) == RoundPhaseAborted }, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestRoundResume(t *testing.T) {
+	os.RemoveAll("./chain_data")
+	db, err := model.NewDB()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// 节点 3 的 deal 暂缓发送，节点 0 在收到其他 deal 后崩溃
+	g := newTestGroup(t, 4)
+	g.peers[3].hold = true
+	require.NoError(t, g.dkgs[0].TryGroupEpoch(g.validators, 1, model.ThresholdPolicy{}))
+	require.Eventually(t, func() bool {
+		for _, d := range g.dkgs {
+			if roundDeals(d) != 3 {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 50*time.Millisecond)
+
+	old := g.dkgs[0]
+	g.net.Crash(g.nodes[0])
+	old.Stop()
+
+	// 重启后从数据库恢复轮次，重放已收到的 deal
+	g.net.Restart(g.nodes[0])
+	g.start(t, 0)
+	require.NotSame(t, old, g.dkgs[0])
+	require.Eventually(t, func() bool { return roundDeals(g.dkgs[0]) == 3 }, 5*time.Second, 50*time.Millisecond)
+	require.Equal(t, RoundPhaseDeal, roundPhase(g.dkgs[0]))
+
+	g.peers[3].release()
+	require.Eventually(t, func() bool { return g.done(1) }, 10*time.Second, 100*time.Millisecond)
+	for _, d := range g.dkgs {
+		require.Equal(t, g.dkgs[0].DkgPubKey.SS58(), d.DkgPubKey.SS58())
+		pubPoly := share.NewPubPoly(d.Suite, nil, d.DkgKeyShare.Commitments())
+		require.True(t, pubPoly.Check(d.DkgKeyShare.PriShare()))
+	}
+}
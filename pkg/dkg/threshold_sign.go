@@ -0,0 +1,244 @@
+package dkg
+
+import (
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+// 应用门限签名（两轮 FROST 形式的分布式 Schnorr 签名）
+// 每个请求使用新的随机 nonce，签名结果是标准的 ed25519 签名，可以用 ed25519.Verify 验证
+// 应用的签名密钥由 DKG 密钥加上公开的 tweak 派生：s' = s + h，A' = A + h·G
+
+// SignNonce 节点一次签名使用的 nonce，签名后必须丢弃
+type SignNonce struct {
+	hiding  kyber.Scalar
+	binding kyber.Scalar
+	Commit  *SignCommitment
+}
+
+// SignCommitment nonce 的公开承诺
+type SignCommitment struct {
+	// 节点的份额序号
+	Index   uint32
+	Hiding  kyber.Point
+	Binding kyber.Point
+}
+
+// NewSignNonce 生成一次性的签名 nonce
+func NewSignNonce(suite suites.Suite, index uint32) *SignNonce {
+	hiding := suite.Scalar().Pick(suite.RandomStream())
+	binding := suite.Scalar().Pick(suite.RandomStream())
+	return &SignNonce{
+		hiding:  hiding,
+		binding: binding,
+		Commit: &SignCommitment{
+			Index:   index,
+			Hiding:  suite.Point().Mul(hiding, nil),
+			Binding: suite.Point().Mul(binding, nil),
+		},
+	}
+}
+
+// DeriveSignKey 为应用身份和 key id 派生签名密钥，返回 tweak 和派生的公钥
+func DeriveSignKey(suite suites.Suite, groupPub kyber.Point, identity []byte, keyId string) (kyber.Scalar, kyber.Point, error) {
+	pub, err := groupPub.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tweak := hashToScalar(suite, []byte("wetee/app-sign-key"), pub, lenPrefixed(identity), []byte(keyId))
+	derived := suite.Point().Add(groupPub, suite.Point().Mul(tweak, nil))
+	return tweak, derived, nil
+}
+
+// ThresholdPartialSign 使用本节点的份额和 nonce 生成部分签名
+// commits 为本轮所有签名节点的承诺，必须包含本节点
+func ThresholdPartialSign(suite suites.Suite, priShare *share.PriShare, tweak kyber.Scalar, nonce *SignNonce, signPub kyber.Point, msg []byte, commits []*SignCommitment) (kyber.Scalar, error) {
+	if err := checkCommitments(commits); err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(commits, func(c *SignCommitment) bool {
+		return c.Index == priShare.I && c.Hiding.Equal(nonce.Commit.Hiding) && c.Binding.Equal(nonce.Commit.Binding)
+	}) {
+		return nil, errors.New("own commitment not in signing set")
+	}
+
+	rhos, r, err := groupCommitment(suite, msg, commits)
+	if err != nil {
+		return nil, err
+	}
+	c, err := signChallenge(suite, r, signPub, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// z_i = d_i + e_i·ρ_i + λ_i·(s_i + h)·c
+	lambda := lagrangeCoeff(suite, priShare.I, commits)
+	key := suite.Scalar().Add(priShare.V, tweak)
+	z := suite.Scalar().Mul(lambda, suite.Scalar().Mul(key, c))
+	z.Add(z, nonce.hiding)
+	z.Add(z, suite.Scalar().Mul(nonce.binding, rhos[priShare.I]))
+	return z, nil
+}
+
+// VerifyPartialSign 使用节点的公开份额验证部分签名
+func VerifyPartialSign(suite suites.Suite, pubPoly *share.PubPoly, tweak kyber.Scalar, signPub kyber.Point, msg []byte, commits []*SignCommitment, index uint32, z kyber.Scalar) error {
+	if err := checkCommitments(commits); err != nil {
+		return err
+	}
+	i := slices.IndexFunc(commits, func(c *SignCommitment) bool { return c.Index == index })
+	if i < 0 {
+		return fmt.Errorf("node %d not in signing set", index)
+	}
+
+	rhos, r, err := groupCommitment(suite, msg, commits)
+	if err != nil {
+		return err
+	}
+	c, err := signChallenge(suite, r, signPub, msg)
+	if err != nil {
+		return err
+	}
+
+	// z_i·G == D_i + ρ_i·E_i + λ_i·c·(Y_i + h·G)
+	pubShare := pubPoly.Eval(index).V
+	key := suite.Point().Add(pubShare, suite.Point().Mul(tweak, nil))
+	expect := suite.Point().Mul(suite.Scalar().Mul(lagrangeCoeff(suite, index, commits), c), key)
+	expect.Add(expect, commits[i].Hiding)
+	expect.Add(expect, suite.Point().Mul(rhos[index], commits[i].Binding))
+
+	if !suite.Point().Mul(z, nil).Equal(expect) {
+		return fmt.Errorf("invalid partial signature from node %d", index)
+	}
+	return nil
+}
+
+// AggregateSign 聚合部分签名为 ed25519 签名 R || z
+func AggregateSign(suite suites.Suite, signPub kyber.Point, msg []byte, commits []*SignCommitment, partials []kyber.Scalar) ([]byte, error) {
+	if len(partials) != len(commits) {
+		return nil, errors.New("partial signatures and commitments mismatch")
+	}
+	_, r, err := groupCommitment(suite, msg, commits)
+	if err != nil {
+		return nil, err
+	}
+
+	z := suite.Scalar().Zero()
+	for _, p := range partials {
+		z.Add(z, p)
+	}
+
+	rb, err := r.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	zb, err := z.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	sig := append(rb, zb...)
+
+	pub, err := signPub.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if !ed25519.Verify(pub, msg, sig) {
+		return nil, errors.New("aggregated signature is invalid")
+	}
+	return sig, nil
+}
+
+// checkCommitments 签名集合不能为空，节点不能重复
+func checkCommitments(commits []*SignCommitment) error {
+	if len(commits) == 0 {
+		return errors.New("empty signing set")
+	}
+	seen := make(map[uint32]bool, len(commits))
+	for _, c := range commits {
+		if c == nil || c.Hiding == nil || c.Binding == nil {
+			return errors.New("invalid commitment")
+		}
+		if seen[c.Index] {
+			return fmt.Errorf("duplicate commitment of node %d", c.Index)
+		}
+		seen[c.Index] = true
+	}
+	return nil
+}
+
+// groupCommitment 计算每个节点的 binding factor 和组合 nonce R = Σ(D_i + ρ_i·E_i)
+func groupCommitment(suite suites.Suite, msg []byte, commits []*SignCommitment) (map[uint32]kyber.Scalar, kyber.Point, error) {
+	encoded := make([]byte, 0, len(commits)*68)
+	for _, c := range commits {
+		d, err := c.Hiding.MarshalBinary()
+		if err != nil {
+			return nil, nil, err
+		}
+		e, err := c.Binding.MarshalBinary()
+		if err != nil {
+			return nil, nil, err
+		}
+		encoded = binary.BigEndian.AppendUint32(encoded, c.Index)
+		encoded = append(encoded, d...)
+		encoded = append(encoded, e...)
+	}
+
+	rhos := make(map[uint32]kyber.Scalar, len(commits))
+	r := suite.Point().Null()
+	for _, c := range commits {
+		rho := hashToScalar(suite, []byte("wetee/app-sign-rho"), binary.BigEndian.AppendUint32(nil, c.Index), lenPrefixed(msg), encoded)
+		rhos[c.Index] = rho
+		r.Add(r, c.Hiding)
+		r.Add(r, suite.Point().Mul(rho, c.Binding))
+	}
+	return rhos, r, nil
+}
+
+// signChallenge ed25519 的 challenge：SHA512(R || A || M) mod l
+func signChallenge(suite suites.Suite, r, pub kyber.Point, msg []byte) (kyber.Scalar, error) {
+	rb, err := r.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	pb, err := pub.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return hashToScalar(suite, rb, pb, msg), nil
+}
+
+// lagrangeCoeff 节点 index 在签名集合中 x=0 处的拉格朗日系数，份额的 x 为 I+1
+func lagrangeCoeff(suite suites.Suite, index uint32, commits []*SignCommitment) kyber.Scalar {
+	xi := suite.Scalar().SetInt64(int64(index) + 1)
+	num := suite.Scalar().One()
+	den := suite.Scalar().One()
+	for _, c := range commits {
+		if c.Index == index {
+			continue
+		}
+		xj := suite.Scalar().SetInt64(int64(c.Index) + 1)
+		num.Mul(num, xj)
+		den.Mul(den, suite.Scalar().Sub(xj, xi))
+	}
+	return num.Div(num, den)
+}
+
+func hashToScalar(suite suites.Suite, parts ...[]byte) kyber.Scalar {
+	h := sha512.New()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return suite.Scalar().SetBytes(h.Sum(nil))
+}
+
+func lenPrefixed(b []byte) []byte {
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(b))), b...)
+}
@@ -0,0 +1,64 @@
+package dkg
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+func TestThresholdSign(t *testing.T) {
+	suite := suites.MustFind("Ed25519")
+	n, threshold := 5, 3
+
+	secret := suite.Scalar().Pick(suite.RandomStream())
+	priPoly := share.NewPriPoly(suite, threshold, secret, suite.RandomStream())
+	pubPoly := priPoly.Commit(nil)
+	shares := priPoly.Shares(n)
+
+	tweak, signPub, err := DeriveSignKey(suite, pubPoly.Commit(), []byte("app measurement"), "payments")
+	require.NoError(t, err)
+	_, otherPub, err := DeriveSignKey(suite, pubPoly.Commit(), []byte("app measurement"), "other")
+	require.NoError(t, err)
+	require.False(t, signPub.Equal(otherPub))
+
+	msg := []byte("transfer 10 to bob")
+	// 使用任意 threshold 个节点签名
+	for _, set := range [][]int{{0, 1, 2}, {1, 3, 4}, {4, 2, 0}} {
+		nonces := make([]*SignNonce, 0, len(set))
+		commits := make([]*SignCommitment, 0, len(set))
+		for _, i := range set {
+			nonce := NewSignNonce(suite, shares[i].I)
+			nonces = append(nonces, nonce)
+			commits = append(commits, nonce.Commit)
+		}
+
+		partials := make([]kyber.Scalar, 0, len(set))
+		for j, i := range set {
+			z, err := ThresholdPartialSign(suite, shares[i], tweak, nonces[j], signPub, msg, commits)
+			require.NoError(t, err)
+			require.NoError(t, VerifyPartialSign(suite, pubPoly, tweak, signPub, msg, commits, shares[i].I, z))
+			partials = append(partials, z)
+		}
+
+		sig, err := AggregateSign(suite, signPub, msg, commits, partials)
+		require.NoError(t, err)
+		pub, _ := signPub.MarshalBinary()
+		require.True(t, ed25519.Verify(pub, msg, sig))
+	}
+
+	// 错误的部分签名可以被识别
+	nonces := []*SignNonce{NewSignNonce(suite, shares[0].I), NewSignNonce(suite, shares[1].I), NewSignNonce(suite, shares[2].I)}
+	commits := []*SignCommitment{nonces[0].Commit, nonces[1].Commit, nonces[2].Commit}
+	z, err := ThresholdPartialSign(suite, shares[0], tweak, nonces[0], signPub, msg, commits)
+	require.NoError(t, err)
+	require.Error(t, VerifyPartialSign(suite, pubPoly, tweak, signPub, []byte("other msg"), commits, shares[0].I, z))
+	require.Error(t, VerifyPartialSign(suite, pubPoly, tweak, signPub, msg, commits, shares[1].I, z))
+
+	// 本节点的承诺不在签名集合中时拒绝签名
+	_, err = ThresholdPartialSign(suite, shares[3], tweak, NewSignNonce(suite, shares[3].I), signPub, msg, commits)
+	require.Error(t, err)
+}
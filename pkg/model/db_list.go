@@ -59,7 +59,8 @@ func GetList(namespace string, subkey string, cursor []byte, size int) ([][]byte
 	}
 
 	for ; iter.Valid() && len(list) < size; iter.Next() {
-		v := iter.Value()
+		// iter.Value 的内容在 Next 之后失效，需要复制
+		v := bytes.Clone(iter.Value())
 		value, err := util.Unseal(v, nil)
 		if err != nil {
 			return nil, nil, err
@@ -69,6 +69,24 @@ func (txn *Txn) DeletekeysByPrefix(prefix []byte) error {
 	return nil
 }
 
+// KeysInRange 返回 [lower, upper) 范围内的 key，包括交易中未提交的修改
+func (txn *Txn) KeysInRange(lower, upper []byte) ([][]byte, error) {
+	iter, err := txn.in.NewIter(&pebble.IterOptions{
+		LowerBound: lower,
+		UpperBound: upper,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	keys := [][]byte{}
+	for iter.First(); iter.Valid(); iter.Next() {
+		keys = append(keys, bytes.Clone(iter.Key()))
+	}
+	return keys, nil
+}
+
 func TxnGetJson[T any](txn *Txn, key []byte) (*T, error) {
 	v, err := txn.Get(key)
 	if err != nil {
@@ -59,3 +59,31 @@ func TestTxnDelete(t *testing.T) {
 		t.Error("value not equal")
 	}
 }
+
+func TestTxnKeysInRange(t *testing.T) {
+	os.RemoveAll(dbPath)
+	NewDB()
+	defer DBINS.Close()
+
+	tx := DBINS.NewTransaction()
+	tx.Set([]byte("k_1"), []byte("1"))
+	tx.Set([]byte("k_3"), []byte("3"))
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 未提交的修改和已提交的数据一起返回
+	tx = DBINS.NewTransaction()
+	defer tx.Rollback()
+	tx.Set([]byte("k_2"), []byte("2"))
+	tx.Delete([]byte("k_3"))
+	tx.Set([]byte("k_4"), []byte("4"))
+
+	keys, err := tx.KeysInRange([]byte("k_"), []byte("k_4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || string(keys[0]) != "k_1" || string(keys[1]) != "k_2" {
+		t.Errorf("unexpected keys %q", keys)
+	}
+}
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// 条件解密的状态
+const (
+	DisclosurePending   uint32 = 0
+	DisclosureReady     uint32 = 1
+	DisclosureDisclosed uint32 = 2
+)
+
+const (
+	// 条件解密密文的最大长度
+	MaxDisclosureSize = 64 * 1024
+	// 加密交易的最大长度
+	MaxEncryptedTxSize = 64 * 1024
+)
+
+// DisclosureLabel 条件解密密文的 label，证明绑定 owner 和 index
+// DisclosureLabel returns the label of a disclosure ciphertext, bound to owner and index
+func DisclosureLabel(owner types.H160, index uint64) []byte {
+	label := append([]byte("disclosure"), owner[:]...)
+	return binary.BigEndian.AppendUint64(label, index)
+}
+
+// EncryptedTxLabel 加密交易密文的 label，加密时还不知道交易打包的高度和位置
+// 证明使 label 只能由知道加密随机数的客户端设置，保存的 secret 和条件解密的密文不能作为加密交易解密
+var EncryptedTxLabel = []byte("etx")
+
+// ValidateInlineSecret 检查由网络公开解密的密文，payload 必须保存在密文中
+// payload 为空时公开解密的结果是 data key 本身，所以必须设置
+func ValidateInlineSecret(store *SecretStore, maxSize int) error {
+	if store == nil || len(store.RawEncCmt) == 0 || len(store.RawEncScrt) == 0 {
+		return errors.New("empty secret")
+	}
+	if len(store.PayloadCid) > 0 {
+		return errors.New("payload must be inline")
+	}
+	if len(store.Payload) == 0 {
+		return errors.New("empty payload")
+	}
+	if len(store.Proof) == 0 {
+		return errors.New("missing label proof")
+	}
+	if store.Size() > maxSize {
+		return errors.New("secret too large")
+	}
+	return nil
+}
+
+// SignBytes 返回 owner 需要签名的数据（不含 signature）
+// SignBytes returns the bytes signed by the owner, signature excluded
+func (d *SealDisclosure) SignBytes() ([]byte, error) {
+	c := proto.Clone(d).(*SealDisclosure)
+	c.Signature = nil
+	return proto.Marshal(c)
+}
+
+// OwnerH160 returns the H160 namespace of the owner
+func (d *SealDisclosure) OwnerH160() types.H160 {
+	return PubKeyFromByte(d.Owner).H160Address()
+}
+
+// VerifyOwner 验证密文和条件，并验证调用由 owner 签名
+func (d *SealDisclosure) VerifyOwner() error {
+	if len(d.Owner) != 32 {
+		return errors.New("seal disclosure: invalid owner")
+	}
+	if err := ValidateInlineSecret(d.Secret, MaxDisclosureSize); err != nil {
+		return errors.New("seal disclosure: " + err.Error())
+	}
+	if d.Condition == nil || d.Condition.Kind == nil {
+		return errors.New("seal disclosure: missing condition")
+	}
+	if r, ok := d.Condition.Kind.(*DisclosureCondition_OwnerRelease); ok && !r.OwnerRelease {
+		return errors.New("seal disclosure: invalid condition")
+	}
+
+	msg, err := d.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !SignVerify(d.Owner, msg, d.Signature) {
+		return errors.New("seal disclosure: invalid signature")
+	}
+	return nil
+}
+
+// SignBytes 返回 owner 需要签名的数据（不含 signature）
+// SignBytes returns the bytes signed by the owner, signature excluded
+func (r *ReleaseDisclosure) SignBytes() ([]byte, error) {
+	c := proto.Clone(r).(*ReleaseDisclosure)
+	c.Signature = nil
+	return proto.Marshal(c)
+}
+
+// OwnerH160 returns the H160 namespace of the owner
+func (r *ReleaseDisclosure) OwnerH160() types.H160 {
+	return PubKeyFromByte(r.Owner).H160Address()
+}
+
+// VerifyOwner 验证发布由 owner 签名
+func (r *ReleaseDisclosure) VerifyOwner() error {
+	if len(r.Owner) != 32 {
+		return errors.New("release disclosure: invalid owner")
+	}
+	msg, err := r.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !SignVerify(r.Owner, msg, r.Signature) {
+		return errors.New("release disclosure: invalid signature")
+	}
+	return nil
+}
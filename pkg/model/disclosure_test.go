@@ -0,0 +1,75 @@
+package model
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisclosureVerifyOwner(t *testing.T) {
+	owner, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	other, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	seal := &SealDisclosure{
+		Owner: owner.GetPublic().Byte(),
+		Index: 1,
+		Secret: &SecretStore{
+			RawEncCmt:  []byte{1},
+			RawEncScrt: [][]byte{{2}},
+			Payload:    []byte{3},
+			Proof:      []byte{4},
+		},
+		Condition: &DisclosureCondition{Kind: &DisclosureCondition_Height{Height: 100}},
+		Time:      10,
+	}
+	msg, err := seal.SignBytes()
+	require.NoError(t, err)
+	seal.Signature = ed25519.Sign(owner.PrivateKey, msg)
+	require.NoError(t, seal.VerifyOwner())
+
+	// changed condition
+	seal.Condition = &DisclosureCondition{Kind: &DisclosureCondition_Height{Height: 1}}
+	require.Error(t, seal.VerifyOwner())
+
+	// missing condition
+	seal.Condition = nil
+	msg, err = seal.SignBytes()
+	require.NoError(t, err)
+	seal.Signature = ed25519.Sign(owner.PrivateKey, msg)
+	require.Error(t, seal.VerifyOwner())
+
+	// empty payload, the data key itself would be disclosed
+	seal.Condition = &DisclosureCondition{Kind: &DisclosureCondition_OwnerRelease{OwnerRelease: true}}
+	seal.Secret.Payload = nil
+	msg, err = seal.SignBytes()
+	require.NoError(t, err)
+	seal.Signature = ed25519.Sign(owner.PrivateKey, msg)
+	require.Error(t, seal.VerifyOwner())
+
+	// payload in blob store
+	seal.Secret.Payload = []byte{3}
+	seal.Secret.PayloadCid = []byte{3}
+	msg, err = seal.SignBytes()
+	require.NoError(t, err)
+	seal.Signature = ed25519.Sign(owner.PrivateKey, msg)
+	require.Error(t, seal.VerifyOwner())
+
+	// signed by other account
+	release := &ReleaseDisclosure{
+		Owner: owner.GetPublic().Byte(),
+		Index: 1,
+		Time:  11,
+	}
+	msg, err = release.SignBytes()
+	require.NoError(t, err)
+	release.Signature = ed25519.Sign(other.PrivateKey, msg)
+	require.Error(t, release.VerifyOwner())
+
+	release.Signature = ed25519.Sign(owner.PrivateKey, msg)
+	require.NoError(t, release.VerifyOwner())
+	require.Equal(t, owner.GetPublic().H160Address(), release.OwnerH160())
+}
@@ -19,6 +19,10 @@ type ConsensusMsg struct {
 	OldValidators    []*Validator
 	Validators       []*Validator
 	ConsensusNodeNum int
+	// 新 epoch 使用的门限策略
+	Policy ThresholdPolicy
+	// epoch 内的份额刷新序号，0 表示切换 epoch
+	Refresh uint64
 }
 
 type DealBundle struct {
@@ -4,4 +4,6 @@ type NewEpochMsg struct {
 	Time int64
 	// MapAccountPartialSig []byte
 	PartialSig []byte
+	// 份额刷新和密钥组 DKG 完成后节点的签名确认
+	Ack *RoundAck
 }
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/vedhavyas/go-subkey/v2/sr25519"
+)
+
+// 节点生成的密钥类型
+const (
+	KeyTypeSymmetric = "symmetric"
+	KeyTypeEd25519   = "ed25519"
+	KeyTypeSr25519   = "sr25519"
+	KeyTypeSecp256k1 = "secp256k1"
+	KeyTypeX509      = "x509"
+)
+
+// KeyMaterial 节点内生成的密钥
+// Private 作为 secret 加密储存，Public 返回给用户
+type KeyMaterial struct {
+	Type string
+	// symmetric: 32 字节密钥; ed25519/sr25519: 32 字节 seed;
+	// secp256k1: 32 字节私钥; x509: PKCS#8 DER 编码的 P-256 私钥
+	Private []byte
+	// symmetric: 空; ed25519/sr25519: 32 字节公钥;
+	// secp256k1: 33 字节压缩公钥; x509: PEM 编码的 CSR
+	Public []byte
+}
+
+// GenerateKeyMaterial 生成指定类型的密钥，subject 为 x509 CSR 的 CommonName
+func GenerateKeyMaterial(keyType string, subject string) (*KeyMaterial, error) {
+	switch keyType {
+	case KeyTypeSymmetric:
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		return &KeyMaterial{Type: keyType, Private: key}, nil
+	case KeyTypeEd25519:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return &KeyMaterial{Type: keyType, Private: priv.Seed(), Public: pub}, nil
+	case KeyTypeSr25519:
+		kp, err := sr25519.Scheme{}.Generate()
+		if err != nil {
+			return nil, err
+		}
+		return &KeyMaterial{Type: keyType, Private: kp.Seed(), Public: kp.Public()}, nil
+	case KeyTypeSecp256k1:
+		priv, err := secp256k1.GeneratePrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		return &KeyMaterial{Type: keyType, Private: priv.Serialize(), Public: priv.PubKey().SerializeCompressed()}, nil
+	case KeyTypeX509:
+		return generateX509Key(subject)
+	default:
+		return nil, fmt.Errorf("unknown key type %q", keyType)
+	}
+}
+
+// generateX509Key 生成 P-256 私钥和对应的 CSR
+func generateX509Key(subject string) (*KeyMaterial, error) {
+	if subject == "" {
+		return nil, errors.New("x509 key requires a subject")
+	}
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{CommonName: subject},
+	}, priv)
+	if err != nil {
+		return nil, fmt.Errorf("create csr: %w", err)
+	}
+
+	return &KeyMaterial{
+		Type:    KeyTypeX509,
+		Private: der,
+		Public:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}),
+	}, nil
+}
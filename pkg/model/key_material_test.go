@@ -0,0 +1,51 @@
+package model
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/vedhavyas/go-subkey/v2/sr25519"
+)
+
+func TestGenerateKeyMaterial(t *testing.T) {
+	k, err := GenerateKeyMaterial(KeyTypeSymmetric, "")
+	require.NoError(t, err)
+	require.Len(t, k.Private, 32)
+	require.Empty(t, k.Public)
+
+	k, err = GenerateKeyMaterial(KeyTypeEd25519, "")
+	require.NoError(t, err)
+	require.Equal(t, []byte(ed25519.NewKeyFromSeed(k.Private).Public().(ed25519.PublicKey)), k.Public)
+
+	k, err = GenerateKeyMaterial(KeyTypeSr25519, "")
+	require.NoError(t, err)
+	kp, err := sr25519.Scheme{}.FromSeed(k.Private)
+	require.NoError(t, err)
+	require.Equal(t, kp.Public(), k.Public)
+
+	k, err = GenerateKeyMaterial(KeyTypeSecp256k1, "")
+	require.NoError(t, err)
+	require.Equal(t, secp256k1.PrivKeyFromBytes(k.Private).PubKey().SerializeCompressed(), k.Public)
+
+	k, err = GenerateKeyMaterial(KeyTypeX509, "pod.example")
+	require.NoError(t, err)
+	block, _ := pem.Decode(k.Public)
+	require.NotNil(t, block)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+	require.NoError(t, csr.CheckSignature())
+	require.Equal(t, "pod.example", csr.Subject.CommonName)
+	priv, err := x509.ParsePKCS8PrivateKey(k.Private)
+	require.NoError(t, err)
+	require.True(t, priv.(*ecdsa.PrivateKey).PublicKey.Equal(csr.PublicKey))
+
+	_, err = GenerateKeyMaterial(KeyTypeX509, "")
+	require.Error(t, err)
+	_, err = GenerateKeyMaterial("rsa", "")
+	require.Error(t, err)
+}
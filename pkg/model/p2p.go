@@ -2,6 +2,11 @@ package model
 
 import (
 	"bytes"
+	"crypto/rand"
+	"errors"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
 )
 
 // p2p send msg to all node
@@ -62,3 +67,59 @@ func (s *To) Check(id *PubKey) bool {
 	}
 	return false
 }
+
+// NewP2PEnvelope 使用发送节点的 P2P 密钥签名消息
+func NewP2PEnvelope(key *PrivKey, channel uint32, msg proto.Message) (*P2PEnvelope, error) {
+	bt, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	env := &P2PEnvelope{
+		From:      key.GetPublic().Byte(),
+		Timestamp: time.Now().Unix(),
+		Nonce:     nonce,
+		Channel:   channel,
+		Msg:       bt,
+	}
+	sb, err := env.SignBytes()
+	if err != nil {
+		return nil, err
+	}
+	env.Signature, err = key.ToSigner().Sign(sb)
+	if err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// SignBytes 返回发送节点需要签名的数据（不含 signature）
+// SignBytes returns the bytes signed by the sender, signature excluded
+func (e *P2PEnvelope) SignBytes() ([]byte, error) {
+	c := proto.Clone(e).(*P2PEnvelope)
+	c.Signature = nil
+	return proto.Marshal(c)
+}
+
+// Verify 验证信封由 from 签名，且发送时间与本地时间相差不超过 maxAge
+func (e *P2PEnvelope) Verify(maxAge time.Duration, now time.Time) error {
+	if len(e.From) != 32 || len(e.Nonce) == 0 {
+		return errors.New("p2p envelope: invalid sender or nonce")
+	}
+	age := now.Sub(time.Unix(e.Timestamp, 0))
+	if age > maxAge || age < -maxAge {
+		return errors.New("p2p envelope: expired")
+	}
+	msg, err := e.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !SignVerify(e.From, msg, e.Signature) {
+		return errors.New("p2p envelope: invalid signature")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestP2PEnvelope(t *testing.T) {
+	sender, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	other, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	msg := &DkgMessage{
+		To:      SendToNode(other.GetPublic()),
+		Type:    "deal",
+		Payload: make([]byte, 1024),
+	}
+	env, err := NewP2PEnvelope(sender, 255, msg)
+	require.NoError(t, err)
+	require.Equal(t, sender.GetPublic().Byte(), env.From)
+	require.NoError(t, env.Verify(time.Minute, time.Now()))
+
+	got := &DkgMessage{}
+	require.NoError(t, proto.Unmarshal(env.Msg, got))
+	require.Equal(t, "deal", got.Type)
+
+	// 过期
+	require.Error(t, env.Verify(time.Minute, time.Now().Add(2*time.Minute)))
+	require.Error(t, env.Verify(time.Minute, time.Now().Add(-2*time.Minute)))
+
+	// 冒充其他节点
+	spoof := proto.Clone(env).(*P2PEnvelope)
+	spoof.From = other.GetPublic().Byte()
+	require.Error(t, spoof.Verify(time.Minute, time.Now()))
+
+	// 篡改消息和通道
+	tampered := proto.Clone(env).(*P2PEnvelope)
+	tampered.Msg[0] ^= 1
+	require.Error(t, tampered.Verify(time.Minute, time.Now()))
+	tampered = proto.Clone(env).(*P2PEnvelope)
+	tampered.Channel = 254
+	require.Error(t, tampered.Verify(time.Minute, time.Now()))
+}
@@ -67,16 +67,30 @@ func (c *PersistChan[T]) Push(msg T) error {
 }
 
 func (c *PersistChan[T]) Start(handler func(T) error) {
-	for data := range c.listChan {
-		c.mu.Lock()
-		c.list = c.list[1:]
-		c.save()
-		c.mu.Unlock()
+	c.Run(handler, nil)
+}
+
+// Run 按顺序处理队列中的消息，同时在同一个 goroutine 中执行 local 中的函数
+// local 中的函数不持久化，只用于本节点产生的命令
+func (c *PersistChan[T]) Run(handler func(T) error, local <-chan func()) {
+	for {
+		select {
+		case data, ok := <-c.listChan:
+			if !ok {
+				return
+			}
+			c.mu.Lock()
+			c.list = c.list[1:]
+			c.save()
+			c.mu.Unlock()
 
-		handler(data)
-		// if err != nil {
-		// 	c.Push(data)
-		// }
+			handler(data)
+			// if err != nil {
+			// 	c.Push(data)
+			// }
+		case fn := <-local:
+			fn()
+		}
 	}
 }
 
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// 份额灾难恢复
+// 超过 n-t 个验证节点丢失密封状态时，DKG 密钥无法恢复，可选开启运维人员托管：
+// 1. 节点用随机数据密钥加密份额，数据密钥按 k-of-m 拆分后分别加密到运维人员的恢复公钥，托管文件保存在节点之外
+// 2. 新的 enclave 生成临时恢复公钥，由 TEE report 证明后发起恢复请求
+// 3. 运维人员验证 report 的代码度量和托管文件中固定的度量一致后把自己的分片重新加密到恢复公钥并签名
+// 4. enclave 收集 k 个批准后恢复份额，并使用链上的承诺验证
+
+// ShareEscrow 验证节点份额的恢复托管
+type ShareEscrow struct {
+	Validator *PubKey `json:"validator"`
+	Epoch     uint32  `json:"epoch"`
+	Refresh   uint64  `json:"refresh"`
+	// 恢复需要的运维人员批准数量 k
+	Threshold int `json:"threshold"`
+	// nonce || AEAD 加密的份额
+	Payload []byte `json:"payload"`
+	// 每个运维人员的数据密钥分片
+	Pieces []*EscrowPiece `json:"pieces"`
+	// 生成托管文件的 enclave 的 TEE 类型和代码度量，恢复请求必须来自相同的代码
+	Code *TeeVerifyResult `json:"code"`
+	Time int64            `json:"time"`
+}
+
+// EscrowPiece 加密到公钥的数据密钥分片
+type EscrowPiece struct {
+	Operator *PubKey  `json:"operator"`
+	EncCmt   []byte   `json:"enc_cmt"`
+	EncKey   [][]byte `json:"enc_key"`
+	Payload  []byte   `json:"payload"`
+}
+
+// RecoveryRequest 新的 enclave 请求恢复份额，Report 证明 RecoveryKey 由可信 TEE 生成
+type RecoveryRequest struct {
+	Validator   *PubKey `json:"validator"`
+	Epoch       uint32  `json:"epoch"`
+	Refresh     uint64  `json:"refresh"`
+	RecoveryKey *PubKey `json:"recovery_key"`
+	// 序列化的 TeeCall，绑定 RecoveryKey 和 ReportNonce
+	Report []byte `json:"report"`
+	Time   int64  `json:"time"`
+}
+
+// ReportNonce TEE report 中绑定的请求数据
+func (r *RecoveryRequest) ReportNonce() []byte {
+	return fmt.Appendf(nil, "share-recovery:%s:%d:%d:%d", r.Validator.SS58(), r.Epoch, r.Refresh, r.Time)
+}
+
+// RecoveryApproval 运维人员批准恢复，Piece 为重新加密到恢复公钥的分片
+type RecoveryApproval struct {
+	Operator    *PubKey      `json:"operator"`
+	Validator   *PubKey      `json:"validator"`
+	Epoch       uint32       `json:"epoch"`
+	Refresh     uint64       `json:"refresh"`
+	RecoveryKey *PubKey      `json:"recovery_key"`
+	Piece       *EscrowPiece `json:"piece"`
+	Signature   []byte       `json:"signature,omitempty"`
+}
+
+// SignBytes 运维人员签名的数据，不包含 Signature
+func (a *RecoveryApproval) SignBytes() ([]byte, error) {
+	c := *a
+	c.Signature = nil
+	return json.Marshal(&c)
+}
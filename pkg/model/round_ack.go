@@ -0,0 +1,50 @@
+package model
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"slices"
+)
+
+// 份额刷新和密钥组 DKG 由发起者提交交易，交易需要带上验证节点对结果的签名确认
+// 链上验证签名数量，发起者不能单独提交伪造的份额承诺
+
+// AckBytes 返回节点确认份额刷新时签名的数据：epoch、refresh 和承诺的哈希
+// AckBytes returns the bytes signed by a validator that finished the refresh
+func (r *ShareRefresh) AckBytes() []byte {
+	h := sha256.Sum256(r.Commits)
+	msg := []byte("share_refresh")
+	msg = binary.BigEndian.AppendUint32(msg, r.Epoch)
+	msg = binary.BigEndian.AppendUint64(msg, r.Refresh)
+	return append(msg, h[:]...)
+}
+
+// AckBytes 返回成员确认密钥组 DKG 时签名的数据：密钥组 id、epoch 和承诺的哈希
+// AckBytes returns the bytes signed by a member that finished the key group round
+func (r *KeyGroupEpoch) AckBytes() []byte {
+	h := sha256.Sum256(r.DkgCommits)
+	msg := []byte("key_group_epoch")
+	msg = binary.BigEndian.AppendUint32(msg, uint32(len(r.Id)))
+	msg = append(msg, r.Id...)
+	msg = binary.BigEndian.AppendUint32(msg, r.Epoch)
+	return append(msg, h[:]...)
+}
+
+// CountRoundAcks 返回对 msg 签名有效的不同验证节点数量，member 检查签名节点是否可以确认
+func CountRoundAcks(acks []*RoundAck, msg []byte, member func(validator []byte) bool) int {
+	signed := make([][]byte, 0, len(acks))
+	for _, ack := range acks {
+		if ack == nil || !member(ack.Validator) {
+			continue
+		}
+		if slices.ContainsFunc(signed, func(v []byte) bool { return bytes.Equal(v, ack.Validator) }) {
+			continue
+		}
+		if !SignVerify(ack.Validator, msg, ack.Signature) {
+			continue
+		}
+		signed = append(signed, ack.Validator)
+	}
+	return len(signed)
+}
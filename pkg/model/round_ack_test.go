@@ -0,0 +1,54 @@
+package model
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountRoundAcks(t *testing.T) {
+	v1, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	v2, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	other, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	r := &ShareRefresh{Epoch: 1, Refresh: 2, Commits: []byte("commits")}
+	msg := r.AckBytes()
+	ack := func(k *PrivKey, msg []byte) *RoundAck {
+		return &RoundAck{Validator: k.GetPublic().Byte(), Signature: ed25519.Sign(k.PrivateKey, msg)}
+	}
+	member := func(v []byte) bool {
+		return string(v) == string(v1.GetPublic().Byte()) || string(v) == string(v2.GetPublic().Byte())
+	}
+
+	acks := []*RoundAck{
+		ack(v1, msg),
+		ack(v1, msg),    // 重复
+		ack(other, msg), // 不是验证节点
+		nil,
+	}
+	require.Equal(t, 1, CountRoundAcks(acks, msg, member))
+
+	// 签名的承诺不同
+	changed := &ShareRefresh{Epoch: 1, Refresh: 2, Commits: []byte("forged")}
+	acks = append(acks, ack(v2, changed.AckBytes()))
+	require.Equal(t, 1, CountRoundAcks(acks, msg, member))
+
+	acks = append(acks, ack(v2, msg))
+	require.Equal(t, 2, CountRoundAcks(acks, msg, member))
+}
+
+func TestKeyGroupAckBytes(t *testing.T) {
+	r := &KeyGroupEpoch{Id: "eu", Epoch: 3, DkgCommits: []byte("commits")}
+
+	// 密钥组、epoch 和承诺不同时签名的数据不同
+	require.NotEqual(t, r.AckBytes(), (&KeyGroupEpoch{Id: "us", Epoch: 3, DkgCommits: []byte("commits")}).AckBytes())
+	require.NotEqual(t, r.AckBytes(), (&KeyGroupEpoch{Id: "eu", Epoch: 4, DkgCommits: []byte("commits")}).AckBytes())
+	require.NotEqual(t, r.AckBytes(), (&KeyGroupEpoch{Id: "eu", Epoch: 3, DkgCommits: []byte("forged")}).AckBytes())
+	// 和份额刷新的确认不能互换
+	require.NotEqual(t, r.AckBytes(), (&ShareRefresh{Epoch: 3, Commits: []byte("commits")}).AckBytes())
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// 签名数据的类型标签，授权和撤销的签名不能互相重放
+const (
+	GrantSignTag  = "tee-dsecret/grant/v1"
+	RevokeSignTag = "tee-dsecret/revoke/v1"
+)
+
+// SignChainId 侧链的 chain id（genesis 中的 chain_id），签名不能在其他侧链上重放
+// 启动侧链时设置
+var SignChainId = ""
+
+// domainSignBytes tag || 0 || chainId || 0 || msg
+func domainSignBytes(tag string, msg []byte) []byte {
+	buf := bytes.NewBuffer(make([]byte, 0, len(tag)+len(SignChainId)+len(msg)+2))
+	buf.WriteString(tag)
+	buf.WriteByte(0)
+	buf.WriteString(SignChainId)
+	buf.WriteByte(0)
+	buf.Write(msg)
+	return buf.Bytes()
+}
+
+// SignBytes 返回 owner 需要签名的数据：类型标签、chain id 和不含 signature 的 GrantSecret
+// SignBytes returns the bytes signed by the owner, signature excluded
+func (g *GrantSecret) SignBytes() ([]byte, error) {
+	c := proto.Clone(g).(*GrantSecret)
+	c.Signature = nil
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return domainSignBytes(GrantSignTag, msg), nil
+}
+
+// OwnerH160 returns the H160 namespace of the owner
+func (g *GrantSecret) OwnerH160() types.H160 {
+	return PubKeyFromByte(g.Owner).H160Address()
+}
+
+// VerifyOwner 验证授权由 secret 的 owner 签名
+func (g *GrantSecret) VerifyOwner() error {
+	if len(g.Owner) != 32 || len(g.Grantee) != 20 {
+		return errors.New("grant secret: invalid owner or grantee")
+	}
+	msg, err := g.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !SignVerify(g.Owner, msg, g.Signature) {
+		return errors.New("grant secret: invalid signature")
+	}
+	return nil
+}
+
+// SignBytes 返回 owner 需要签名的数据：类型标签、chain id 和不含 signature 的 RevokeSecret
+// SignBytes returns the bytes signed by the owner, signature excluded
+func (r *RevokeSecret) SignBytes() ([]byte, error) {
+	c := proto.Clone(r).(*RevokeSecret)
+	c.Signature = nil
+	msg, err := proto.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return domainSignBytes(RevokeSignTag, msg), nil
+}
+
+// OwnerH160 returns the H160 namespace of the owner
+func (r *RevokeSecret) OwnerH160() types.H160 {
+	return PubKeyFromByte(r.Owner).H160Address()
+}
+
+// VerifyOwner 验证撤销由 secret 的 owner 签名
+func (r *RevokeSecret) VerifyOwner() error {
+	if len(r.Owner) != 32 || len(r.Grantee) != 20 {
+		return errors.New("revoke secret: invalid owner or grantee")
+	}
+	msg, err := r.SignBytes()
+	if err != nil {
+		return err
+	}
+	if !SignVerify(r.Owner, msg, r.Signature) {
+		return errors.New("revoke secret: invalid signature")
+	}
+	return nil
+}
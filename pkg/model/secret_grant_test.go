@@ -0,0 +1,89 @@
+package model
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrantSecretVerifyOwner(t *testing.T) {
+	owner, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	other, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	grantee := other.GetPublic().H160Address()
+	grant := &GrantSecret{
+		Owner:   owner.GetPublic().Byte(),
+		Index:   1,
+		Grantee: grantee[:],
+		Expire:  100,
+		Time:    10,
+	}
+	msg, err := grant.SignBytes()
+	require.NoError(t, err)
+	grant.Signature = ed25519.Sign(owner.PrivateKey, msg)
+	require.NoError(t, grant.VerifyOwner())
+	require.Equal(t, owner.GetPublic().H160Address(), grant.OwnerH160())
+
+	// changed expire
+	grant.Expire = 0
+	require.Error(t, grant.VerifyOwner())
+
+	// signed by other account
+	revoke := &RevokeSecret{
+		Owner:   owner.GetPublic().Byte(),
+		Index:   1,
+		Grantee: grantee[:],
+		Time:    11,
+	}
+	msg, err = revoke.SignBytes()
+	require.NoError(t, err)
+	revoke.Signature = ed25519.Sign(other.PrivateKey, msg)
+	require.Error(t, revoke.VerifyOwner())
+
+	revoke.Signature = ed25519.Sign(owner.PrivateKey, msg)
+	require.NoError(t, revoke.VerifyOwner())
+}
+
+func TestRevokeSignatureNotGrant(t *testing.T) {
+	owner, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	grantee := types.H160{1}
+
+	revoke := &RevokeSecret{
+		Owner:   owner.GetPublic().Byte(),
+		Index:   1,
+		Grantee: grantee[:],
+		Time:    5,
+	}
+	msg, err := revoke.SignBytes()
+	require.NoError(t, err)
+	revoke.Signature = ed25519.Sign(owner.PrivateKey, msg)
+	require.NoError(t, revoke.VerifyOwner())
+
+	// 撤销的 time 和授权的 expire 编码相同，不含类型标签时签名可以重放为授权
+	grant := &GrantSecret{
+		Owner:     revoke.Owner,
+		Index:     revoke.Index,
+		Grantee:   revoke.Grantee,
+		Expire:    revoke.Time,
+		Signature: revoke.Signature,
+	}
+	rawGrant, err := proto.Marshal(&GrantSecret{Owner: grant.Owner, Index: grant.Index, Grantee: grant.Grantee, Expire: grant.Expire})
+	require.NoError(t, err)
+	rawRevoke, err := proto.Marshal(&RevokeSecret{Owner: revoke.Owner, Index: revoke.Index, Grantee: revoke.Grantee, Time: revoke.Time})
+	require.NoError(t, err)
+	require.Equal(t, rawRevoke, rawGrant)
+	require.Error(t, grant.VerifyOwner())
+
+	// 其他侧链上的签名无效
+	chainId := SignChainId
+	SignChainId = "other-chain"
+	defer func() { SignChainId = chainId }()
+	require.Error(t, revoke.VerifyOwner())
+}
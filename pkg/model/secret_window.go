@@ -0,0 +1,68 @@
+package model
+
+import (
+	"errors"
+)
+
+var (
+	ErrSecretNotActive = errors.New("secret is not active yet")
+	ErrSecretExpired   = errors.New("secret is expired")
+)
+
+// Validate 检查有效期设置
+func (w *SecretWindow) Validate() error {
+	if w == nil {
+		return nil
+	}
+	if w.NotAfter > 0 && w.NotAfter <= w.NotBefore {
+		return errors.New("secret window: not_after must be after not_before")
+	}
+	return nil
+}
+
+// Check 检查在给定的区块高度和时间是否可以访问 secret，未设置有效期时总是可以访问
+func (w *SecretWindow) Check(height int64, now int64) error {
+	if w == nil {
+		return nil
+	}
+
+	current := uint64(max(now, 0))
+	if w.ByHeight {
+		current = uint64(max(height, 0))
+	}
+	if w.NotBefore > 0 && current < w.NotBefore {
+		return ErrSecretNotActive
+	}
+	if w.NotAfter > 0 && current >= w.NotAfter {
+		return ErrSecretExpired
+	}
+	return nil
+}
+
+// Expired 检查 secret 过期是否已超过清理延迟
+// delayBlocks 用于按高度的有效期，delaySeconds 用于按时间的有效期
+func (w *SecretWindow) Expired(height int64, now int64, delayBlocks, delaySeconds uint64) bool {
+	if w == nil || w.NotAfter == 0 {
+		return false
+	}
+	if w.ByHeight {
+		return height >= 0 && uint64(height) >= w.NotAfter+delayBlocks
+	}
+	return now >= 0 && uint64(now) >= w.NotAfter+delaySeconds
+}
+
+// DefaultSecretSweepConfig 治理未设置时的清理配置：每 100 个区块清理一次，过期 14400 个区块或 1 天后删除
+func DefaultSecretSweepConfig() SecretSweepConfig {
+	return SecretSweepConfig{Interval: 100, DelayBlocks: 14400, DelaySeconds: 86400}
+}
+
+// Validate 检查清理配置
+func (c *SecretSweepConfig) Validate() error {
+	if c == nil {
+		return errors.New("secret sweep: config is empty")
+	}
+	if c.Interval <= 0 {
+		return errors.New("secret sweep: interval must be positive")
+	}
+	return nil
+}
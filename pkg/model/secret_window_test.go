@@ -0,0 +1,29 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretWindow(t *testing.T) {
+	var none *SecretWindow
+	require.NoError(t, none.Check(10, 10))
+	require.False(t, none.Expired(10, 10, 0, 0))
+
+	byTime := &SecretWindow{NotBefore: 100, NotAfter: 200}
+	require.NoError(t, byTime.Validate())
+	require.ErrorIs(t, byTime.Check(1000, 99), ErrSecretNotActive)
+	require.NoError(t, byTime.Check(0, 100))
+	require.ErrorIs(t, byTime.Check(0, 200), ErrSecretExpired)
+	require.False(t, byTime.Expired(0, 250, 0, 60))
+	require.True(t, byTime.Expired(0, 260, 0, 60))
+
+	byHeight := &SecretWindow{NotAfter: 50, ByHeight: true}
+	require.NoError(t, byHeight.Check(49, 1<<40))
+	require.ErrorIs(t, byHeight.Check(50, 0), ErrSecretExpired)
+	require.False(t, byHeight.Expired(59, 0, 10, 0))
+	require.True(t, byHeight.Expired(60, 0, 10, 0))
+
+	require.Error(t, (&SecretWindow{NotBefore: 10, NotAfter: 10}).Validate())
+}
@@ -69,7 +69,7 @@ func VerifyReport(reportData *TeeCall) (*TeeVerifyResult, error) {
 	case 1:
 		return SnpVerify(reportData)
 	case 9999:
-		return &TeeVerifyResult{}, nil
+		return &TeeVerifyResult{TeeType: 9999}, nil
 	}
 
 	return nil, errors.New("unknown tee type")
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	chain "github.com/wetee-dao/ink.go"
+	"golang.org/x/crypto/blake2b"
+)
+
+// TeeKeyReport tee_report 查询返回的结构
+// TeeKeyReport is the JSON returned by the tee_report query
+type TeeKeyReport struct {
+	// hex of report data, blake2b(pubkey) || nonce
+	Param string `json:"param"`
+	// marshaled TeeCall carrying the report
+	Report []byte `json:"report"`
+}
+
+// KeyReportData 计算 TEE report 需要绑定的数据
+// KeyReportData returns blake2b(pubkey) || nonce, the data bound into a key report
+func KeyReportData(pubKey []byte, nonce []byte) []byte {
+	h := blake2b.Sum256(pubKey)
+
+	var buf bytes.Buffer
+	buf.Write(h[:])
+	buf.Write(nonce)
+	return buf.Bytes()
+}
+
+// IssueKeyReport 生成绑定公钥哈希和客户端 nonce 的 TEE report
+// IssueKeyReport issues a TEE report binding the public key hash and the client nonce
+func IssueKeyReport(pk *chain.Signer, pubKey []byte, nonce []byte) (*TeeCall, error) {
+	call := &TeeCall{
+		Tx: &TeeCall_Text{
+			Text: KeyReportData(pubKey, nonce),
+		},
+	}
+
+	err := IssueReport(pk, call)
+	if err != nil {
+		return nil, err
+	}
+
+	return call, nil
+}
+
+// VerifyKeyReport 验证 tee_report 返回的 report 是否绑定了公钥和 nonce
+// 没有 TEE（TeeType 9999）的 report 只有 allowNoTee 时接受，调用方应固定返回的 TEE 类型和度量值
+// VerifyKeyReport verifies that a report from tee_report binds the given public key and nonce.
+// Clients must call it before encrypting with the key. Reports issued without TEE (TeeType 9999)
+// are rejected unless allowNoTee is set. Callers should pin the returned TeeType and
+// CodeSigner/CodeSignature/CodeProductId, which identify the enclave code.
+func VerifyKeyReport(report []byte, pubKey []byte, nonce []byte, allowNoTee bool) (*TeeVerifyResult, error) {
+	call := new(TeeCall)
+	err := call.Unmarshal(report)
+	if err != nil {
+		return nil, errors.New("unmarshal report: " + err.Error())
+	}
+
+	if call.TeeType == 9999 && !allowNoTee {
+		return nil, errors.New("report is not issued by a TEE")
+	}
+
+	if !bytes.Equal(call.GetText(), KeyReportData(pubKey, nonce)) {
+		return nil, errors.New("report data not match public key and nonce")
+	}
+
+	result, err := VerifyReport(call)
+	if err != nil {
+		return nil, err
+	}
+	result.TeeType = call.TeeType
+	return result, nil
+}
+
+// LocalTeeCode 本节点的 TEE 类型和代码度量，没有 TEE 时只有 TeeType 9999
+// LocalTeeCode returns the TEE type and code measurement of this node from a self issued report
+func LocalTeeCode(pk *chain.Signer) (*TeeVerifyResult, error) {
+	call, err := IssueKeyReport(pk, pk.PublicKey, []byte("local-tee-code"))
+	if err != nil {
+		return nil, err
+	}
+	result, err := VerifyReport(call)
+	if err != nil {
+		return nil, err
+	}
+	result.TeeType = call.TeeType
+	return result, nil
+}
+
+// SameTeeCode 检查 report 的 TEE 类型和度量是否和固定的值一致，固定值中为空的字段不检查
+// SameTeeCode checks the verified result against the pinned TEE type and code identity,
+// empty pinned fields are not checked, but a TEE result must pin at least one of them
+func SameTeeCode(pinned *TeeVerifyResult, result *TeeVerifyResult) error {
+	if pinned == nil {
+		return errors.New("no pinned code measurement")
+	}
+	if pinned.TeeType != result.TeeType {
+		return fmt.Errorf("tee type %d not match pinned %d", result.TeeType, pinned.TeeType)
+	}
+	if pinned.TeeType == 9999 {
+		return nil
+	}
+	if len(pinned.CodeSigner) == 0 && len(pinned.CodeSignature) == 0 && len(pinned.CodeProductId) == 0 {
+		return errors.New("no pinned code measurement")
+	}
+	if len(pinned.CodeSignature) > 0 && !bytes.Equal(pinned.CodeSignature, result.CodeSignature) {
+		return errors.New("code measurement not match pinned " + hex.EncodeToString(pinned.CodeSignature))
+	}
+	if len(pinned.CodeSigner) > 0 && !bytes.Equal(pinned.CodeSigner, result.CodeSigner) {
+		return errors.New("code signer not match pinned " + hex.EncodeToString(pinned.CodeSigner))
+	}
+	if len(pinned.CodeProductId) > 0 && !bytes.Equal(pinned.CodeProductId, result.CodeProductId) {
+		return errors.New("code product id not match pinned " + hex.EncodeToString(pinned.CodeProductId))
+	}
+	return nil
+}
+
+// VerifyRsaKeyReport 验证 secret_rsa 返回的 PEM 公钥是否属于可信 TEE
+// VerifyRsaKeyReport verifies the tee_report JSON against the PEM key from secret_rsa and the nonce sent with the query,
+// see VerifyKeyReport for allowNoTee and the returned result
+func VerifyRsaKeyReport(reportJson string, publicPem string, nonce []byte, allowNoTee bool) (*TeeVerifyResult, error) {
+	report := new(TeeKeyReport)
+	err := json.Unmarshal([]byte(reportJson), report)
+	if err != nil {
+		return nil, errors.New("unmarshal tee report: " + err.Error())
+	}
+
+	block, _ := pem.Decode([]byte(publicPem))
+	if block == nil || block.Type != "RSA PUBLIC KEY" {
+		return nil, errors.New("invalid rsa public key pem")
+	}
+
+	return VerifyKeyReport(report.Report, block.Bytes, nonce, allowNoTee)
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyReport(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pubKey := x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)
+
+	signer, _, err := GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+
+	nonce := []byte("client nonce")
+	call, err := IssueKeyReport(signer.ToSigner(), pubKey, nonce)
+	require.NoError(t, err)
+
+	report, err := call.Marshal()
+	require.NoError(t, err)
+
+	// 没有 TEE 的 report 需要显式允许
+	_, err = VerifyKeyReport(report, pubKey, nonce, false)
+	require.Error(t, err)
+	result, err := VerifyKeyReport(report, pubKey, nonce, true)
+	require.NoError(t, err)
+	require.Equal(t, uint32(9999), result.TeeType)
+
+	// wrong nonce
+	_, err = VerifyKeyReport(report, pubKey, []byte("other nonce"), true)
+	require.Error(t, err)
+
+	// verify from tee_report json and secret_rsa pem
+	bt, err := json.Marshal(TeeKeyReport{Report: report})
+	require.NoError(t, err)
+	pubPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: pubKey})
+
+	_, err = VerifyRsaKeyReport(string(bt), string(pubPem), nonce, true)
+	require.NoError(t, err)
+
+	// other rsa key
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&otherKey.PublicKey)})
+
+	_, err = VerifyRsaKeyReport(string(bt), string(otherPem), nonce, true)
+	require.Error(t, err)
+}
@@ -98,10 +98,11 @@ func SnpVerify(callData *TeeCall) (result *TeeVerifyResult, err error) {
 		return nil, errors.New("invalid report sign")
 	}
 
+	// 启动度量值标识运行的代码
 	return &TeeVerifyResult{
 		TeeType:       callData.TeeType,
 		CodeSigner:    []byte{},
-		CodeSignature: []byte{},
+		CodeSignature: attestation.Report.Measurement,
 		CodeProductId: []byte{},
 	}, nil
 }
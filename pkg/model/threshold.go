@@ -0,0 +1,63 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DefaultThresholdPolicy 默认门限策略：超过 2/3 的份额，超过 3/4 的新验证节点在线
+func DefaultThresholdPolicy() ThresholdPolicy {
+	return ThresholdPolicy{Num: 2, Den: 3, QuorumNum: 3, QuorumDen: 4}
+}
+
+// OrDefault 未设置的策略使用默认策略，用于开始新的 epoch
+// 门限策略之前创建的 epoch 保存的是空策略，门限见 Threshold
+func (p ThresholdPolicy) OrDefault() ThresholdPolicy {
+	if p.Den == 0 || p.QuorumDen == 0 {
+		return DefaultThresholdPolicy()
+	}
+	return p
+}
+
+// Validate 检查治理设置的策略
+// 门限不能低于 1/2，否则两个不相交的节点集合可以各自恢复密钥
+func (p ThresholdPolicy) Validate() error {
+	if p.Den == 0 || p.QuorumDen == 0 {
+		return errors.New("threshold policy: zero denominator")
+	}
+	if p.Num >= p.Den || p.QuorumNum >= p.QuorumDen {
+		return errors.New("threshold policy: ratio must be less than 1")
+	}
+	if 2*p.Num < p.Den {
+		return fmt.Errorf("threshold policy: %d/%d is less than 1/2", p.Num, p.Den)
+	}
+	return nil
+}
+
+// Threshold n 个节点时恢复密钥、签名需要的份额数量，即 DKG 多项式的门限 t
+// Threshold returns the number of shares needed out of n, in [1, n]
+func (p ThresholdPolicy) Threshold(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	// 空策略是门限策略之前创建的 epoch，保持原来的门限 n*2/3，刷新份额时不改变
+	if p.IsLegacy() {
+		return max(n*2/3, 1)
+	}
+	return min(n*int(p.Num)/int(p.Den)+1, n)
+}
+
+// IsLegacy 门限策略之前创建的 epoch 没有保存策略
+func (p ThresholdPolicy) IsLegacy() bool {
+	return p.Den == 0
+}
+
+// Quorum n 个新验证节点时开始 DKG 共识需要在线的节点数量，不低于门限
+// Quorum returns the number of online nodes needed to start a DKG round
+func (p ThresholdPolicy) Quorum(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	q := p.OrDefault()
+	return min(max(n*int(q.QuorumNum)/int(q.QuorumDen)+1, p.Threshold(n)), n)
+}
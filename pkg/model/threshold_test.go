@@ -0,0 +1,51 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestThresholdPolicy(t *testing.T) {
+	p := DefaultThresholdPolicy()
+	require.NoError(t, p.Validate())
+
+	thresholds := []int{1, 2, 3, 3, 4, 5, 5, 6, 7, 7}
+	quorums := []int{1, 2, 3, 4, 4, 5, 6, 7, 7, 8}
+	for n := 1; n <= 10; n++ {
+		th := p.Threshold(n)
+		require.Equal(t, thresholds[n-1], th, "threshold n=%d", n)
+		require.Equal(t, quorums[n-1], p.Quorum(n), "quorum n=%d", n)
+
+		// 超过 2/3，且可以容忍 (n-1)/3 个节点离线
+		require.Greater(t, 3*th, 2*n)
+		require.LessOrEqual(t, th, n-(n-1)/3)
+		require.GreaterOrEqual(t, p.Quorum(n), th)
+	}
+	require.Equal(t, 0, p.Threshold(0))
+	require.Equal(t, 0, p.Quorum(0))
+
+	// 门限策略之前创建的 epoch 保存的空策略保持原来的门限 n*2/3，在线要求和默认策略相同
+	legacy := ThresholdPolicy{}
+	require.True(t, legacy.IsLegacy())
+	require.Equal(t, p, legacy.OrDefault())
+	for n := 2; n <= 10; n++ {
+		require.Equal(t, n*2/3, legacy.Threshold(n))
+		require.Equal(t, p.Quorum(n), legacy.Quorum(n))
+	}
+	require.Equal(t, 1, legacy.Threshold(1))
+	require.Equal(t, 0, legacy.Threshold(0))
+
+	// 多数策略，在线要求不低于门限
+	majority := ThresholdPolicy{Num: 1, Den: 2, QuorumNum: 1, QuorumDen: 3}
+	require.NoError(t, majority.Validate())
+	for n := 1; n <= 10; n++ {
+		require.Equal(t, n/2+1, majority.Threshold(n))
+		require.Equal(t, majority.Threshold(n), majority.Quorum(n))
+	}
+
+	require.Error(t, ThresholdPolicy{}.Validate())
+	require.Error(t, ThresholdPolicy{Num: 1, Den: 3, QuorumNum: 3, QuorumDen: 4}.Validate())
+	require.Error(t, ThresholdPolicy{Num: 3, Den: 3, QuorumNum: 3, QuorumDen: 4}.Validate())
+	require.Error(t, ThresholdPolicy{Num: 2, Den: 3, QuorumNum: 4, QuorumDen: 4}.Validate())
+}
@@ -98,6 +98,19 @@ type Tx struct {
 	//	*Tx_SyncTxEnd
 	//	*Tx_SyncTxRetry
 	//	*Tx_DaoCall
+	//	*Tx_AuditLog
+	//	*Tx_DisclosureShare
+	//	*Tx_EncryptedTx
+	//	*Tx_EncryptedTxShare
+	//	*Tx_BeaconShare
+	//	*Tx_ThresholdPolicy
+	//	*Tx_ShareRefresh
+	//	*Tx_ShareRefreshStart
+	//	*Tx_KeyGroup
+	//	*Tx_KeyGroupEpoch
+	//	*Tx_DealerFault
+	//	*Tx_SecretMigrate
+	//	*Tx_SecretSweep
 	Payload              isTx_Payload `protobuf_oneof:"payload"`
 	Caller               []byte       `protobuf:"bytes,10,opt,name=caller,proto3" json:"caller,omitempty"`
 	Signature            []byte       `protobuf:"bytes,11,opt,name=signature,proto3" json:"signature,omitempty"`
@@ -169,15 +182,67 @@ type Tx_SyncTxRetry struct {
 type Tx_DaoCall struct {
 	DaoCall []byte `protobuf:"bytes,8,opt,name=dao_call,json=daoCall,proto3,oneof" json:"dao_call,omitempty"`
 }
+type Tx_AuditLog struct {
+	AuditLog *AuditLog `protobuf:"bytes,9,opt,name=audit_log,json=auditLog,proto3,oneof" json:"audit_log,omitempty"`
+}
+type Tx_DisclosureShare struct {
+	DisclosureShare *DisclosureShare `protobuf:"bytes,12,opt,name=disclosure_share,json=disclosureShare,proto3,oneof" json:"disclosure_share,omitempty"`
+}
+type Tx_EncryptedTx struct {
+	EncryptedTx *SecretStore `protobuf:"bytes,13,opt,name=encrypted_tx,json=encryptedTx,proto3,oneof" json:"encrypted_tx,omitempty"`
+}
+type Tx_EncryptedTxShare struct {
+	EncryptedTxShare *EncryptedTxShare `protobuf:"bytes,14,opt,name=encrypted_tx_share,json=encryptedTxShare,proto3,oneof" json:"encrypted_tx_share,omitempty"`
+}
+type Tx_BeaconShare struct {
+	BeaconShare *BeaconShare `protobuf:"bytes,15,opt,name=beacon_share,json=beaconShare,proto3,oneof" json:"beacon_share,omitempty"`
+}
+type Tx_ThresholdPolicy struct {
+	ThresholdPolicy *ThresholdPolicy `protobuf:"bytes,16,opt,name=threshold_policy,json=thresholdPolicy,proto3,oneof" json:"threshold_policy,omitempty"`
+}
+type Tx_ShareRefresh struct {
+	ShareRefresh *ShareRefresh `protobuf:"bytes,17,opt,name=share_refresh,json=shareRefresh,proto3,oneof" json:"share_refresh,omitempty"`
+}
+type Tx_ShareRefreshStart struct {
+	ShareRefreshStart int64 `protobuf:"varint,18,opt,name=share_refresh_start,json=shareRefreshStart,proto3,oneof" json:"share_refresh_start,omitempty"`
+}
+type Tx_KeyGroup struct {
+	KeyGroup *KeyGroup `protobuf:"bytes,19,opt,name=key_group,json=keyGroup,proto3,oneof" json:"key_group,omitempty"`
+}
+type Tx_KeyGroupEpoch struct {
+	KeyGroupEpoch *KeyGroupEpoch `protobuf:"bytes,20,opt,name=key_group_epoch,json=keyGroupEpoch,proto3,oneof" json:"key_group_epoch,omitempty"`
+}
+type Tx_DealerFault struct {
+	DealerFault *DealerFaultReport `protobuf:"bytes,21,opt,name=dealer_fault,json=dealerFault,proto3,oneof" json:"dealer_fault,omitempty"`
+}
+type Tx_SecretMigrate struct {
+	SecretMigrate *SecretMigrate `protobuf:"bytes,22,opt,name=secret_migrate,json=secretMigrate,proto3,oneof" json:"secret_migrate,omitempty"`
+}
+type Tx_SecretSweep struct {
+	SecretSweep *SecretSweepConfig `protobuf:"bytes,23,opt,name=secret_sweep,json=secretSweep,proto3,oneof" json:"secret_sweep,omitempty"`
+}
 
-func (*Tx_Empty) isTx_Payload()       {}
-func (*Tx_EpochEnd) isTx_Payload()    {}
-func (*Tx_EpochStart) isTx_Payload()  {}
-func (*Tx_HubCall) isTx_Payload()     {}
-func (*Tx_SyncTxStart) isTx_Payload() {}
-func (*Tx_SyncTxEnd) isTx_Payload()   {}
-func (*Tx_SyncTxRetry) isTx_Payload() {}
-func (*Tx_DaoCall) isTx_Payload()     {}
+func (*Tx_Empty) isTx_Payload()             {}
+func (*Tx_EpochEnd) isTx_Payload()          {}
+func (*Tx_EpochStart) isTx_Payload()        {}
+func (*Tx_HubCall) isTx_Payload()           {}
+func (*Tx_SyncTxStart) isTx_Payload()       {}
+func (*Tx_SyncTxEnd) isTx_Payload()         {}
+func (*Tx_SyncTxRetry) isTx_Payload()       {}
+func (*Tx_DaoCall) isTx_Payload()           {}
+func (*Tx_AuditLog) isTx_Payload()          {}
+func (*Tx_DisclosureShare) isTx_Payload()   {}
+func (*Tx_EncryptedTx) isTx_Payload()       {}
+func (*Tx_EncryptedTxShare) isTx_Payload()  {}
+func (*Tx_BeaconShare) isTx_Payload()       {}
+func (*Tx_ThresholdPolicy) isTx_Payload()   {}
+func (*Tx_ShareRefresh) isTx_Payload()      {}
+func (*Tx_ShareRefreshStart) isTx_Payload() {}
+func (*Tx_KeyGroup) isTx_Payload()          {}
+func (*Tx_KeyGroupEpoch) isTx_Payload()     {}
+func (*Tx_DealerFault) isTx_Payload()       {}
+func (*Tx_SecretMigrate) isTx_Payload()     {}
+func (*Tx_SecretSweep) isTx_Payload()       {}
 
 func (m *Tx) GetPayload() isTx_Payload {
 	if m != nil {
@@ -186,20 +251,6 @@ func (m *Tx) GetPayload() isTx_Payload {
 	return nil
 }
 
-func (m *Tx) GetCaller() []byte {
-	if m != nil {
-		return m.Caller
-	}
-	return nil
-}
-
-func (m *Tx) GetSignature() []byte {
-	if m != nil {
-		return m.Signature
-	}
-	return nil
-}
-
 func (m *Tx) GetEmpty() int64 {
 	if x, ok := m.GetPayload().(*Tx_Empty); ok {
 		return x.Empty
@@ -256,6 +307,111 @@ func (m *Tx) GetDaoCall() []byte {
 	return nil
 }
 
+func (m *Tx) GetAuditLog() *AuditLog {
+	if x, ok := m.GetPayload().(*Tx_AuditLog); ok {
+		return x.AuditLog
+	}
+	return nil
+}
+
+func (m *Tx) GetDisclosureShare() *DisclosureShare {
+	if x, ok := m.GetPayload().(*Tx_DisclosureShare); ok {
+		return x.DisclosureShare
+	}
+	return nil
+}
+
+func (m *Tx) GetEncryptedTx() *SecretStore {
+	if x, ok := m.GetPayload().(*Tx_EncryptedTx); ok {
+		return x.EncryptedTx
+	}
+	return nil
+}
+
+func (m *Tx) GetEncryptedTxShare() *EncryptedTxShare {
+	if x, ok := m.GetPayload().(*Tx_EncryptedTxShare); ok {
+		return x.EncryptedTxShare
+	}
+	return nil
+}
+
+func (m *Tx) GetBeaconShare() *BeaconShare {
+	if x, ok := m.GetPayload().(*Tx_BeaconShare); ok {
+		return x.BeaconShare
+	}
+	return nil
+}
+
+func (m *Tx) GetThresholdPolicy() *ThresholdPolicy {
+	if x, ok := m.GetPayload().(*Tx_ThresholdPolicy); ok {
+		return x.ThresholdPolicy
+	}
+	return nil
+}
+
+func (m *Tx) GetShareRefresh() *ShareRefresh {
+	if x, ok := m.GetPayload().(*Tx_ShareRefresh); ok {
+		return x.ShareRefresh
+	}
+	return nil
+}
+
+func (m *Tx) GetShareRefreshStart() int64 {
+	if x, ok := m.GetPayload().(*Tx_ShareRefreshStart); ok {
+		return x.ShareRefreshStart
+	}
+	return 0
+}
+
+func (m *Tx) GetKeyGroup() *KeyGroup {
+	if x, ok := m.GetPayload().(*Tx_KeyGroup); ok {
+		return x.KeyGroup
+	}
+	return nil
+}
+
+func (m *Tx) GetKeyGroupEpoch() *KeyGroupEpoch {
+	if x, ok := m.GetPayload().(*Tx_KeyGroupEpoch); ok {
+		return x.KeyGroupEpoch
+	}
+	return nil
+}
+
+func (m *Tx) GetDealerFault() *DealerFaultReport {
+	if x, ok := m.GetPayload().(*Tx_DealerFault); ok {
+		return x.DealerFault
+	}
+	return nil
+}
+
+func (m *Tx) GetSecretMigrate() *SecretMigrate {
+	if x, ok := m.GetPayload().(*Tx_SecretMigrate); ok {
+		return x.SecretMigrate
+	}
+	return nil
+}
+
+func (m *Tx) GetSecretSweep() *SecretSweepConfig {
+	if x, ok := m.GetPayload().(*Tx_SecretSweep); ok {
+		return x.SecretSweep
+	}
+	return nil
+}
+
+func (m *Tx) GetCaller() []byte {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
+func (m *Tx) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Tx) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -267,13 +423,28 @@ func (*Tx) XXX_OneofWrappers() []interface{} {
 		(*Tx_SyncTxEnd)(nil),
 		(*Tx_SyncTxRetry)(nil),
 		(*Tx_DaoCall)(nil),
+		(*Tx_AuditLog)(nil),
+		(*Tx_DisclosureShare)(nil),
+		(*Tx_EncryptedTx)(nil),
+		(*Tx_EncryptedTxShare)(nil),
+		(*Tx_BeaconShare)(nil),
+		(*Tx_ThresholdPolicy)(nil),
+		(*Tx_ShareRefresh)(nil),
+		(*Tx_ShareRefreshStart)(nil),
+		(*Tx_KeyGroup)(nil),
+		(*Tx_KeyGroupEpoch)(nil),
+		(*Tx_DealerFault)(nil),
+		(*Tx_SecretMigrate)(nil),
+		(*Tx_SecretSweep)(nil),
 	}
 }
 
 // side validator
 type SideValidator struct {
-	Pubkey               []byte   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	Power                int64    `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	Pubkey []byte `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Power  int64  `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	// 验证节点的 p2p key，节点提交的交易使用 p2p key 签名
+	P2PId                []byte   `protobuf:"bytes,3,opt,name=p2p_id,json=p2pId,proto3" json:"p2p_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -326,6 +497,13 @@ func (m *SideValidator) GetPower() int64 {
 	return 0
 }
 
+func (m *SideValidator) GetP2PId() []byte {
+	if m != nil {
+		return m.P2PId
+	}
+	return nil
+}
+
 // Call from TEE to mainchain
 type EpochEnd struct {
 	Epoch                uint32           `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
@@ -518,6 +696,7 @@ type DkgMessage struct {
 	Payload              []byte   `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
 	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	Type                 string   `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Group                string   `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -598,6 +777,13 @@ func (m *DkgMessage) GetType() string {
 	return ""
 }
 
+func (m *DkgMessage) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
 // block partial sign
 type BlockPartialSign struct {
 	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
@@ -821,6 +1007,95 @@ func (m *Nodes) GetL() [][]byte {
 	return nil
 }
 
+// 签名的 p2p 消息信封，接收节点验证发送节点、时间和随机数后再处理消息
+// Reactor message envelope signed by the sender's P2P key
+type P2PEnvelope struct {
+	From                 []byte   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Timestamp            int64    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Nonce                []byte   `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Channel              uint32   `protobuf:"varint,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Msg                  []byte   `protobuf:"bytes,5,opt,name=msg,proto3" json:"msg,omitempty"`
+	Signature            []byte   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *P2PEnvelope) Reset()         { *m = P2PEnvelope{} }
+func (m *P2PEnvelope) String() string { return proto.CompactTextString(m) }
+func (*P2PEnvelope) ProtoMessage()    {}
+func (*P2PEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{10}
+}
+func (m *P2PEnvelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *P2PEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_P2PEnvelope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *P2PEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_P2PEnvelope.Merge(m, src)
+}
+func (m *P2PEnvelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *P2PEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_P2PEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_P2PEnvelope proto.InternalMessageInfo
+
+func (m *P2PEnvelope) GetFrom() []byte {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *P2PEnvelope) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *P2PEnvelope) GetNonce() []byte {
+	if m != nil {
+		return m.Nonce
+	}
+	return nil
+}
+
+func (m *P2PEnvelope) GetChannel() uint32 {
+	if m != nil {
+		return m.Channel
+	}
+	return 0
+}
+
+func (m *P2PEnvelope) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *P2PEnvelope) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 // TEE call
 type TeeCall struct {
 	Caller  []byte `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
@@ -835,6 +1110,11 @@ type TeeCall struct {
 	//	*TeeCall_Text
 	//	*TeeCall_UploadSecret
 	//	*TeeCall_InitDisk
+	//	*TeeCall_GrantSecret
+	//	*TeeCall_RevokeSecret
+	//	*TeeCall_ThresholdSign
+	//	*TeeCall_SealDisclosure
+	//	*TeeCall_ReleaseDisclosure
 	Tx                   isTeeCall_Tx `protobuf_oneof:"tx"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
@@ -845,7 +1125,7 @@ func (m *TeeCall) Reset()         { *m = TeeCall{} }
 func (m *TeeCall) String() string { return proto.CompactTextString(m) }
 func (*TeeCall) ProtoMessage()    {}
 func (*TeeCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{10}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{11}
 }
 func (m *TeeCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -898,13 +1178,33 @@ type TeeCall_UploadSecret struct {
 type TeeCall_InitDisk struct {
 	InitDisk *InitDisk `protobuf:"bytes,10,opt,name=init_disk,json=initDisk,proto3,oneof" json:"init_disk,omitempty"`
 }
+type TeeCall_GrantSecret struct {
+	GrantSecret *GrantSecret `protobuf:"bytes,11,opt,name=grant_secret,json=grantSecret,proto3,oneof" json:"grant_secret,omitempty"`
+}
+type TeeCall_RevokeSecret struct {
+	RevokeSecret *RevokeSecret `protobuf:"bytes,12,opt,name=revoke_secret,json=revokeSecret,proto3,oneof" json:"revoke_secret,omitempty"`
+}
+type TeeCall_ThresholdSign struct {
+	ThresholdSign *ThresholdSign `protobuf:"bytes,13,opt,name=threshold_sign,json=thresholdSign,proto3,oneof" json:"threshold_sign,omitempty"`
+}
+type TeeCall_SealDisclosure struct {
+	SealDisclosure *SealDisclosure `protobuf:"bytes,14,opt,name=seal_disclosure,json=sealDisclosure,proto3,oneof" json:"seal_disclosure,omitempty"`
+}
+type TeeCall_ReleaseDisclosure struct {
+	ReleaseDisclosure *ReleaseDisclosure `protobuf:"bytes,15,opt,name=release_disclosure,json=releaseDisclosure,proto3,oneof" json:"release_disclosure,omitempty"`
+}
 
-func (*TeeCall_PodStart) isTeeCall_Tx()     {}
-func (*TeeCall_PodMint) isTeeCall_Tx()      {}
-func (*TeeCall_BridgeCall) isTeeCall_Tx()   {}
-func (*TeeCall_Text) isTeeCall_Tx()         {}
-func (*TeeCall_UploadSecret) isTeeCall_Tx() {}
-func (*TeeCall_InitDisk) isTeeCall_Tx()     {}
+func (*TeeCall_PodStart) isTeeCall_Tx()          {}
+func (*TeeCall_PodMint) isTeeCall_Tx()           {}
+func (*TeeCall_BridgeCall) isTeeCall_Tx()        {}
+func (*TeeCall_Text) isTeeCall_Tx()              {}
+func (*TeeCall_UploadSecret) isTeeCall_Tx()      {}
+func (*TeeCall_InitDisk) isTeeCall_Tx()          {}
+func (*TeeCall_GrantSecret) isTeeCall_Tx()       {}
+func (*TeeCall_RevokeSecret) isTeeCall_Tx()      {}
+func (*TeeCall_ThresholdSign) isTeeCall_Tx()     {}
+func (*TeeCall_SealDisclosure) isTeeCall_Tx()    {}
+func (*TeeCall_ReleaseDisclosure) isTeeCall_Tx() {}
 
 func (m *TeeCall) GetTx() isTeeCall_Tx {
 	if m != nil {
@@ -983,6 +1283,41 @@ func (m *TeeCall) GetInitDisk() *InitDisk {
 	return nil
 }
 
+func (m *TeeCall) GetGrantSecret() *GrantSecret {
+	if x, ok := m.GetTx().(*TeeCall_GrantSecret); ok {
+		return x.GrantSecret
+	}
+	return nil
+}
+
+func (m *TeeCall) GetRevokeSecret() *RevokeSecret {
+	if x, ok := m.GetTx().(*TeeCall_RevokeSecret); ok {
+		return x.RevokeSecret
+	}
+	return nil
+}
+
+func (m *TeeCall) GetThresholdSign() *ThresholdSign {
+	if x, ok := m.GetTx().(*TeeCall_ThresholdSign); ok {
+		return x.ThresholdSign
+	}
+	return nil
+}
+
+func (m *TeeCall) GetSealDisclosure() *SealDisclosure {
+	if x, ok := m.GetTx().(*TeeCall_SealDisclosure); ok {
+		return x.SealDisclosure
+	}
+	return nil
+}
+
+func (m *TeeCall) GetReleaseDisclosure() *ReleaseDisclosure {
+	if x, ok := m.GetTx().(*TeeCall_ReleaseDisclosure); ok {
+		return x.ReleaseDisclosure
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TeeCall) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -992,27 +1327,34 @@ func (*TeeCall) XXX_OneofWrappers() []interface{} {
 		(*TeeCall_Text)(nil),
 		(*TeeCall_UploadSecret)(nil),
 		(*TeeCall_InitDisk)(nil),
+		(*TeeCall_GrantSecret)(nil),
+		(*TeeCall_RevokeSecret)(nil),
+		(*TeeCall_ThresholdSign)(nil),
+		(*TeeCall_SealDisclosure)(nil),
+		(*TeeCall_ReleaseDisclosure)(nil),
 	}
 }
 
 // polkadot hub pod mint call
 type PodStart struct {
-	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AppId                []byte   `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
-	NameSpace            []byte   `protobuf:"bytes,3,opt,name=name_space,json=nameSpace,proto3" json:"name_space,omitempty"`
-	PubKey               []byte   `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	Secrets              []uint64 `protobuf:"varint,5,rep,packed,name=secrets,proto3" json:"secrets,omitempty"`
-	Disks                []uint64 `protobuf:"varint,6,rep,packed,name=disks,proto3" json:"disks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AppId                []byte          `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	NameSpace            []byte          `protobuf:"bytes,3,opt,name=name_space,json=nameSpace,proto3" json:"name_space,omitempty"`
+	PubKey               []byte          `protobuf:"bytes,4,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Secrets              []uint64        `protobuf:"varint,5,rep,packed,name=secrets,proto3" json:"secrets,omitempty"`
+	Disks                []uint64        `protobuf:"varint,6,rep,packed,name=disks,proto3" json:"disks,omitempty"`
+	Shared               []*SharedSecret `protobuf:"bytes,7,rep,name=shared,proto3" json:"shared,omitempty"`
+	ReplicaKeys          [][]byte        `protobuf:"bytes,8,rep,name=replica_keys,json=replicaKeys,proto3" json:"replica_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *PodStart) Reset()         { *m = PodStart{} }
 func (m *PodStart) String() string { return proto.CompactTextString(m) }
 func (*PodStart) ProtoMessage()    {}
 func (*PodStart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{11}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{12}
 }
 func (m *PodStart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,6 +1425,85 @@ func (m *PodStart) GetDisks() []uint64 {
 	return nil
 }
 
+func (m *PodStart) GetShared() []*SharedSecret {
+	if m != nil {
+		return m.Shared
+	}
+	return nil
+}
+
+func (m *PodStart) GetReplicaKeys() [][]byte {
+	if m != nil {
+		return m.ReplicaKeys
+	}
+	return nil
+}
+
+// 其他账户授权给 name_space 的 secret
+// Secret granted to name_space by another account
+type SharedSecret struct {
+	Owner                []byte   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Disk                 bool     `protobuf:"varint,3,opt,name=disk,proto3" json:"disk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SharedSecret) Reset()         { *m = SharedSecret{} }
+func (m *SharedSecret) String() string { return proto.CompactTextString(m) }
+func (*SharedSecret) ProtoMessage()    {}
+func (*SharedSecret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{13}
+}
+func (m *SharedSecret) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharedSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharedSecret.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SharedSecret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharedSecret.Merge(m, src)
+}
+func (m *SharedSecret) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharedSecret) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharedSecret.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharedSecret proto.InternalMessageInfo
+
+func (m *SharedSecret) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *SharedSecret) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *SharedSecret) GetDisk() bool {
+	if m != nil {
+		return m.Disk
+	}
+	return false
+}
+
 // polkadot hub pod mint call
 type PodMint struct {
 	Id                   uint64   `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
@@ -1096,7 +1517,7 @@ func (m *PodMint) Reset()         { *m = PodMint{} }
 func (m *PodMint) String() string { return proto.CompactTextString(m) }
 func (*PodMint) ProtoMessage()    {}
 func (*PodMint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{12}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{14}
 }
 func (m *PodMint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1151,7 +1572,7 @@ func (m *BridgeCall) Reset()         { *m = BridgeCall{} }
 func (m *BridgeCall) String() string { return proto.CompactTextString(m) }
 func (*BridgeCall) ProtoMessage()    {}
 func (*BridgeCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{13}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{15}
 }
 func (m *BridgeCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1202,7 +1623,7 @@ func (m *TeeVerifyResult) Reset()         { *m = TeeVerifyResult{} }
 func (m *TeeVerifyResult) String() string { return proto.CompactTextString(m) }
 func (*TeeVerifyResult) ProtoMessage()    {}
 func (*TeeVerifyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{14}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{16}
 }
 func (m *TeeVerifyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1266,6 +1687,7 @@ type UploadSecret struct {
 	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	Time                 uint64   `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
 	Hash                 []byte   `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
+	KeyType              string   `protobuf:"bytes,6,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1275,7 +1697,7 @@ func (m *UploadSecret) Reset()         { *m = UploadSecret{} }
 func (m *UploadSecret) String() string { return proto.CompactTextString(m) }
 func (*UploadSecret) ProtoMessage()    {}
 func (*UploadSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{15}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{17}
 }
 func (m *UploadSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,10 +1761,17 @@ func (m *UploadSecret) GetHash() []byte {
 	return nil
 }
 
-// Init disk
-type InitDisk struct {
-	User                 []byte   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+func (m *UploadSecret) GetKeyType() string {
+	if m != nil {
+		return m.KeyType
+	}
+	return ""
+}
+
+// Init disk
+type InitDisk struct {
+	User                 []byte   `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	Time                 uint64   `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
 	Hash                 []byte   `protobuf:"bytes,5,opt,name=hash,proto3" json:"hash,omitempty"`
@@ -1355,7 +1784,7 @@ func (m *InitDisk) Reset()         { *m = InitDisk{} }
 func (m *InitDisk) String() string { return proto.CompactTextString(m) }
 func (*InitDisk) ProtoMessage()    {}
 func (*InitDisk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{16}
+	return fileDescriptor_0fd2153dc07d3b5c, []int{18}
 }
 func (m *InitDisk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1419,32 +1848,32 @@ func (m *InitDisk) GetHash() []byte {
 	return nil
 }
 
-type SecretBox struct {
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To   *To    `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*SecretBox_Req
-	//	*SecretBox_SharesResp
-	//	*SecretBox_Resp
-	Payload              isSecretBox_Payload `protobuf_oneof:"payload"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+// Grant secret access to another account or app
+type GrantSecret struct {
+	Owner                []byte   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Disk                 bool     `protobuf:"varint,3,opt,name=disk,proto3" json:"disk,omitempty"`
+	Grantee              []byte   `protobuf:"bytes,4,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Expire               uint64   `protobuf:"varint,5,opt,name=expire,proto3" json:"expire,omitempty"`
+	Time                 uint64   `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	Signature            []byte   `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SecretBox) Reset()         { *m = SecretBox{} }
-func (m *SecretBox) String() string { return proto.CompactTextString(m) }
-func (*SecretBox) ProtoMessage()    {}
-func (*SecretBox) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{17}
+func (m *GrantSecret) Reset()         { *m = GrantSecret{} }
+func (m *GrantSecret) String() string { return proto.CompactTextString(m) }
+func (*GrantSecret) ProtoMessage()    {}
+func (*GrantSecret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{19}
 }
-func (m *SecretBox) XXX_Unmarshal(b []byte) error {
+func (m *GrantSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SecretBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GrantSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SecretBox.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GrantSecret.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1454,110 +1883,92 @@ func (m *SecretBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *SecretBox) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SecretBox.Merge(m, src)
+func (m *GrantSecret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantSecret.Merge(m, src)
 }
-func (m *SecretBox) XXX_Size() int {
+func (m *GrantSecret) XXX_Size() int {
 	return m.Size()
 }
-func (m *SecretBox) XXX_DiscardUnknown() {
-	xxx_messageInfo_SecretBox.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_SecretBox proto.InternalMessageInfo
-
-type isSecretBox_Payload interface {
-	isSecretBox_Payload()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type SecretBox_Req struct {
-	Req *PodStart `protobuf:"bytes,4,opt,name=req,proto3,oneof" json:"req,omitempty"`
-}
-type SecretBox_SharesResp struct {
-	SharesResp *DecryptSharesResp `protobuf:"bytes,5,opt,name=shares_resp,json=sharesResp,proto3,oneof" json:"shares_resp,omitempty"`
-}
-type SecretBox_Resp struct {
-	Resp *DecryptResp `protobuf:"bytes,6,opt,name=resp,proto3,oneof" json:"resp,omitempty"`
+func (m *GrantSecret) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantSecret.DiscardUnknown(m)
 }
 
-func (*SecretBox_Req) isSecretBox_Payload()        {}
-func (*SecretBox_SharesResp) isSecretBox_Payload() {}
-func (*SecretBox_Resp) isSecretBox_Payload()       {}
+var xxx_messageInfo_GrantSecret proto.InternalMessageInfo
 
-func (m *SecretBox) GetPayload() isSecretBox_Payload {
+func (m *GrantSecret) GetOwner() []byte {
 	if m != nil {
-		return m.Payload
+		return m.Owner
 	}
 	return nil
 }
 
-func (m *SecretBox) GetFrom() string {
+func (m *GrantSecret) GetIndex() uint64 {
 	if m != nil {
-		return m.From
+		return m.Index
 	}
-	return ""
+	return 0
 }
 
-func (m *SecretBox) GetTo() *To {
+func (m *GrantSecret) GetDisk() bool {
 	if m != nil {
-		return m.To
+		return m.Disk
 	}
-	return nil
+	return false
 }
 
-func (m *SecretBox) GetReq() *PodStart {
-	if x, ok := m.GetPayload().(*SecretBox_Req); ok {
-		return x.Req
+func (m *GrantSecret) GetGrantee() []byte {
+	if m != nil {
+		return m.Grantee
 	}
 	return nil
 }
 
-func (m *SecretBox) GetSharesResp() *DecryptSharesResp {
-	if x, ok := m.GetPayload().(*SecretBox_SharesResp); ok {
-		return x.SharesResp
+func (m *GrantSecret) GetExpire() uint64 {
+	if m != nil {
+		return m.Expire
 	}
-	return nil
+	return 0
 }
 
-func (m *SecretBox) GetResp() *DecryptResp {
-	if x, ok := m.GetPayload().(*SecretBox_Resp); ok {
-		return x.Resp
+func (m *GrantSecret) GetTime() uint64 {
+	if m != nil {
+		return m.Time
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*SecretBox) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*SecretBox_Req)(nil),
-		(*SecretBox_SharesResp)(nil),
-		(*SecretBox_Resp)(nil),
+func (m *GrantSecret) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
+	return nil
 }
 
-// secret data
-type SecretStore struct {
-	RawEncCmt            []byte   `protobuf:"bytes,1,opt,name=raw_enc_cmt,json=rawEncCmt,proto3" json:"raw_enc_cmt,omitempty"`
-	RawEncScrt           [][]byte `protobuf:"bytes,2,rep,name=raw_enc_scrt,json=rawEncScrt,proto3" json:"raw_enc_scrt,omitempty"`
+// Revoke granted secret access
+type RevokeSecret struct {
+	Owner                []byte   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Disk                 bool     `protobuf:"varint,3,opt,name=disk,proto3" json:"disk,omitempty"`
+	Grantee              []byte   `protobuf:"bytes,4,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Time                 uint64   `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	Signature            []byte   `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SecretStore) Reset()         { *m = SecretStore{} }
-func (m *SecretStore) String() string { return proto.CompactTextString(m) }
-func (*SecretStore) ProtoMessage()    {}
-func (*SecretStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{18}
+func (m *RevokeSecret) Reset()         { *m = RevokeSecret{} }
+func (m *RevokeSecret) String() string { return proto.CompactTextString(m) }
+func (*RevokeSecret) ProtoMessage()    {}
+func (*RevokeSecret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{20}
 }
-func (m *SecretStore) XXX_Unmarshal(b []byte) error {
+func (m *RevokeSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SecretStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevokeSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SecretStore.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevokeSecret.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1567,55 +1978,86 @@ func (m *SecretStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SecretStore) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SecretStore.Merge(m, src)
+func (m *RevokeSecret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSecret.Merge(m, src)
 }
-func (m *SecretStore) XXX_Size() int {
+func (m *RevokeSecret) XXX_Size() int {
 	return m.Size()
 }
-func (m *SecretStore) XXX_DiscardUnknown() {
-	xxx_messageInfo_SecretStore.DiscardUnknown(m)
+func (m *RevokeSecret) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSecret.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SecretStore proto.InternalMessageInfo
+var xxx_messageInfo_RevokeSecret proto.InternalMessageInfo
 
-func (m *SecretStore) GetRawEncCmt() []byte {
+func (m *RevokeSecret) GetOwner() []byte {
 	if m != nil {
-		return m.RawEncCmt
+		return m.Owner
 	}
 	return nil
 }
 
-func (m *SecretStore) GetRawEncScrt() [][]byte {
+func (m *RevokeSecret) GetIndex() uint64 {
 	if m != nil {
-		return m.RawEncScrt
+		return m.Index
+	}
+	return 0
+}
+
+func (m *RevokeSecret) GetDisk() bool {
+	if m != nil {
+		return m.Disk
+	}
+	return false
+}
+
+func (m *RevokeSecret) GetGrantee() []byte {
+	if m != nil {
+		return m.Grantee
 	}
 	return nil
 }
 
-// DecryptShare
-type DecryptShare struct {
-	ShareIndex           int32    `protobuf:"varint,2,opt,name=share_index,json=shareIndex,proto3" json:"share_index,omitempty"`
-	XncSki               []byte   `protobuf:"bytes,3,opt,name=xnc_ski,json=xncSki,proto3" json:"xnc_ski,omitempty"`
-	Chlgi                []byte   `protobuf:"bytes,4,opt,name=chlgi,proto3" json:"chlgi,omitempty"`
-	Proofi               []byte   `protobuf:"bytes,5,opt,name=proofi,proto3" json:"proofi,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RevokeSecret) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
 }
 
-func (m *DecryptShare) Reset()         { *m = DecryptShare{} }
-func (m *DecryptShare) String() string { return proto.CompactTextString(m) }
-func (*DecryptShare) ProtoMessage()    {}
-func (*DecryptShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{19}
+func (m *RevokeSecret) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
 }
-func (m *DecryptShare) XXX_Unmarshal(b []byte) error {
+
+// 条件满足后由网络解密并公开的密文
+// Ciphertext decrypted by the network and published once the condition holds
+type SealDisclosure struct {
+	Owner                []byte               `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Index                uint64               `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Secret               *SecretStore         `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Condition            *DisclosureCondition `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	Time                 uint64               `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	Signature            []byte               `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SealDisclosure) Reset()         { *m = SealDisclosure{} }
+func (m *SealDisclosure) String() string { return proto.CompactTextString(m) }
+func (*SealDisclosure) ProtoMessage()    {}
+func (*SealDisclosure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{21}
+}
+func (m *SealDisclosure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DecryptShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SealDisclosure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DecryptShare.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SealDisclosure.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1625,69 +2067,85 @@ func (m *DecryptShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DecryptShare) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DecryptShare.Merge(m, src)
+func (m *SealDisclosure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealDisclosure.Merge(m, src)
 }
-func (m *DecryptShare) XXX_Size() int {
+func (m *SealDisclosure) XXX_Size() int {
 	return m.Size()
 }
-func (m *DecryptShare) XXX_DiscardUnknown() {
-	xxx_messageInfo_DecryptShare.DiscardUnknown(m)
+func (m *SealDisclosure) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealDisclosure.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DecryptShare proto.InternalMessageInfo
+var xxx_messageInfo_SealDisclosure proto.InternalMessageInfo
 
-func (m *DecryptShare) GetShareIndex() int32 {
+func (m *SealDisclosure) GetOwner() []byte {
 	if m != nil {
-		return m.ShareIndex
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *SealDisclosure) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
 	return 0
 }
 
-func (m *DecryptShare) GetXncSki() []byte {
+func (m *SealDisclosure) GetSecret() *SecretStore {
 	if m != nil {
-		return m.XncSki
+		return m.Secret
 	}
 	return nil
 }
 
-func (m *DecryptShare) GetChlgi() []byte {
+func (m *SealDisclosure) GetCondition() *DisclosureCondition {
 	if m != nil {
-		return m.Chlgi
+		return m.Condition
 	}
 	return nil
 }
 
-func (m *DecryptShare) GetProofi() []byte {
+func (m *SealDisclosure) GetTime() uint64 {
 	if m != nil {
-		return m.Proofi
+		return m.Time
 	}
-	return nil
+	return 0
 }
 
-// DecryptShares
-type DecryptSharesResp struct {
-	Req                  *PodStart                `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
-	SecretShares         map[uint64]*DecryptShare `protobuf:"bytes,3,rep,name=secret_shares,json=secretShares,proto3" json:"secret_shares,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	DiskShares           map[uint64]*DecryptShare `protobuf:"bytes,4,rep,name=disk_shares,json=diskShares,proto3" json:"disk_shares,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Error                []byte                   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *SealDisclosure) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
 }
 
-func (m *DecryptSharesResp) Reset()         { *m = DecryptSharesResp{} }
-func (m *DecryptSharesResp) String() string { return proto.CompactTextString(m) }
-func (*DecryptSharesResp) ProtoMessage()    {}
-func (*DecryptSharesResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{20}
+// Disclosure condition
+type DisclosureCondition struct {
+	// Types that are valid to be assigned to Kind:
+	//
+	//	*DisclosureCondition_DaoProposal
+	//	*DisclosureCondition_Height
+	//	*DisclosureCondition_OwnerRelease
+	Kind                 isDisclosureCondition_Kind `protobuf_oneof:"kind"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *DisclosureCondition) Reset()         { *m = DisclosureCondition{} }
+func (m *DisclosureCondition) String() string { return proto.CompactTextString(m) }
+func (*DisclosureCondition) ProtoMessage()    {}
+func (*DisclosureCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{22}
 }
-func (m *DecryptSharesResp) XXX_Unmarshal(b []byte) error {
+func (m *DisclosureCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DecryptSharesResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DisclosureCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DecryptSharesResp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DisclosureCondition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1697,68 +2155,99 @@ func (m *DecryptSharesResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DecryptSharesResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DecryptSharesResp.Merge(m, src)
+func (m *DisclosureCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisclosureCondition.Merge(m, src)
 }
-func (m *DecryptSharesResp) XXX_Size() int {
+func (m *DisclosureCondition) XXX_Size() int {
 	return m.Size()
 }
-func (m *DecryptSharesResp) XXX_DiscardUnknown() {
-	xxx_messageInfo_DecryptSharesResp.DiscardUnknown(m)
+func (m *DisclosureCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisclosureCondition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DecryptSharesResp proto.InternalMessageInfo
+var xxx_messageInfo_DisclosureCondition proto.InternalMessageInfo
 
-func (m *DecryptSharesResp) GetReq() *PodStart {
+type isDisclosureCondition_Kind interface {
+	isDisclosureCondition_Kind()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type DisclosureCondition_DaoProposal struct {
+	DaoProposal uint32 `protobuf:"varint,1,opt,name=dao_proposal,json=daoProposal,proto3,oneof" json:"dao_proposal,omitempty"`
+}
+type DisclosureCondition_Height struct {
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3,oneof" json:"height,omitempty"`
+}
+type DisclosureCondition_OwnerRelease struct {
+	OwnerRelease bool `protobuf:"varint,3,opt,name=owner_release,json=ownerRelease,proto3,oneof" json:"owner_release,omitempty"`
+}
+
+func (*DisclosureCondition_DaoProposal) isDisclosureCondition_Kind()  {}
+func (*DisclosureCondition_Height) isDisclosureCondition_Kind()       {}
+func (*DisclosureCondition_OwnerRelease) isDisclosureCondition_Kind() {}
+
+func (m *DisclosureCondition) GetKind() isDisclosureCondition_Kind {
 	if m != nil {
-		return m.Req
+		return m.Kind
 	}
 	return nil
 }
 
-func (m *DecryptSharesResp) GetSecretShares() map[uint64]*DecryptShare {
-	if m != nil {
-		return m.SecretShares
+func (m *DisclosureCondition) GetDaoProposal() uint32 {
+	if x, ok := m.GetKind().(*DisclosureCondition_DaoProposal); ok {
+		return x.DaoProposal
 	}
-	return nil
+	return 0
 }
 
-func (m *DecryptSharesResp) GetDiskShares() map[uint64]*DecryptShare {
-	if m != nil {
-		return m.DiskShares
+func (m *DisclosureCondition) GetHeight() uint64 {
+	if x, ok := m.GetKind().(*DisclosureCondition_Height); ok {
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-func (m *DecryptSharesResp) GetError() []byte {
-	if m != nil {
-		return m.Error
+func (m *DisclosureCondition) GetOwnerRelease() bool {
+	if x, ok := m.GetKind().(*DisclosureCondition_OwnerRelease); ok {
+		return x.OwnerRelease
 	}
-	return nil
+	return false
 }
 
-// Decrypt resp
-type DecryptResp struct {
-	DkgKey               []byte             `protobuf:"bytes,1,opt,name=dkg_key,json=dkgKey,proto3" json:"dkg_key,omitempty"`
-	Secrets              map[uint64]*Secret `protobuf:"bytes,3,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	DiskKeys             map[uint64]*Secret `protobuf:"bytes,4,rep,name=disk_keys,json=diskKeys,proto3" json:"disk_keys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*DisclosureCondition) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*DisclosureCondition_DaoProposal)(nil),
+		(*DisclosureCondition_Height)(nil),
+		(*DisclosureCondition_OwnerRelease)(nil),
+	}
 }
 
-func (m *DecryptResp) Reset()         { *m = DecryptResp{} }
-func (m *DecryptResp) String() string { return proto.CompactTextString(m) }
-func (*DecryptResp) ProtoMessage()    {}
-func (*DecryptResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{21}
+// owner 发布条件解密
+// Owner release of a sealed disclosure
+type ReleaseDisclosure struct {
+	Owner                []byte   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Time                 uint64   `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Signature            []byte   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *DecryptResp) XXX_Unmarshal(b []byte) error {
+
+func (m *ReleaseDisclosure) Reset()         { *m = ReleaseDisclosure{} }
+func (m *ReleaseDisclosure) String() string { return proto.CompactTextString(m) }
+func (*ReleaseDisclosure) ProtoMessage()    {}
+func (*ReleaseDisclosure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{23}
+}
+func (m *ReleaseDisclosure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DecryptResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReleaseDisclosure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DecryptResp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReleaseDisclosure.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1768,60 +2257,74 @@ func (m *DecryptResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DecryptResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DecryptResp.Merge(m, src)
+func (m *ReleaseDisclosure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseDisclosure.Merge(m, src)
 }
-func (m *DecryptResp) XXX_Size() int {
+func (m *ReleaseDisclosure) XXX_Size() int {
 	return m.Size()
 }
-func (m *DecryptResp) XXX_DiscardUnknown() {
-	xxx_messageInfo_DecryptResp.DiscardUnknown(m)
+func (m *ReleaseDisclosure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseDisclosure.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DecryptResp proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseDisclosure proto.InternalMessageInfo
 
-func (m *DecryptResp) GetDkgKey() []byte {
+func (m *ReleaseDisclosure) GetOwner() []byte {
 	if m != nil {
-		return m.DkgKey
+		return m.Owner
 	}
 	return nil
 }
 
-func (m *DecryptResp) GetSecrets() map[uint64]*Secret {
+func (m *ReleaseDisclosure) GetIndex() uint64 {
 	if m != nil {
-		return m.Secrets
+		return m.Index
 	}
-	return nil
+	return 0
 }
 
-func (m *DecryptResp) GetDiskKeys() map[uint64]*Secret {
+func (m *ReleaseDisclosure) GetTime() uint64 {
 	if m != nil {
-		return m.DiskKeys
+		return m.Time
 	}
-	return nil
+	return 0
 }
 
-// Decrypted secret
-type Secret struct {
-	XncCmt               []byte   `protobuf:"bytes,1,opt,name=xnc_cmt,json=xncCmt,proto3" json:"xnc_cmt,omitempty"`
-	EncScrt              [][]byte `protobuf:"bytes,2,rep,name=enc_scrt,json=encScrt,proto3" json:"enc_scrt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ReleaseDisclosure) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
 }
 
-func (m *Secret) Reset()         { *m = Secret{} }
-func (m *Secret) String() string { return proto.CompactTextString(m) }
-func (*Secret) ProtoMessage()    {}
-func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{22}
+// 条件解密的状态
+// State of a sealed disclosure
+type Disclosure struct {
+	Seal                 *SealDisclosure `protobuf:"bytes,1,opt,name=seal,proto3" json:"seal,omitempty"`
+	Status               uint32          `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	ReadyHeight          int64           `protobuf:"varint,3,opt,name=ready_height,json=readyHeight,proto3" json:"ready_height,omitempty"`
+	DisclosedHeight      int64           `protobuf:"varint,4,opt,name=disclosed_height,json=disclosedHeight,proto3" json:"disclosed_height,omitempty"`
+	Plaintext            []byte          `protobuf:"bytes,5,opt,name=plaintext,proto3" json:"plaintext,omitempty"`
+	Error                string          `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Epoch                uint32          `protobuf:"varint,7,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Shares               []*DecryptShare `protobuf:"bytes,8,rep,name=shares,proto3" json:"shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Disclosure) Reset()         { *m = Disclosure{} }
+func (m *Disclosure) String() string { return proto.CompactTextString(m) }
+func (*Disclosure) ProtoMessage()    {}
+func (*Disclosure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{24}
 }
-func (m *Secret) XXX_Unmarshal(b []byte) error {
+func (m *Disclosure) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Secret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Disclosure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Secret.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Disclosure.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1831,53 +2334,98 @@ func (m *Secret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Secret) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Secret.Merge(m, src)
+func (m *Disclosure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Disclosure.Merge(m, src)
 }
-func (m *Secret) XXX_Size() int {
+func (m *Disclosure) XXX_Size() int {
 	return m.Size()
 }
-func (m *Secret) XXX_DiscardUnknown() {
-	xxx_messageInfo_Secret.DiscardUnknown(m)
+func (m *Disclosure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Disclosure.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Secret proto.InternalMessageInfo
+var xxx_messageInfo_Disclosure proto.InternalMessageInfo
 
-func (m *Secret) GetXncCmt() []byte {
+func (m *Disclosure) GetSeal() *SealDisclosure {
 	if m != nil {
-		return m.XncCmt
+		return m.Seal
 	}
 	return nil
 }
 
-func (m *Secret) GetEncScrt() [][]byte {
+func (m *Disclosure) GetStatus() uint32 {
 	if m != nil {
-		return m.EncScrt
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Disclosure) GetReadyHeight() int64 {
+	if m != nil {
+		return m.ReadyHeight
+	}
+	return 0
+}
+
+func (m *Disclosure) GetDisclosedHeight() int64 {
+	if m != nil {
+		return m.DisclosedHeight
+	}
+	return 0
+}
+
+func (m *Disclosure) GetPlaintext() []byte {
+	if m != nil {
+		return m.Plaintext
 	}
 	return nil
 }
 
-type TeeTrigger struct {
-	Tee                  *TeeCall `protobuf:"bytes,1,opt,name=tee,proto3" json:"tee,omitempty"`
-	ClusterId            uint64   `protobuf:"varint,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	Callids              []uint64 `protobuf:"varint,3,rep,packed,name=callids,proto3" json:"callids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Disclosure) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *TeeTrigger) Reset()         { *m = TeeTrigger{} }
-func (m *TeeTrigger) String() string { return proto.CompactTextString(m) }
-func (*TeeTrigger) ProtoMessage()    {}
-func (*TeeTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{23}
+func (m *Disclosure) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
-func (m *TeeTrigger) XXX_Unmarshal(b []byte) error {
+
+func (m *Disclosure) GetShares() []*DecryptShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// 节点提交的条件解密份额
+// Decrypt share of a node for a ready disclosure
+type DisclosureShare struct {
+	Owner                []byte        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Index                uint64        `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Epoch                uint32        `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Share                *DecryptShare `protobuf:"bytes,4,opt,name=share,proto3" json:"share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DisclosureShare) Reset()         { *m = DisclosureShare{} }
+func (m *DisclosureShare) String() string { return proto.CompactTextString(m) }
+func (*DisclosureShare) ProtoMessage()    {}
+func (*DisclosureShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{25}
+}
+func (m *DisclosureShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TeeTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DisclosureShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TeeTrigger.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DisclosureShare.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1887,61 +2435,73 @@ func (m *TeeTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TeeTrigger) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TeeTrigger.Merge(m, src)
+func (m *DisclosureShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisclosureShare.Merge(m, src)
 }
-func (m *TeeTrigger) XXX_Size() int {
+func (m *DisclosureShare) XXX_Size() int {
 	return m.Size()
 }
-func (m *TeeTrigger) XXX_DiscardUnknown() {
-	xxx_messageInfo_TeeTrigger.DiscardUnknown(m)
+func (m *DisclosureShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisclosureShare.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TeeTrigger proto.InternalMessageInfo
+var xxx_messageInfo_DisclosureShare proto.InternalMessageInfo
 
-func (m *TeeTrigger) GetTee() *TeeCall {
+func (m *DisclosureShare) GetOwner() []byte {
 	if m != nil {
-		return m.Tee
+		return m.Owner
 	}
 	return nil
 }
 
-func (m *TeeTrigger) GetClusterId() uint64 {
+func (m *DisclosureShare) GetIndex() uint64 {
 	if m != nil {
-		return m.ClusterId
+		return m.Index
 	}
 	return 0
 }
 
-func (m *TeeTrigger) GetCallids() []uint64 {
+func (m *DisclosureShare) GetEpoch() uint32 {
 	if m != nil {
-		return m.Callids
+		return m.Epoch
 	}
-	return nil
+	return 0
 }
 
-// api req
-type ApiReq struct {
-	Url                  []byte   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	From                 []byte   `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DisclosureShare) GetShare() *DecryptShare {
+	if m != nil {
+		return m.Share
+	}
+	return nil
 }
 
-func (m *ApiReq) Reset()         { *m = ApiReq{} }
-func (m *ApiReq) String() string { return proto.CompactTextString(m) }
-func (*ApiReq) ProtoMessage()    {}
-func (*ApiReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{24}
-}
-func (m *ApiReq) XXX_Unmarshal(b []byte) error {
+// 等待解密执行的加密交易
+// Encrypted tx waiting for decryption, ordered by height and position
+type EncryptedTx struct {
+	Envelope             *SecretStore    `protobuf:"bytes,1,opt,name=envelope,proto3" json:"envelope,omitempty"`
+	Height               int64           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Position             uint32          `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Epoch                uint32          `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Shares               []*DecryptShare `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+	Decrypted            bool            `protobuf:"varint,6,opt,name=decrypted,proto3" json:"decrypted,omitempty"`
+	Error                string          `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EncryptedTx) Reset()         { *m = EncryptedTx{} }
+func (m *EncryptedTx) String() string { return proto.CompactTextString(m) }
+func (*EncryptedTx) ProtoMessage()    {}
+func (*EncryptedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{26}
+}
+func (m *EncryptedTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApiReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EncryptedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApiReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EncryptedTx.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1951,60 +2511,91 @@ func (m *ApiReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ApiReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApiReq.Merge(m, src)
+func (m *EncryptedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedTx.Merge(m, src)
 }
-func (m *ApiReq) XXX_Size() int {
+func (m *EncryptedTx) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApiReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApiReq.DiscardUnknown(m)
+func (m *EncryptedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedTx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApiReq proto.InternalMessageInfo
+var xxx_messageInfo_EncryptedTx proto.InternalMessageInfo
 
-func (m *ApiReq) GetUrl() []byte {
+func (m *EncryptedTx) GetEnvelope() *SecretStore {
 	if m != nil {
-		return m.Url
+		return m.Envelope
 	}
 	return nil
 }
 
-func (m *ApiReq) GetFrom() []byte {
+func (m *EncryptedTx) GetHeight() int64 {
 	if m != nil {
-		return m.From
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-func (m *ApiReq) GetData() []byte {
+func (m *EncryptedTx) GetPosition() uint32 {
 	if m != nil {
-		return m.Data
+		return m.Position
+	}
+	return 0
+}
+
+func (m *EncryptedTx) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EncryptedTx) GetShares() []*DecryptShare {
+	if m != nil {
+		return m.Shares
 	}
 	return nil
 }
 
-// api resp
-type ApiResp struct {
-	Code                 int32    `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
-	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *EncryptedTx) GetDecrypted() bool {
+	if m != nil {
+		return m.Decrypted
+	}
+	return false
 }
 
-func (m *ApiResp) Reset()         { *m = ApiResp{} }
-func (m *ApiResp) String() string { return proto.CompactTextString(m) }
-func (*ApiResp) ProtoMessage()    {}
-func (*ApiResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fd2153dc07d3b5c, []int{25}
+func (m *EncryptedTx) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
-func (m *ApiResp) XXX_Unmarshal(b []byte) error {
+
+// 节点提交的加密交易解密份额
+// Decrypt share of a node for an encrypted tx
+type EncryptedTxShare struct {
+	Height               int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Position             uint32        `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Epoch                uint32        `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Share                *DecryptShare `protobuf:"bytes,4,opt,name=share,proto3" json:"share,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EncryptedTxShare) Reset()         { *m = EncryptedTxShare{} }
+func (m *EncryptedTxShare) String() string { return proto.CompactTextString(m) }
+func (*EncryptedTxShare) ProtoMessage()    {}
+func (*EncryptedTxShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{27}
+}
+func (m *EncryptedTxShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApiResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EncryptedTxShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApiResp.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EncryptedTxShare.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2014,2870 +2605,19118 @@ func (m *ApiResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ApiResp) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApiResp.Merge(m, src)
+func (m *EncryptedTxShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedTxShare.Merge(m, src)
 }
-func (m *ApiResp) XXX_Size() int {
+func (m *EncryptedTxShare) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApiResp) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApiResp.DiscardUnknown(m)
+func (m *EncryptedTxShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedTxShare.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApiResp proto.InternalMessageInfo
+var xxx_messageInfo_EncryptedTxShare proto.InternalMessageInfo
 
-func (m *ApiResp) GetCode() int32 {
+func (m *EncryptedTxShare) GetHeight() int64 {
 	if m != nil {
-		return m.Code
+		return m.Height
 	}
 	return 0
 }
 
-func (m *ApiResp) GetData() []byte {
+func (m *EncryptedTxShare) GetPosition() uint32 {
 	if m != nil {
-		return m.Data
+		return m.Position
 	}
-	return nil
-}
-
-func init() {
-	proto.RegisterType((*TxBox)(nil), "model.TxBox")
-	proto.RegisterType((*Tx)(nil), "model.Tx")
-	proto.RegisterType((*SideValidator)(nil), "model.SideValidator")
-	proto.RegisterType((*EpochEnd)(nil), "model.EpochEnd")
-	proto.RegisterType((*Bridge)(nil), "model.Bridge")
-	proto.RegisterType((*HubCall)(nil), "model.HubCall")
-	proto.RegisterType((*DkgMessage)(nil), "model.DkgMessage")
-	proto.RegisterType((*BlockPartialSign)(nil), "model.BlockPartialSign")
-	proto.RegisterType((*To)(nil), "model.To")
-	proto.RegisterType((*Nodes)(nil), "model.Nodes")
-	proto.RegisterType((*TeeCall)(nil), "model.TeeCall")
-	proto.RegisterType((*PodStart)(nil), "model.PodStart")
-	proto.RegisterType((*PodMint)(nil), "model.PodMint")
-	proto.RegisterType((*BridgeCall)(nil), "model.BridgeCall")
-	proto.RegisterType((*TeeVerifyResult)(nil), "model.TeeVerifyResult")
-	proto.RegisterType((*UploadSecret)(nil), "model.UploadSecret")
-	proto.RegisterType((*InitDisk)(nil), "model.InitDisk")
-	proto.RegisterType((*SecretBox)(nil), "model.SecretBox")
-	proto.RegisterType((*SecretStore)(nil), "model.SecretStore")
-	proto.RegisterType((*DecryptShare)(nil), "model.DecryptShare")
-	proto.RegisterType((*DecryptSharesResp)(nil), "model.DecryptSharesResp")
-	proto.RegisterMapType((map[uint64]*DecryptShare)(nil), "model.DecryptSharesResp.DiskSharesEntry")
-	proto.RegisterMapType((map[uint64]*DecryptShare)(nil), "model.DecryptSharesResp.SecretSharesEntry")
-	proto.RegisterType((*DecryptResp)(nil), "model.DecryptResp")
-	proto.RegisterMapType((map[uint64]*Secret)(nil), "model.DecryptResp.DiskKeysEntry")
-	proto.RegisterMapType((map[uint64]*Secret)(nil), "model.DecryptResp.SecretsEntry")
-	proto.RegisterType((*Secret)(nil), "model.Secret")
-	proto.RegisterType((*TeeTrigger)(nil), "model.TeeTrigger")
-	proto.RegisterType((*ApiReq)(nil), "model.ApiReq")
-	proto.RegisterType((*ApiResp)(nil), "model.ApiResp")
+	return 0
 }
 
-func init() { proto.RegisterFile("tx.proto", fileDescriptor_0fd2153dc07d3b5c) }
-
-var fileDescriptor_0fd2153dc07d3b5c = []byte{
-	// 1555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x8e, 0xdb, 0x36,
-	0x10, 0x5e, 0xd9, 0xf2, 0xdf, 0xd8, 0xde, 0x4d, 0x98, 0x9f, 0x7a, 0x53, 0x74, 0xe3, 0x28, 0x69,
-	0xb1, 0x6d, 0x81, 0x05, 0x9a, 0xe6, 0xd0, 0xa4, 0xcd, 0xa1, 0x4e, 0x16, 0xb0, 0x1b, 0xa4, 0x59,
-	0xd0, 0x6e, 0x0e, 0xbd, 0x08, 0xb2, 0xc8, 0xc8, 0x82, 0x65, 0x49, 0xa1, 0xa8, 0xc4, 0x3e, 0xf7,
-	0x05, 0x8a, 0x1e, 0x0a, 0x14, 0xe8, 0x53, 0xf4, 0xd2, 0x57, 0xe8, 0xb1, 0x7d, 0x83, 0x22, 0x4f,
-	0x52, 0x0c, 0x49, 0xc9, 0x72, 0xb2, 0x39, 0x04, 0x01, 0x7a, 0xe3, 0x8c, 0x86, 0xc3, 0x99, 0x6f,
-	0xbe, 0x19, 0x52, 0xd0, 0x96, 0xeb, 0x93, 0x54, 0x24, 0x32, 0x21, 0x8d, 0x55, 0xc2, 0x78, 0xe4,
-	0x8c, 0xa0, 0x31, 0x5b, 0x8f, 0x92, 0x35, 0xf9, 0x00, 0x5a, 0x92, 0x73, 0x37, 0x0b, 0x83, 0x81,
-	0x35, 0xb4, 0x8e, 0x7b, 0xb4, 0x29, 0x39, 0x9f, 0x86, 0x01, 0xb9, 0x00, 0xf5, 0x44, 0x04, 0x83,
-	0x9a, 0x52, 0xe2, 0x92, 0xec, 0x43, 0x4d, 0xae, 0x07, 0x75, 0xa5, 0xa8, 0xc9, 0xb5, 0xf3, 0x47,
-	0x0d, 0x6a, 0xb3, 0x35, 0xb9, 0x0a, 0x0d, 0xbe, 0x4a, 0xe5, 0x66, 0xe0, 0x0f, 0xad, 0xe3, 0xfa,
-	0x78, 0x8f, 0x6a, 0x91, 0x9c, 0x40, 0x87, 0xa7, 0x89, 0xbf, 0x70, 0x79, 0xcc, 0x94, 0xef, 0xee,
-	0xed, 0x83, 0x13, 0x75, 0xfa, 0xc9, 0x29, 0xea, 0x4f, 0x63, 0x36, 0xde, 0xa3, 0x6d, 0x6e, 0xd6,
-	0xe4, 0x06, 0x74, 0xb5, 0x7d, 0x26, 0x3d, 0x21, 0xd5, 0xc1, 0xe8, 0x0d, 0x94, 0x72, 0x8a, 0x3a,
-	0xf2, 0x39, 0xb4, 0x17, 0xf9, 0xdc, 0xf5, 0xbd, 0x28, 0x1a, 0xd8, 0xca, 0xe3, 0xbe, 0xf1, 0x38,
-	0xce, 0xe7, 0x0f, 0xbc, 0x28, 0x1a, 0xef, 0xd1, 0xd6, 0x42, 0x2f, 0xc9, 0x2d, 0xe8, 0x67, 0x9b,
-	0xd8, 0x77, 0xe5, 0xda, 0x78, 0x6c, 0x18, 0x8f, 0x5d, 0x54, 0xcf, 0xd6, 0xda, 0xe5, 0x10, 0xba,
-	0x85, 0x15, 0xc6, 0xd9, 0x34, 0x36, 0x1d, 0x6d, 0x83, 0x71, 0x55, 0xfc, 0x08, 0x2e, 0xc5, 0x66,
-	0xd0, 0xda, 0xf5, 0x43, 0x51, 0x49, 0x3e, 0x84, 0x36, 0xf3, 0x12, 0x1d, 0x5a, 0x1b, 0x21, 0xc2,
-	0x50, 0x98, 0x97, 0x60, 0x28, 0xa3, 0x0e, 0xb4, 0x52, 0x6f, 0x13, 0x25, 0x1e, 0x73, 0xee, 0x43,
-	0x7f, 0x1a, 0x32, 0xfe, 0xd4, 0x8b, 0x42, 0xe6, 0xc9, 0x44, 0x90, 0xab, 0xd0, 0x4c, 0xf3, 0xf9,
-	0x92, 0x6f, 0x0a, 0xfc, 0xb5, 0x44, 0x2e, 0x43, 0x23, 0x4d, 0x5e, 0x72, 0xa1, 0x81, 0xa0, 0x5a,
-	0x70, 0x7e, 0xb6, 0xa0, 0x5d, 0xa0, 0x87, 0x26, 0x0a, 0x1c, 0xb5, 0xb3, 0x4f, 0xb5, 0x40, 0xee,
-	0x00, 0xbc, 0x28, 0xbc, 0x67, 0x83, 0xda, 0xb0, 0x7e, 0xdc, 0xbd, 0x7d, 0xd9, 0xc0, 0xb4, 0x73,
-	0x34, 0xad, 0xd8, 0x21, 0x0f, 0xd8, 0x32, 0x70, 0xd3, 0x7c, 0x6e, 0x2a, 0xdc, 0x64, 0xcb, 0xe0,
-	0x2c, 0x9f, 0x93, 0xeb, 0xd0, 0xc5, 0x0f, 0x7e, 0xb2, 0x5a, 0x85, 0x32, 0x53, 0xb0, 0xf7, 0x28,
-	0xb0, 0x65, 0xf0, 0x40, 0x6b, 0x9c, 0xbb, 0xd0, 0x1c, 0x89, 0x90, 0x05, 0x9c, 0x5c, 0x81, 0xe6,
-	0x2a, 0x0b, 0xdc, 0x50, 0x97, 0xbb, 0x43, 0x1b, 0xab, 0x2c, 0x98, 0x30, 0x32, 0x28, 0xb3, 0x37,
-	0x6c, 0x2a, 0xc1, 0x18, 0x43, 0xcb, 0x14, 0x8e, 0x1c, 0x42, 0xdb, 0x5f, 0x78, 0x61, 0x5c, 0xec,
-	0xee, 0xd3, 0x96, 0x92, 0x27, 0x8c, 0x38, 0x60, 0x2b, 0x58, 0x75, 0x2a, 0x45, 0xc5, 0x67, 0x9c,
-	0xe3, 0x46, 0xaa, 0xbe, 0x39, 0xbf, 0x5a, 0x00, 0x0f, 0x97, 0xc1, 0x63, 0x9e, 0x65, 0x5e, 0xc0,
-	0x09, 0x01, 0xfb, 0x99, 0x48, 0x56, 0x26, 0x0e, 0xb5, 0x26, 0x87, 0x50, 0x93, 0x89, 0x8a, 0xa0,
-	0x7b, 0xbb, 0x53, 0x38, 0x49, 0x68, 0x4d, 0x26, 0x95, 0xc0, 0xeb, 0x6f, 0x09, 0xdc, 0xde, 0x09,
-	0x5c, 0x21, 0x2f, 0x44, 0x22, 0x14, 0xa7, 0x3a, 0x54, 0x0b, 0x78, 0xaa, 0xdc, 0xa4, 0x5c, 0x91,
-	0xa8, 0x43, 0xd5, 0xda, 0xc9, 0xe1, 0xc2, 0x28, 0x4a, 0xfc, 0xe5, 0x99, 0x27, 0x64, 0xe8, 0x45,
-	0xd3, 0x30, 0x88, 0xdf, 0x35, 0xba, 0x43, 0x6c, 0x5f, 0x37, 0x8c, 0x19, 0xd7, 0xdd, 0x57, 0xa7,
-	0x2d, 0xb9, 0x9e, 0xa0, 0x88, 0x55, 0xc3, 0x86, 0xc0, 0xee, 0xd5, 0x11, 0x36, 0x17, 0xf9, 0x7c,
-	0x1a, 0x06, 0xce, 0x12, 0x6a, 0xb3, 0x84, 0x1c, 0x41, 0x67, 0x2e, 0x12, 0x8f, 0xf9, 0x5e, 0x26,
-	0xd5, 0x69, 0x6d, 0xa4, 0x76, 0xa9, 0x22, 0xb7, 0xa0, 0x11, 0x27, 0x8c, 0x67, 0xe6, 0xdc, 0x9e,
-	0x39, 0xf7, 0x7b, 0xd4, 0x61, 0x23, 0xab, 0x8f, 0xe4, 0x32, 0xd8, 0xb8, 0xd0, 0xbc, 0x18, 0xef,
-	0x51, 0x25, 0x55, 0x39, 0x7d, 0x05, 0x1a, 0x6a, 0x0b, 0xe9, 0x81, 0xa5, 0xcb, 0xd4, 0xa3, 0x56,
-	0xe4, 0xfc, 0x54, 0x87, 0x96, 0xa9, 0x12, 0xb2, 0x1c, 0xeb, 0xc4, 0x45, 0xc1, 0x72, 0x2d, 0x29,
-	0xc8, 0xc2, 0x15, 0x37, 0x24, 0x57, 0x6b, 0x95, 0x2f, 0xe7, 0xae, 0x82, 0xb2, 0xae, 0xa9, 0x20,
-	0x39, 0x9f, 0x6d, 0x52, 0x8e, 0x6e, 0x04, 0x4f, 0x13, 0x21, 0x8b, 0x74, 0xb5, 0x84, 0xb3, 0x26,
-	0x4d, 0x58, 0xa5, 0xcf, 0xb7, 0xb3, 0xe6, 0x2c, 0x61, 0xaa, 0xd3, 0x71, 0xd6, 0xa4, 0x66, 0x8d,
-	0x83, 0x04, 0xed, 0x57, 0x61, 0x2c, 0x55, 0xb5, 0xb6, 0xb4, 0x3a, 0x4b, 0xd8, 0xe3, 0x30, 0x46,
-	0xeb, 0x56, 0xaa, 0x97, 0xe4, 0x0e, 0x74, 0xe7, 0x8a, 0xe0, 0xba, 0xbb, 0x5b, 0xca, 0xfe, 0xa2,
-	0xb1, 0xd7, 0xd4, 0x37, 0xb3, 0x07, 0xe6, 0xa5, 0x84, 0xa8, 0x49, 0xbe, 0x96, 0xe5, 0x30, 0x50,
-	0x12, 0xb9, 0x07, 0xfd, 0x3c, 0x45, 0xd0, 0xdc, 0x8c, 0xfb, 0x82, 0xcb, 0x41, 0x47, 0x79, 0xbb,
-	0x64, 0xbc, 0xfd, 0xa0, 0xbe, 0x4d, 0xd5, 0xa7, 0xf1, 0x1e, 0xed, 0xe5, 0x15, 0x19, 0x93, 0x0c,
-	0xe3, 0x50, 0xba, 0x2c, 0xcc, 0x96, 0x03, 0xd8, 0x49, 0x72, 0x12, 0x87, 0xf2, 0x61, 0x98, 0x2d,
-	0x31, 0xc9, 0xd0, 0xac, 0x47, 0x36, 0xce, 0x6b, 0xe7, 0x37, 0x0b, 0xda, 0x05, 0x06, 0x38, 0xc2,
-	0x4d, 0x7f, 0xd9, 0xb4, 0x16, 0x32, 0x24, 0xbe, 0x97, 0xa6, 0x48, 0x7c, 0xdd, 0x99, 0x0d, 0x2f,
-	0x4d, 0x27, 0x8c, 0x7c, 0x04, 0x10, 0x7b, 0x2b, 0xee, 0x66, 0xa9, 0xe7, 0x9b, 0xba, 0xd3, 0x0e,
-	0x6a, 0xa6, 0xa8, 0x40, 0xd6, 0xa5, 0xf9, 0xdc, 0xc5, 0x99, 0x65, 0x97, 0x33, 0xeb, 0x11, 0xdf,
-	0x60, 0xc3, 0xe8, 0xb4, 0xb2, 0x41, 0x63, 0x58, 0x3f, 0xb6, 0x69, 0x21, 0x62, 0xc3, 0x60, 0xd8,
-	0xd9, 0xa0, 0xa9, 0xf4, 0x5a, 0x70, 0xee, 0x42, 0xcb, 0xe0, 0x8d, 0x91, 0x4d, 0xca, 0xc8, 0x26,
-	0x8c, 0x1c, 0x01, 0xe8, 0xda, 0x8e, 0xbd, 0x6c, 0x61, 0x42, 0xa8, 0x68, 0x9c, 0x21, 0xc0, 0x16,
-	0xfa, 0x92, 0x46, 0xd6, 0x96, 0x46, 0xce, 0xef, 0x16, 0x1c, 0xcc, 0x38, 0x7f, 0xca, 0x45, 0xf8,
-	0x6c, 0x43, 0x79, 0x96, 0x47, 0x72, 0x87, 0x5a, 0xd6, 0x2e, 0xb5, 0xae, 0x43, 0xd7, 0x4f, 0x98,
-	0xba, 0x09, 0x63, 0x33, 0x75, 0x7b, 0x14, 0x50, 0x35, 0x55, 0x1a, 0xf2, 0x31, 0xec, 0x97, 0x06,
-	0x9e, 0xcc, 0x45, 0x01, 0x4c, 0xbf, 0xb0, 0x51, 0x4a, 0xf2, 0x09, 0x1c, 0x28, 0xb3, 0x54, 0x24,
-	0x2c, 0xf7, 0x25, 0x62, 0x6b, 0x6f, 0xed, 0xce, 0xb4, 0x76, 0xc2, 0x1c, 0x09, 0xbd, 0x6a, 0xb5,
-	0x31, 0x85, 0x3c, 0x2b, 0xfb, 0x43, 0xad, 0x11, 0x35, 0xdd, 0xf6, 0x35, 0x85, 0x8b, 0x16, 0xd0,
-	0x92, 0x79, 0xd2, 0x33, 0xc7, 0xab, 0x75, 0x09, 0x80, 0xad, 0x0c, 0x75, 0x1f, 0x11, 0xb0, 0x17,
-	0x08, 0x5e, 0x43, 0xdb, 0xe1, 0xda, 0x49, 0xa1, 0x5d, 0x70, 0xe5, 0x7f, 0x3a, 0xf1, 0x1f, 0x0b,
-	0x3a, 0x3a, 0x45, 0x7c, 0x6e, 0xbc, 0xe3, 0xe8, 0xbb, 0x09, 0x75, 0xc1, 0x9f, 0x9b, 0xbb, 0xfe,
-	0x9c, 0x8e, 0xc6, 0xaf, 0xe4, 0x6b, 0xe8, 0x66, 0x0b, 0x4f, 0xf0, 0xcc, 0x15, 0x3c, 0x4b, 0x4d,
-	0xfb, 0x0f, 0x8c, 0xf1, 0x43, 0xee, 0x8b, 0x4d, 0x2a, 0xa7, 0xca, 0x80, 0xf2, 0x2c, 0xc5, 0x36,
-	0xcd, 0x4a, 0x89, 0x1c, 0x83, 0xad, 0x76, 0xe9, 0x29, 0x40, 0x76, 0x77, 0x19, 0x7b, 0x65, 0x51,
-	0x1d, 0x78, 0x4f, 0xa0, 0xab, 0x53, 0x9a, 0xca, 0x44, 0x70, 0x72, 0x04, 0x5d, 0xe1, 0xbd, 0x74,
-	0x79, 0xec, 0xbb, 0xfe, 0x4a, 0x1a, 0x3c, 0x3b, 0xc2, 0x7b, 0x79, 0x1a, 0xfb, 0x0f, 0x56, 0xf8,
-	0xc6, 0xe8, 0x15, 0xdf, 0x33, 0x5f, 0x3d, 0x6d, 0xea, 0x8a, 0xcd, 0xca, 0x60, 0xea, 0x0b, 0xe9,
-	0xbc, 0x80, 0x5e, 0x35, 0x50, 0x24, 0xa3, 0x8a, 0xd1, 0xdd, 0x16, 0xa3, 0x61, 0xc2, 0x2e, 0x07,
-	0xff, 0x1a, 0xdd, 0x2d, 0xc3, 0xe2, 0xba, 0x5e, 0xc7, 0xfe, 0x74, 0x19, 0x62, 0x01, 0xfd, 0x45,
-	0x14, 0x84, 0x86, 0x74, 0x5a, 0x50, 0x8f, 0x0c, 0x91, 0x24, 0xcf, 0x42, 0x53, 0x1a, 0x23, 0x39,
-	0xbf, 0xd4, 0xe1, 0xe2, 0x1b, 0x08, 0x91, 0x1b, 0x1a, 0xf5, 0xda, 0xb9, 0xa8, 0x6b, 0xcc, 0x9f,
-	0x40, 0x5f, 0xb7, 0xb6, 0xab, 0xb1, 0x1c, 0xd4, 0xd5, 0xe5, 0xfc, 0xd9, 0xdb, 0x50, 0x3f, 0x31,
-	0x78, 0x29, 0xc5, 0x69, 0x2c, 0xc5, 0x86, 0xf6, 0xb2, 0x8a, 0x8a, 0x4c, 0xa0, 0x8b, 0x33, 0xa1,
-	0x70, 0x67, 0x2b, 0x77, 0xc7, 0x6f, 0x75, 0x87, 0x04, 0xae, 0x3a, 0x03, 0x56, 0x2a, 0x76, 0x2f,
-	0xe7, 0x9e, 0xb9, 0x9c, 0xaf, 0xcd, 0xe0, 0xe2, 0x1b, 0x31, 0xe0, 0x23, 0xb7, 0x78, 0x79, 0xd9,
-	0x14, 0x97, 0xe4, 0x53, 0x68, 0xbc, 0xf0, 0xa2, 0x9c, 0x9b, 0xec, 0x2f, 0x9d, 0x13, 0x01, 0xd5,
-	0x16, 0xf7, 0x6a, 0x5f, 0x59, 0xd7, 0x28, 0x1c, 0xbc, 0x16, 0xca, 0x7b, 0xfb, 0x74, 0xfe, 0xac,
-	0x41, 0xb7, 0x42, 0xc0, 0xe2, 0x69, 0x56, 0x79, 0x22, 0xb2, 0x65, 0x80, 0xe3, 0xf6, 0xee, 0x76,
-	0xdc, 0x6a, 0xf8, 0xaf, 0xbf, 0x49, 0x5f, 0x03, 0xbc, 0x81, 0xa9, 0x9c, 0xc7, 0xf7, 0xa1, 0xa3,
-	0xe0, 0x5e, 0xf2, 0x4d, 0x01, 0xf6, 0xf0, 0x9c, 0xcd, 0x98, 0xdb, 0x23, 0xbe, 0x31, 0xbb, 0xdb,
-	0xcc, 0x88, 0xd7, 0x26, 0xd0, 0xab, 0xfa, 0x3d, 0x27, 0xe7, 0x9b, 0xbb, 0x39, 0xf7, 0x8b, 0x07,
-	0xa8, 0xda, 0x55, 0x45, 0xf0, 0x3b, 0xe8, 0xef, 0x9c, 0xf2, 0x1e, 0xbe, 0x9c, 0x6f, 0xa0, 0x69,
-	0xa6, 0xa9, 0xe9, 0x8f, 0x6d, 0x3b, 0x62, 0x7f, 0x60, 0x2f, 0x1e, 0x42, 0xfb, 0xb5, 0x3e, 0x6c,
-	0x71, 0xd3, 0x84, 0x01, 0xc0, 0x8c, 0xf3, 0x99, 0x08, 0x83, 0x80, 0x0b, 0x32, 0x84, 0xba, 0xe4,
-	0xdc, 0xfc, 0xb8, 0xbc, 0xfe, 0xe8, 0xc4, 0x4f, 0x78, 0x4b, 0xfa, 0x51, 0x9e, 0x49, 0x2e, 0x8a,
-	0x0b, 0xd4, 0xa6, 0x1d, 0xa3, 0xd1, 0xaf, 0x47, 0x7c, 0x2f, 0x84, 0x4c, 0x57, 0xc7, 0xa6, 0x85,
-	0xe8, 0x8c, 0xa0, 0xf9, 0x6d, 0x1a, 0x52, 0xfe, 0x1c, 0x73, 0xcd, 0x45, 0x54, 0xfc, 0x64, 0xe5,
-	0x22, 0x2a, 0x07, 0xa4, 0x19, 0xb5, 0x6a, 0x40, 0x16, 0xe3, 0xd7, 0xde, 0x8e, 0x5f, 0xe7, 0x0b,
-	0x68, 0x29, 0x1f, 0x59, 0x8a, 0x9f, 0xf1, 0x6a, 0x31, 0x53, 0x42, 0xad, 0xcf, 0x9b, 0xd8, 0xa3,
-	0xfd, 0xbf, 0x5e, 0x1d, 0x59, 0x7f, 0xbf, 0x3a, 0xb2, 0xfe, 0x7d, 0x75, 0x64, 0xfd, 0xb8, 0x37,
-	0x6f, 0xaa, 0x3f, 0xc2, 0x2f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x57, 0x47, 0xf6, 0xe6, 0x1d,
-	0x0e, 0x00, 0x00,
+func (m *EncryptedTxShare) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-func (m *TxBox) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EncryptedTxShare) GetShare() *DecryptShare {
+	if m != nil {
+		return m.Share
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TxBox) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 门限策略：恢复密钥或签名需要超过 n*num/den 个份额，
+// 开始 DKG 共识需要超过 n*quorum_num/quorum_den 个新验证节点在线
+// Threshold policy shared by DKG, DSS, re-encryption recovery and hub sync
+type ThresholdPolicy struct {
+	Num                  uint32   `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+	Den                  uint32   `protobuf:"varint,2,opt,name=den,proto3" json:"den,omitempty"`
+	QuorumNum            uint32   `protobuf:"varint,3,opt,name=quorum_num,json=quorumNum,proto3" json:"quorum_num,omitempty"`
+	QuorumDen            uint32   `protobuf:"varint,4,opt,name=quorum_den,json=quorumDen,proto3" json:"quorum_den,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TxBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Tx) > 0 {
-		i -= len(m.Tx)
-		copy(dAtA[i:], m.Tx)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tx)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Org) > 0 {
-		i -= len(m.Org)
-		copy(dAtA[i:], m.Org)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Org)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TeeSig) > 0 {
-		i -= len(m.TeeSig)
-		copy(dAtA[i:], m.TeeSig)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TeeSig)))
-		i--
-		dAtA[i] = 0xa
+func (m *ThresholdPolicy) Reset()         { *m = ThresholdPolicy{} }
+func (m *ThresholdPolicy) String() string { return proto.CompactTextString(m) }
+func (*ThresholdPolicy) ProtoMessage()    {}
+func (*ThresholdPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{28}
+}
+func (m *ThresholdPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ThresholdPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdPolicy.Merge(m, src)
+}
+func (m *ThresholdPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdPolicy.DiscardUnknown(m)
 }
 
-func (m *Tx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ThresholdPolicy proto.InternalMessageInfo
+
+func (m *ThresholdPolicy) GetNum() uint32 {
+	if m != nil {
+		return m.Num
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Tx) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ThresholdPolicy) GetDen() uint32 {
+	if m != nil {
+		return m.Den
+	}
+	return 0
 }
 
-func (m *Tx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0x52
+func (m *ThresholdPolicy) GetQuorumNum() uint32 {
+	if m != nil {
+		return m.QuorumNum
 	}
-	if m.Payload != nil {
-		{
-			size := m.Payload.Size()
-			i -= size
-			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	return 0
+}
+
+func (m *ThresholdPolicy) GetQuorumDen() uint32 {
+	if m != nil {
+		return m.QuorumDen
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Tx_EpochEnd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 过期 secret 的清理配置，由治理设置，所有验证节点使用相同的配置
+// Sweep config of expired secrets set by governance
+type SecretSweepConfig struct {
+	Interval             int64    `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	DelayBlocks          uint64   `protobuf:"varint,2,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+	DelaySeconds         uint64   `protobuf:"varint,3,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Tx_EpochEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.EpochEnd != nil {
-		{
-			size, err := m.EpochEnd.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *SecretSweepConfig) Reset()         { *m = SecretSweepConfig{} }
+func (m *SecretSweepConfig) String() string { return proto.CompactTextString(m) }
+func (*SecretSweepConfig) ProtoMessage()    {}
+func (*SecretSweepConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{29}
+}
+func (m *SecretSweepConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretSweepConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretSweepConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Tx_EpochStart) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SecretSweepConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretSweepConfig.Merge(m, src)
 }
-
-func (m *Tx_EpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintTx(dAtA, i, uint64(m.EpochStart))
-	i--
-	dAtA[i] = 0x10
-	return len(dAtA) - i, nil
+func (m *SecretSweepConfig) XXX_Size() int {
+	return m.Size()
 }
-func (m *Tx_HubCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SecretSweepConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretSweepConfig.DiscardUnknown(m)
 }
 
-func (m *Tx_HubCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.HubCall != nil {
-		{
-			size, err := m.HubCall.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_SecretSweepConfig proto.InternalMessageInfo
+
+func (m *SecretSweepConfig) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Tx_SyncTxStart) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *Tx_SyncTxStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintTx(dAtA, i, uint64(m.SyncTxStart))
-	i--
-	dAtA[i] = 0x28
-	return len(dAtA) - i, nil
-}
-func (m *Tx_SyncTxEnd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SecretSweepConfig) GetDelayBlocks() uint64 {
+	if m != nil {
+		return m.DelayBlocks
+	}
+	return 0
 }
 
-func (m *Tx_SyncTxEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintTx(dAtA, i, uint64(m.SyncTxEnd))
-	i--
-	dAtA[i] = 0x30
-	return len(dAtA) - i, nil
+func (m *SecretSweepConfig) GetDelaySeconds() uint64 {
+	if m != nil {
+		return m.DelaySeconds
+	}
+	return 0
 }
-func (m *Tx_SyncTxRetry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// 节点提交的随机数信标份额
+// Beacon share of a node: value = s_i·M with a DLEQ proof against the DKG commits
+type BeaconShare struct {
+	Round                uint64   `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Epoch                uint32   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Index                uint32   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Value                []byte   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Proof                []byte   `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Tx_SyncTxRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintTx(dAtA, i, uint64(m.SyncTxRetry))
-	i--
-	dAtA[i] = 0x38
-	return len(dAtA) - i, nil
+func (m *BeaconShare) Reset()         { *m = BeaconShare{} }
+func (m *BeaconShare) String() string { return proto.CompactTextString(m) }
+func (*BeaconShare) ProtoMessage()    {}
+func (*BeaconShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{30}
 }
-func (m *Tx_DaoCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BeaconShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Tx_DaoCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DaoCall != nil {
-		i -= len(m.DaoCall)
-		copy(dAtA[i:], m.DaoCall)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DaoCall)))
-		i--
-		dAtA[i] = 0x42
+func (m *BeaconShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Tx_Empty) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BeaconShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconShare.Merge(m, src)
+}
+func (m *BeaconShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconShare.DiscardUnknown(m)
 }
 
-func (m *Tx_Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintTx(dAtA, i, uint64(m.Empty))
-	i--
-	dAtA[i] = 0x6
-	i--
-	dAtA[i] = 0x98
-	return len(dAtA) - i, nil
+var xxx_messageInfo_BeaconShare proto.InternalMessageInfo
+
+func (m *BeaconShare) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
 }
-func (m *SideValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *BeaconShare) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SideValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BeaconShare) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *SideValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *BeaconShare) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	if m.Power != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *BeaconShare) GetProof() []byte {
+	if m != nil {
+		return m.Proof
 	}
-	if len(m.Pubkey) > 0 {
-		i -= len(m.Pubkey)
-		copy(dAtA[i:], m.Pubkey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Pubkey)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+// 正在收集份额的信标轮次
+// Beacon round waiting for shares
+type BeaconRound struct {
+	Round                uint64         `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Height               int64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Prev                 []byte         `protobuf:"bytes,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	Epoch                uint32         `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Shares               []*BeaconShare `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BeaconRound) Reset()         { *m = BeaconRound{} }
+func (m *BeaconRound) String() string { return proto.CompactTextString(m) }
+func (*BeaconRound) ProtoMessage()    {}
+func (*BeaconRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{31}
+}
+func (m *BeaconRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BeaconRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconRound.Merge(m, src)
+}
+func (m *BeaconRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconRound.DiscardUnknown(m)
 }
 
-func (m *EpochEnd) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BeaconRound proto.InternalMessageInfo
+
+func (m *BeaconRound) GetRound() uint64 {
+	if m != nil {
+		return m.Round
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EpochEnd) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BeaconRound) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *EpochEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *BeaconRound) GetPrev() []byte {
+	if m != nil {
+		return m.Prev
 	}
-	if len(m.DkgCommits) > 0 {
-		i -= len(m.DkgCommits)
-		copy(dAtA[i:], m.DkgCommits)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgCommits)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *BeaconRound) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
 	}
-	if len(m.DkgPub) > 0 {
-		i -= len(m.DkgPub)
-		copy(dAtA[i:], m.DkgPub)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgPub)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *BeaconRound) GetShares() []*BeaconShare {
+	if m != nil {
+		return m.Shares
 	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return nil
+}
+
+// 已完成的随机数信标
+// Finalized beacon output
+type Beacon struct {
+	Round                uint64   `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Finalized            int64    `protobuf:"varint,3,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	Prev                 []byte   `protobuf:"bytes,4,opt,name=prev,proto3" json:"prev,omitempty"`
+	Value                []byte   `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Epoch                uint32   `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Beacon) Reset()         { *m = Beacon{} }
+func (m *Beacon) String() string { return proto.CompactTextString(m) }
+func (*Beacon) ProtoMessage()    {}
+func (*Beacon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{32}
+}
+func (m *Beacon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Beacon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Beacon.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Epoch != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *Beacon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Beacon.Merge(m, src)
+}
+func (m *Beacon) XXX_Size() int {
+	return m.Size()
+}
+func (m *Beacon) XXX_DiscardUnknown() {
+	xxx_messageInfo_Beacon.DiscardUnknown(m)
 }
 
-func (m *Bridge) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Beacon proto.InternalMessageInfo
+
+func (m *Beacon) GetRound() uint64 {
+	if m != nil {
+		return m.Round
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Bridge) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Beacon) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *Bridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Beacon) GetFinalized() int64 {
+	if m != nil {
+		return m.Finalized
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Beacon) GetPrev() []byte {
+	if m != nil {
+		return m.Prev
 	}
-	if len(m.MsgId) > 0 {
-		i -= len(m.MsgId)
-		copy(dAtA[i:], m.MsgId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Beacon) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *HubCall) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Beacon) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *HubCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 同一 epoch 内的主动份额刷新，验证节点不变，DKG 公钥不变
+// Proactive share refresh within an epoch
+type ShareRefresh struct {
+	Epoch                uint32      `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Refresh              uint64      `protobuf:"varint,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	Commits              []byte      `protobuf:"bytes,3,opt,name=commits,proto3" json:"commits,omitempty"`
+	Height               int64       `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Finalized            int64       `protobuf:"varint,5,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	Attempt              uint32      `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Acks                 []*RoundAck `protobuf:"bytes,7,rep,name=acks,proto3" json:"acks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ShareRefresh) Reset()         { *m = ShareRefresh{} }
+func (m *ShareRefresh) String() string { return proto.CompactTextString(m) }
+func (*ShareRefresh) ProtoMessage()    {}
+func (*ShareRefresh) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{33}
+}
+func (m *ShareRefresh) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareRefresh) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareRefresh.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ShareRefresh) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareRefresh.Merge(m, src)
+}
+func (m *ShareRefresh) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareRefresh) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareRefresh.DiscardUnknown(m)
 }
 
-func (m *HubCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_ShareRefresh proto.InternalMessageInfo
+
+func (m *ShareRefresh) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
 	}
-	if len(m.Call) > 0 {
-		for iNdEx := len(m.Call) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Call[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return 0
+}
+
+func (m *ShareRefresh) GetRefresh() uint64 {
+	if m != nil {
+		return m.Refresh
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *ShareRefresh) GetCommits() []byte {
+	if m != nil {
+		return m.Commits
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DkgMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ShareRefresh) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DkgMessage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ShareRefresh) GetFinalized() int64 {
+	if m != nil {
+		return m.Finalized
+	}
+	return 0
 }
 
-func (m *DkgMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MsgId) > 0 {
-		i -= len(m.MsgId)
-		copy(dAtA[i:], m.MsgId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+func (m *ShareRefresh) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BlockPartialSign) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ShareRefresh) GetAcks() []*RoundAck {
+	if m != nil {
+		return m.Acks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BlockPartialSign) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 验证节点完成份额刷新或密钥组 DKG 后的签名确认
+// Signed ack of a validator that finished a share refresh or key group round
+type RoundAck struct {
+	Validator            []byte   `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BlockPartialSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.HubSig) > 0 {
-		i -= len(m.HubSig)
-		copy(dAtA[i:], m.HubSig)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HubSig)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TxIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *RoundAck) Reset()         { *m = RoundAck{} }
+func (m *RoundAck) String() string { return proto.CompactTextString(m) }
+func (*RoundAck) ProtoMessage()    {}
+func (*RoundAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{34}
+}
+func (m *RoundAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoundAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RoundAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundAck.Merge(m, src)
+}
+func (m *RoundAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoundAck proto.InternalMessageInfo
+
+func (m *RoundAck) GetValidator() []byte {
+	if m != nil {
+		return m.Validator
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *To) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RoundAck) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *To) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 独立的密钥组，使用部分验证节点运行单独的 DKG，有自己的门限和 epoch
+// Named key group running its own DKG over a validator subset
+type KeyGroup struct {
+	Id                   string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Members              [][]byte         `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Policy               *ThresholdPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	RegionId             uint32           `protobuf:"varint,4,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	Epoch                uint32           `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	DkgPub               []byte           `protobuf:"bytes,6,opt,name=dkg_pub,json=dkgPub,proto3" json:"dkg_pub,omitempty"`
+	DkgCommits           []byte           `protobuf:"bytes,7,opt,name=dkg_commits,json=dkgCommits,proto3" json:"dkg_commits,omitempty"`
+	Pending              uint32           `protobuf:"varint,8,opt,name=pending,proto3" json:"pending,omitempty"`
+	Attempt              uint32           `protobuf:"varint,9,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *To) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Payload != nil {
-		{
-			size := m.Payload.Size()
-			i -= size
-			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *KeyGroup) Reset()         { *m = KeyGroup{} }
+func (m *KeyGroup) String() string { return proto.CompactTextString(m) }
+func (*KeyGroup) ProtoMessage()    {}
+func (*KeyGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{35}
+}
+func (m *KeyGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *To_Broadcast) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeyGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyGroup.Merge(m, src)
+}
+func (m *KeyGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyGroup.DiscardUnknown(m)
 }
 
-func (m *To_Broadcast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i--
-	if m.Broadcast {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+var xxx_messageInfo_KeyGroup proto.InternalMessageInfo
+
+func (m *KeyGroup) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	i--
-	dAtA[i] = 0x8
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *To_Nodes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *KeyGroup) GetMembers() [][]byte {
+	if m != nil {
+		return m.Members
+	}
+	return nil
 }
 
-func (m *To_Nodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Nodes != nil {
-		{
-			size, err := m.Nodes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *KeyGroup) GetPolicy() *ThresholdPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *To_Node) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *KeyGroup) GetRegionId() uint32 {
+	if m != nil {
+		return m.RegionId
+	}
+	return 0
 }
 
-func (m *To_Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Node != nil {
-		i -= len(m.Node)
-		copy(dAtA[i:], m.Node)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Node)))
-		i--
-		dAtA[i] = 0x1a
+func (m *KeyGroup) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *Nodes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *KeyGroup) GetDkgPub() []byte {
+	if m != nil {
+		return m.DkgPub
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Nodes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeyGroup) GetDkgCommits() []byte {
+	if m != nil {
+		return m.DkgCommits
+	}
+	return nil
 }
 
-func (m *Nodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.L) > 0 {
-		for iNdEx := len(m.L) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.L[iNdEx])
-			copy(dAtA[i:], m.L[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.L[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *KeyGroup) GetPending() uint32 {
+	if m != nil {
+		return m.Pending
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TeeCall) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyGroup) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TeeCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 密钥组的 DKG 完成，由发起节点提交
+// DKG result of a key group epoch
+type KeyGroupEpoch struct {
+	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Epoch                uint32      `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	DkgPub               []byte      `protobuf:"bytes,3,opt,name=dkg_pub,json=dkgPub,proto3" json:"dkg_pub,omitempty"`
+	DkgCommits           []byte      `protobuf:"bytes,4,opt,name=dkg_commits,json=dkgCommits,proto3" json:"dkg_commits,omitempty"`
+	Acks                 []*RoundAck `protobuf:"bytes,5,rep,name=acks,proto3" json:"acks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *TeeCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Tx != nil {
-		{
-			size := m.Tx.Size()
-			i -= size
-			if _, err := m.Tx.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if len(m.Report) > 0 {
-		i -= len(m.Report)
-		copy(dAtA[i:], m.Report)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Report)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TeeType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TeeType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Time != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Time))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Caller) > 0 {
-		i -= len(m.Caller)
-		copy(dAtA[i:], m.Caller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *KeyGroupEpoch) Reset()         { *m = KeyGroupEpoch{} }
+func (m *KeyGroupEpoch) String() string { return proto.CompactTextString(m) }
+func (*KeyGroupEpoch) ProtoMessage()    {}
+func (*KeyGroupEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{36}
 }
-
-func (m *TeeCall_PodStart) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeyGroupEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *TeeCall_PodStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PodStart != nil {
-		{
-			size, err := m.PodStart.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *KeyGroupEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyGroupEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *TeeCall_PodMint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeyGroupEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyGroupEpoch.Merge(m, src)
 }
-
-func (m *TeeCall_PodMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PodMint != nil {
-		{
-			size, err := m.PodMint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	return len(dAtA) - i, nil
+func (m *KeyGroupEpoch) XXX_Size() int {
+	return m.Size()
 }
-func (m *TeeCall_BridgeCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *KeyGroupEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyGroupEpoch.DiscardUnknown(m)
 }
 
-func (m *TeeCall_BridgeCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BridgeCall != nil {
-		{
-			size, err := m.BridgeCall.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+var xxx_messageInfo_KeyGroupEpoch proto.InternalMessageInfo
+
+func (m *KeyGroupEpoch) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *TeeCall_Text) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *KeyGroupEpoch) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-func (m *TeeCall_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Text != nil {
-		i -= len(m.Text)
-		copy(dAtA[i:], m.Text)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Text)))
-		i--
-		dAtA[i] = 0x42
+func (m *KeyGroupEpoch) GetDkgPub() []byte {
+	if m != nil {
+		return m.DkgPub
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *TeeCall_UploadSecret) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *KeyGroupEpoch) GetDkgCommits() []byte {
+	if m != nil {
+		return m.DkgCommits
+	}
+	return nil
 }
 
-func (m *TeeCall_UploadSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UploadSecret != nil {
-		{
-			size, err := m.UploadSecret.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+func (m *KeyGroupEpoch) GetAcks() []*RoundAck {
+	if m != nil {
+		return m.Acks
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *TeeCall_InitDisk) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type SecretBox struct {
+	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *To    `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	ReqId string `protobuf:"bytes,3,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*SecretBox_Req
+	//	*SecretBox_SharesResp
+	//	*SecretBox_Resp
+	//	*SecretBox_MigrateReq
+	//	*SecretBox_MigrateShares
+	Payload              isSecretBox_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *TeeCall_InitDisk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.InitDisk != nil {
-		{
-			size, err := m.InitDisk.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *SecretBox) Reset()         { *m = SecretBox{} }
+func (m *SecretBox) String() string { return proto.CompactTextString(m) }
+func (*SecretBox) ProtoMessage()    {}
+func (*SecretBox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{37}
+}
+func (m *SecretBox) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretBox.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *PodStart) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *SecretBox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretBox.Merge(m, src)
+}
+func (m *SecretBox) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretBox) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretBox.DiscardUnknown(m)
 }
 
-func (m *PodStart) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SecretBox proto.InternalMessageInfo
+
+type isSecretBox_Payload interface {
+	isSecretBox_Payload()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *PodStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Disks) > 0 {
-		dAtA12 := make([]byte, len(m.Disks)*10)
-		var j11 int
-		for _, num := range m.Disks {
-			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j11++
-			}
-			dAtA12[j11] = uint8(num)
-			j11++
-		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintTx(dAtA, i, uint64(j11))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Secrets) > 0 {
-		dAtA14 := make([]byte, len(m.Secrets)*10)
-		var j13 int
-		for _, num := range m.Secrets {
-			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j13++
-			}
-			dAtA14[j13] = uint8(num)
-			j13++
-		}
-		i -= j13
-		copy(dAtA[i:], dAtA14[:j13])
-		i = encodeVarintTx(dAtA, i, uint64(j13))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x22
+type SecretBox_Req struct {
+	Req *PodStart `protobuf:"bytes,4,opt,name=req,proto3,oneof" json:"req,omitempty"`
+}
+type SecretBox_SharesResp struct {
+	SharesResp *DecryptSharesResp `protobuf:"bytes,5,opt,name=shares_resp,json=sharesResp,proto3,oneof" json:"shares_resp,omitempty"`
+}
+type SecretBox_Resp struct {
+	Resp *DecryptResp `protobuf:"bytes,6,opt,name=resp,proto3,oneof" json:"resp,omitempty"`
+}
+type SecretBox_MigrateReq struct {
+	MigrateReq *SecretMigrateReq `protobuf:"bytes,7,opt,name=migrate_req,json=migrateReq,proto3,oneof" json:"migrate_req,omitempty"`
+}
+type SecretBox_MigrateShares struct {
+	MigrateShares *SecretMigrateShares `protobuf:"bytes,8,opt,name=migrate_shares,json=migrateShares,proto3,oneof" json:"migrate_shares,omitempty"`
+}
+
+func (*SecretBox_Req) isSecretBox_Payload()           {}
+func (*SecretBox_SharesResp) isSecretBox_Payload()    {}
+func (*SecretBox_Resp) isSecretBox_Payload()          {}
+func (*SecretBox_MigrateReq) isSecretBox_Payload()    {}
+func (*SecretBox_MigrateShares) isSecretBox_Payload() {}
+
+func (m *SecretBox) GetPayload() isSecretBox_Payload {
+	if m != nil {
+		return m.Payload
 	}
-	if len(m.NameSpace) > 0 {
-		i -= len(m.NameSpace)
-		copy(dAtA[i:], m.NameSpace)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NameSpace)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *SecretBox) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	if len(m.AppId) > 0 {
-		i -= len(m.AppId)
-		copy(dAtA[i:], m.AppId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AppId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SecretBox) GetTo() *To {
+	if m != nil {
+		return m.To
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *SecretBox) GetReqId() string {
+	if m != nil {
+		return m.ReqId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PodMint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SecretBox) GetReq() *PodStart {
+	if x, ok := m.GetPayload().(*SecretBox_Req); ok {
+		return x.Req
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PodMint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SecretBox) GetSharesResp() *DecryptSharesResp {
+	if x, ok := m.GetPayload().(*SecretBox_SharesResp); ok {
+		return x.SharesResp
+	}
+	return nil
 }
 
-func (m *PodMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SecretBox) GetResp() *DecryptResp {
+	if x, ok := m.GetPayload().(*SecretBox_Resp); ok {
+		return x.Resp
 	}
-	if len(m.ReportHash) > 0 {
-		i -= len(m.ReportHash)
-		copy(dAtA[i:], m.ReportHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReportHash)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *SecretBox) GetMigrateReq() *SecretMigrateReq {
+	if x, ok := m.GetPayload().(*SecretBox_MigrateReq); ok {
+		return x.MigrateReq
 	}
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *SecretBox) GetMigrateShares() *SecretMigrateShares {
+	if x, ok := m.GetPayload().(*SecretBox_MigrateShares); ok {
+		return x.MigrateShares
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BridgeCall) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SecretBox) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SecretBox_Req)(nil),
+		(*SecretBox_SharesResp)(nil),
+		(*SecretBox_Resp)(nil),
+		(*SecretBox_MigrateReq)(nil),
+		(*SecretBox_MigrateShares)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *BridgeCall) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Blob p2p message
+type BlobBox struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   *To    `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*BlobBox_Req
+	//	*BlobBox_Resp
+	Payload              isBlobBox_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *BridgeCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Time != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Time))
-		i--
-		dAtA[i] = 0x8
+func (m *BlobBox) Reset()         { *m = BlobBox{} }
+func (m *BlobBox) String() string { return proto.CompactTextString(m) }
+func (*BlobBox) ProtoMessage()    {}
+func (*BlobBox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{38}
+}
+func (m *BlobBox) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobBox.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BlobBox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobBox.Merge(m, src)
+}
+func (m *BlobBox) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobBox) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobBox.DiscardUnknown(m)
 }
 
-func (m *TeeVerifyResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_BlobBox proto.InternalMessageInfo
+
+type isBlobBox_Payload interface {
+	isBlobBox_Payload()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *TeeVerifyResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BlobBox_Req struct {
+	Req []byte `protobuf:"bytes,3,opt,name=req,proto3,oneof" json:"req,omitempty"`
+}
+type BlobBox_Resp struct {
+	Resp *BlobResp `protobuf:"bytes,4,opt,name=resp,proto3,oneof" json:"resp,omitempty"`
 }
 
-func (m *TeeVerifyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.CodeProductId) > 0 {
-		i -= len(m.CodeProductId)
-		copy(dAtA[i:], m.CodeProductId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeProductId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CodeSignature) > 0 {
-		i -= len(m.CodeSignature)
-		copy(dAtA[i:], m.CodeSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeSignature)))
-		i--
-		dAtA[i] = 0x1a
+func (*BlobBox_Req) isBlobBox_Payload()  {}
+func (*BlobBox_Resp) isBlobBox_Payload() {}
+
+func (m *BlobBox) GetPayload() isBlobBox_Payload {
+	if m != nil {
+		return m.Payload
 	}
-	if len(m.CodeSigner) > 0 {
-		i -= len(m.CodeSigner)
-		copy(dAtA[i:], m.CodeSigner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeSigner)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *BlobBox) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	if m.TeeType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TeeType))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *BlobBox) GetTo() *To {
+	if m != nil {
+		return m.To
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *UploadSecret) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlobBox) GetReq() []byte {
+	if x, ok := m.GetPayload().(*BlobBox_Req); ok {
+		return x.Req
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UploadSecret) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlobBox) GetResp() *BlobResp {
+	if x, ok := m.GetPayload().(*BlobBox_Resp); ok {
+		return x.Resp
+	}
+	return nil
 }
 
-func (m *UploadSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Time != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Time))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BlobBox) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BlobBox_Req)(nil),
+		(*BlobBox_Resp)(nil),
 	}
-	if m.Index != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
+}
+
+// Blob fetch response
+type BlobResp struct {
+	Cid                  []byte   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlobResp) Reset()         { *m = BlobResp{} }
+func (m *BlobResp) String() string { return proto.CompactTextString(m) }
+func (*BlobResp) ProtoMessage()    {}
+func (*BlobResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{39}
+}
+func (m *BlobResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *BlobResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobResp.Merge(m, src)
+}
+func (m *BlobResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobResp proto.InternalMessageInfo
+
+func (m *BlobResp) GetCid() []byte {
+	if m != nil {
+		return m.Cid
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *InitDisk) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlobResp) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *InitDisk) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 应用门限签名请求，签名密钥由应用的 TEE 身份和 key_id 派生
+// Threshold sign request of an attested app
+type ThresholdSign struct {
+	KeyId                string   `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Message              []byte   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InitDisk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Time != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Time))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
+func (m *ThresholdSign) Reset()         { *m = ThresholdSign{} }
+func (m *ThresholdSign) String() string { return proto.CompactTextString(m) }
+func (*ThresholdSign) ProtoMessage()    {}
+func (*ThresholdSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{40}
+}
+func (m *ThresholdSign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdSign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Index != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ThresholdSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdSign.Merge(m, src)
+}
+func (m *ThresholdSign) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdSign proto.InternalMessageInfo
+
+func (m *ThresholdSign) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
 	}
-	if len(m.User) > 0 {
-		i -= len(m.User)
-		copy(dAtA[i:], m.User)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ThresholdSign) GetMessage() []byte {
+	if m != nil {
+		return m.Message
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *SecretBox) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// Threshold sign p2p message
+type SignBox struct {
+	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *To    `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	ReqId string `protobuf:"bytes,3,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*SignBox_Req
+	//	*SignBox_Commit
+	//	*SignBox_Round
+	//	*SignBox_Partial
+	Payload              isSignBox_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SignBox) Reset()         { *m = SignBox{} }
+func (m *SignBox) String() string { return proto.CompactTextString(m) }
+func (*SignBox) ProtoMessage()    {}
+func (*SignBox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{41}
+}
+func (m *SignBox) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignBox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignBox.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SignBox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignBox.Merge(m, src)
+}
+func (m *SignBox) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignBox) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignBox.DiscardUnknown(m)
 }
 
-func (m *SecretBox) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SignBox proto.InternalMessageInfo
+
+type isSignBox_Payload interface {
+	isSignBox_Payload()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *SecretBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type SignBox_Req struct {
+	Req *TeeCall `protobuf:"bytes,4,opt,name=req,proto3,oneof" json:"req,omitempty"`
+}
+type SignBox_Commit struct {
+	Commit *SignCommit `protobuf:"bytes,5,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
+}
+type SignBox_Round struct {
+	Round *SignRound `protobuf:"bytes,6,opt,name=round,proto3,oneof" json:"round,omitempty"`
+}
+type SignBox_Partial struct {
+	Partial *SignPartial `protobuf:"bytes,7,opt,name=partial,proto3,oneof" json:"partial,omitempty"`
+}
+
+func (*SignBox_Req) isSignBox_Payload()     {}
+func (*SignBox_Commit) isSignBox_Payload()  {}
+func (*SignBox_Round) isSignBox_Payload()   {}
+func (*SignBox_Partial) isSignBox_Payload() {}
+
+func (m *SignBox) GetPayload() isSignBox_Payload {
+	if m != nil {
+		return m.Payload
 	}
-	if m.Payload != nil {
-		{
-			size := m.Payload.Size()
-			i -= size
-			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	return nil
+}
+
+func (m *SignBox) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SignBox) GetTo() *To {
+	if m != nil {
+		return m.To
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *SignBox) GetReqId() string {
+	if m != nil {
+		return m.ReqId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SecretBox_Req) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignBox) GetReq() *TeeCall {
+	if x, ok := m.GetPayload().(*SignBox_Req); ok {
+		return x.Req
+	}
+	return nil
 }
 
-func (m *SecretBox_Req) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (m *SignBox) GetCommit() *SignCommit {
+	if x, ok := m.GetPayload().(*SignBox_Commit); ok {
+		return x.Commit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *SecretBox_SharesResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *SignBox) GetRound() *SignRound {
+	if x, ok := m.GetPayload().(*SignBox_Round); ok {
+		return x.Round
+	}
+	return nil
 }
 
-func (m *SecretBox_SharesResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SharesResp != nil {
-		{
-			size, err := m.SharesResp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func (m *SignBox) GetPartial() *SignPartial {
+	if x, ok := m.GetPayload().(*SignBox_Partial); ok {
+		return x.Partial
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *SecretBox_Resp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*SignBox) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*SignBox_Req)(nil),
+		(*SignBox_Commit)(nil),
+		(*SignBox_Round)(nil),
+		(*SignBox_Partial)(nil),
+	}
 }
 
-func (m *SecretBox_Resp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Resp != nil {
-		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+// nonce commitment of a signer
+type SignCommit struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Hiding               []byte   `protobuf:"bytes,2,opt,name=hiding,proto3" json:"hiding,omitempty"`
+	Binding              []byte   `protobuf:"bytes,3,opt,name=binding,proto3" json:"binding,omitempty"`
+	Error                []byte   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignCommit) Reset()         { *m = SignCommit{} }
+func (m *SignCommit) String() string { return proto.CompactTextString(m) }
+func (*SignCommit) ProtoMessage()    {}
+func (*SignCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{42}
+}
+func (m *SignCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *SecretStore) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SignCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignCommit.Merge(m, src)
+}
+func (m *SignCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignCommit proto.InternalMessageInfo
+
+func (m *SignCommit) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SecretStore) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SignCommit) GetHiding() []byte {
+	if m != nil {
+		return m.Hiding
+	}
+	return nil
 }
 
-func (m *SecretStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SignCommit) GetBinding() []byte {
+	if m != nil {
+		return m.Binding
 	}
-	if len(m.RawEncScrt) > 0 {
-		for iNdEx := len(m.RawEncScrt) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.RawEncScrt[iNdEx])
-			copy(dAtA[i:], m.RawEncScrt[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.RawEncScrt[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	return nil
+}
+
+func (m *SignCommit) GetError() []byte {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.RawEncCmt) > 0 {
-		i -= len(m.RawEncCmt)
-		copy(dAtA[i:], m.RawEncCmt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RawEncCmt)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+type SignRound struct {
+	Commits              []*SignCommit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SignRound) Reset()         { *m = SignRound{} }
+func (m *SignRound) String() string { return proto.CompactTextString(m) }
+func (*SignRound) ProtoMessage()    {}
+func (*SignRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{43}
+}
+func (m *SignRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SignRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRound.Merge(m, src)
+}
+func (m *SignRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRound.DiscardUnknown(m)
 }
 
-func (m *DecryptShare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SignRound proto.InternalMessageInfo
+
+func (m *SignRound) GetCommits() []*SignCommit {
+	if m != nil {
+		return m.Commits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DecryptShare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SignPartial struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Z                    []byte   `protobuf:"bytes,2,opt,name=z,proto3" json:"z,omitempty"`
+	Error                []byte   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DecryptShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SignPartial) Reset()         { *m = SignPartial{} }
+func (m *SignPartial) String() string { return proto.CompactTextString(m) }
+func (*SignPartial) ProtoMessage()    {}
+func (*SignPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{44}
+}
+func (m *SignPartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignPartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Proofi) > 0 {
-		i -= len(m.Proofi)
-		copy(dAtA[i:], m.Proofi)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Proofi)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *SignPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignPartial.Merge(m, src)
+}
+func (m *SignPartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignPartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignPartial proto.InternalMessageInfo
+
+func (m *SignPartial) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	if len(m.Chlgi) > 0 {
-		i -= len(m.Chlgi)
-		copy(dAtA[i:], m.Chlgi)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Chlgi)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *SignPartial) GetZ() []byte {
+	if m != nil {
+		return m.Z
 	}
-	if len(m.XncSki) > 0 {
-		i -= len(m.XncSki)
-		copy(dAtA[i:], m.XncSki)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.XncSki)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *SignPartial) GetError() []byte {
+	if m != nil {
+		return m.Error
 	}
-	if m.ShareIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ShareIndex))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+// secret data
+type SecretStore struct {
+	RawEncCmt            []byte        `protobuf:"bytes,1,opt,name=raw_enc_cmt,json=rawEncCmt,proto3" json:"raw_enc_cmt,omitempty"`
+	RawEncScrt           [][]byte      `protobuf:"bytes,2,rep,name=raw_enc_scrt,json=rawEncScrt,proto3" json:"raw_enc_scrt,omitempty"`
+	Payload              []byte        `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	PayloadCid           []byte        `protobuf:"bytes,4,opt,name=payload_cid,json=payloadCid,proto3" json:"payload_cid,omitempty"`
+	Window               *SecretWindow `protobuf:"bytes,5,opt,name=window,proto3" json:"window,omitempty"`
+	Group                string        `protobuf:"bytes,6,opt,name=group,proto3" json:"group,omitempty"`
+	Proof                []byte        `protobuf:"bytes,7,opt,name=proof,proto3" json:"proof,omitempty"`
+	KeyType              string        `protobuf:"bytes,8,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SecretStore) Reset()         { *m = SecretStore{} }
+func (m *SecretStore) String() string { return proto.CompactTextString(m) }
+func (*SecretStore) ProtoMessage()    {}
+func (*SecretStore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{45}
+}
+func (m *SecretStore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretStore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SecretStore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretStore.Merge(m, src)
+}
+func (m *SecretStore) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretStore) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretStore.DiscardUnknown(m)
 }
 
-func (m *DecryptSharesResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SecretStore proto.InternalMessageInfo
+
+func (m *SecretStore) GetRawEncCmt() []byte {
+	if m != nil {
+		return m.RawEncCmt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DecryptSharesResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SecretStore) GetRawEncScrt() [][]byte {
+	if m != nil {
+		return m.RawEncScrt
+	}
+	return nil
 }
 
-func (m *DecryptSharesResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SecretStore) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *SecretStore) GetPayloadCid() []byte {
+	if m != nil {
+		return m.PayloadCid
 	}
-	if len(m.DiskShares) > 0 {
-		for k := range m.DiskShares {
-			v := m.DiskShares[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintTx(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintTx(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+	return nil
+}
+
+func (m *SecretStore) GetWindow() *SecretWindow {
+	if m != nil {
+		return m.Window
 	}
-	if len(m.SecretShares) > 0 {
-		for k := range m.SecretShares {
-			v := m.SecretShares[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintTx(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintTx(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
+	return nil
+}
+
+func (m *SecretStore) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SecretStore) GetProof() []byte {
+	if m != nil {
+		return m.Proof
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DecryptResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SecretStore) GetKeyType() string {
+	if m != nil {
+		return m.KeyType
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DecryptResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 签名密钥加密的旧 secret 迁移到加密密钥，只替换加密的 data key，payload 不变
+// Legacy secrets encrypted to the signing key, re-wrapped to the encryption key
+type SecretMigrate struct {
+	Secrets              []*MigratedSecret  `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Members              []*KeySwitchMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *DecryptResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DiskKeys) > 0 {
-		for k := range m.DiskKeys {
-			v := m.DiskKeys[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintTx(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintTx(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Secrets) > 0 {
-		for k := range m.Secrets {
-			v := m.Secrets[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintTx(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintTx(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
+func (m *SecretMigrate) Reset()         { *m = SecretMigrate{} }
+func (m *SecretMigrate) String() string { return proto.CompactTextString(m) }
+func (*SecretMigrate) ProtoMessage()    {}
+func (*SecretMigrate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{46}
+}
+func (m *SecretMigrate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretMigrate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretMigrate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DkgKey) > 0 {
-		i -= len(m.DkgKey)
-		copy(dAtA[i:], m.DkgKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgKey)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *SecretMigrate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretMigrate.Merge(m, src)
+}
+func (m *SecretMigrate) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretMigrate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretMigrate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretMigrate proto.InternalMessageInfo
+
+func (m *SecretMigrate) GetSecrets() []*MigratedSecret {
+	if m != nil {
+		return m.Secrets
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Secret) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SecretMigrate) GetMembers() []*KeySwitchMember {
+	if m != nil {
+		return m.Members
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Secret) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MigratedSecret struct {
+	Disk                 bool              `protobuf:"varint,1,opt,name=disk,proto3" json:"disk,omitempty"`
+	Owner                []byte            `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Index                uint64            `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	OldEncCmt            []byte            `protobuf:"bytes,4,opt,name=old_enc_cmt,json=oldEncCmt,proto3" json:"old_enc_cmt,omitempty"`
+	Shares               []*KeySwitchShare `protobuf:"bytes,7,rep,name=shares,proto3" json:"shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.EncScrt) > 0 {
-		for iNdEx := len(m.EncScrt) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EncScrt[iNdEx])
-			copy(dAtA[i:], m.EncScrt[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.EncScrt[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MigratedSecret) Reset()         { *m = MigratedSecret{} }
+func (m *MigratedSecret) String() string { return proto.CompactTextString(m) }
+func (*MigratedSecret) ProtoMessage()    {}
+func (*MigratedSecret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{47}
+}
+func (m *MigratedSecret) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigratedSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigratedSecret.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.XncCmt) > 0 {
-		i -= len(m.XncCmt)
-		copy(dAtA[i:], m.XncCmt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.XncCmt)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MigratedSecret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigratedSecret.Merge(m, src)
+}
+func (m *MigratedSecret) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigratedSecret) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigratedSecret.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigratedSecret proto.InternalMessageInfo
+
+func (m *MigratedSecret) GetDisk() bool {
+	if m != nil {
+		return m.Disk
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *TeeTrigger) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MigratedSecret) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TeeTrigger) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MigratedSecret) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *TeeTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Callids) > 0 {
-		dAtA25 := make([]byte, len(m.Callids)*10)
-		var j24 int
-		for _, num := range m.Callids {
-			for num >= 1<<7 {
-				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j24++
-			}
-			dAtA25[j24] = uint8(num)
-			j24++
-		}
-		i -= j24
-		copy(dAtA[i:], dAtA25[:j24])
-		i = encodeVarintTx(dAtA, i, uint64(j24))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ClusterId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ClusterId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Tee != nil {
-		{
-			size, err := m.Tee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *MigratedSecret) GetOldEncCmt() []byte {
+	if m != nil {
+		return m.OldEncCmt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ApiReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MigratedSecret) GetShares() []*KeySwitchShare {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ApiReq) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 成员在签名密钥和加密密钥中的份额序号
+// Share indices of a key switching member in the signing key and the encryption key
+type KeySwitchMember struct {
+	From                 uint32   `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   uint32   `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApiReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x1a
+func (m *KeySwitchMember) Reset()         { *m = KeySwitchMember{} }
+func (m *KeySwitchMember) String() string { return proto.CompactTextString(m) }
+func (*KeySwitchMember) ProtoMessage()    {}
+func (*KeySwitchMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{48}
+}
+func (m *KeySwitchMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeySwitchMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeySwitchMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *KeySwitchMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeySwitchMember.Merge(m, src)
+}
+func (m *KeySwitchMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeySwitchMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeySwitchMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeySwitchMember proto.InternalMessageInfo
+
+func (m *KeySwitchMember) GetFrom() uint32 {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ApiResp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeySwitchMember) GetTo() uint32 {
+	if m != nil {
+		return m.To
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ApiResp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 成员的密钥切换份额和 NIZK 证明
+// Key switching share of a member with its NIZK proof
+type KeySwitchShare struct {
+	V                    []byte   `protobuf:"bytes,1,opt,name=v,proto3" json:"v,omitempty"`
+	Challenge            []byte   `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Proof                []byte   `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ApiResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Code != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x10
+func (m *KeySwitchShare) Reset()         { *m = KeySwitchShare{} }
+func (m *KeySwitchShare) String() string { return proto.CompactTextString(m) }
+func (*KeySwitchShare) ProtoMessage()    {}
+func (*KeySwitchShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{49}
+}
+func (m *KeySwitchShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeySwitchShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeySwitchShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *KeySwitchShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeySwitchShare.Merge(m, src)
+}
+func (m *KeySwitchShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeySwitchShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeySwitchShare.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_KeySwitchShare proto.InternalMessageInfo
+
+func (m *KeySwitchShare) GetV() []byte {
+	if m != nil {
+		return m.V
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *TxBox) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TeeSig)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Org)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Tx)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+
+func (m *KeySwitchShare) GetChallenge() []byte {
+	if m != nil {
+		return m.Challenge
 	}
-	return n
+	return nil
 }
 
-func (m *Tx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Payload != nil {
-		n += m.Payload.Size()
-	}
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *KeySwitchShare) GetProof() []byte {
+	if m != nil {
+		return m.Proof
 	}
-	return n
+	return nil
 }
 
-func (m *Tx_EpochEnd) Size() (n int) {
-	if m == nil {
-		return 0
+// 发起节点请求成员计算一批旧 secret 的密钥切换份额
+// Key switching request of a batch of legacy secrets
+type SecretMigrateReq struct {
+	Members              []*KeySwitchMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Secrets              []*MigratedSecret  `protobuf:"bytes,2,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SecretMigrateReq) Reset()         { *m = SecretMigrateReq{} }
+func (m *SecretMigrateReq) String() string { return proto.CompactTextString(m) }
+func (*SecretMigrateReq) ProtoMessage()    {}
+func (*SecretMigrateReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{50}
+}
+func (m *SecretMigrateReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretMigrateReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretMigrateReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.EpochEnd != nil {
-		l = m.EpochEnd.Size()
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *SecretMigrateReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretMigrateReq.Merge(m, src)
+}
+func (m *SecretMigrateReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretMigrateReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretMigrateReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretMigrateReq proto.InternalMessageInfo
+
+func (m *SecretMigrateReq) GetMembers() []*KeySwitchMember {
+	if m != nil {
+		return m.Members
 	}
-	return n
+	return nil
 }
-func (m *Tx_EpochStart) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SecretMigrateReq) GetSecrets() []*MigratedSecret {
+	if m != nil {
+		return m.Secrets
 	}
-	var l int
-	_ = l
-	n += 1 + sovTx(uint64(m.EpochStart))
-	return n
+	return nil
 }
-func (m *Tx_HubCall) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HubCall != nil {
-		l = m.HubCall.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+// 成员的密钥切换份额，按请求中 secret 的顺序
+// Key switching shares of a member, in the order of the request
+type SecretMigrateShares struct {
+	Member               *KeySwitchMember  `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	Shares               []*KeySwitchShare `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
+	Error                []byte            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *Tx_SyncTxStart) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovTx(uint64(m.SyncTxStart))
-	return n
+
+func (m *SecretMigrateShares) Reset()         { *m = SecretMigrateShares{} }
+func (m *SecretMigrateShares) String() string { return proto.CompactTextString(m) }
+func (*SecretMigrateShares) ProtoMessage()    {}
+func (*SecretMigrateShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{51}
 }
-func (m *Tx_SyncTxEnd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovTx(uint64(m.SyncTxEnd))
-	return n
+func (m *SecretMigrateShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *Tx_SyncTxRetry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SecretMigrateShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretMigrateShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	n += 1 + sovTx(uint64(m.SyncTxRetry))
-	return n
 }
-func (m *Tx_DaoCall) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DaoCall != nil {
-		l = len(m.DaoCall)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *SecretMigrateShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretMigrateShares.Merge(m, src)
 }
-func (m *Tx_Empty) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 2 + sovTx(uint64(m.Empty))
-	return n
+func (m *SecretMigrateShares) XXX_Size() int {
+	return m.Size()
 }
-func (m *SideValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Power != 0 {
-		n += 1 + sovTx(uint64(m.Power))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *SecretMigrateShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretMigrateShares.DiscardUnknown(m)
 }
 
-func (m *EpochEnd) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovTx(uint64(m.Epoch))
-	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.DkgPub)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DkgCommits)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_SecretMigrateShares proto.InternalMessageInfo
+
+func (m *SecretMigrateShares) GetMember() *KeySwitchMember {
+	if m != nil {
+		return m.Member
 	}
-	return n
+	return nil
 }
 
-func (m *Bridge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MsgId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SecretMigrateShares) GetShares() []*KeySwitchShare {
+	if m != nil {
+		return m.Shares
 	}
-	return n
+	return nil
 }
 
-func (m *HubCall) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	if len(m.Call) > 0 {
-		for _, e := range m.Call {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SecretMigrateShares) GetError() []byte {
+	if m != nil {
+		return m.Error
 	}
-	return n
+	return nil
 }
 
-func (m *DkgMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MsgId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// secret 的有效期，0 表示不限制
+// Access window of a secret, 0 means unbounded
+type SecretWindow struct {
+	NotBefore            uint64   `protobuf:"varint,1,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter             uint64   `protobuf:"varint,2,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	ByHeight             bool     `protobuf:"varint,3,opt,name=by_height,json=byHeight,proto3" json:"by_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BlockPartialSign) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TxIndex != 0 {
-		n += 1 + sovTx(uint64(m.TxIndex))
-	}
-	l = len(m.HubSig)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SecretWindow) Reset()         { *m = SecretWindow{} }
+func (m *SecretWindow) String() string { return proto.CompactTextString(m) }
+func (*SecretWindow) ProtoMessage()    {}
+func (*SecretWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{52}
+}
+func (m *SecretWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *SecretWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretWindow.Merge(m, src)
+}
+func (m *SecretWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretWindow.DiscardUnknown(m)
 }
 
-func (m *To) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Payload != nil {
-		n += m.Payload.Size()
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_SecretWindow proto.InternalMessageInfo
+
+func (m *SecretWindow) GetNotBefore() uint64 {
+	if m != nil {
+		return m.NotBefore
 	}
-	return n
+	return 0
 }
 
-func (m *To_Broadcast) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SecretWindow) GetNotAfter() uint64 {
+	if m != nil {
+		return m.NotAfter
 	}
-	var l int
-	_ = l
-	n += 2
-	return n
+	return 0
 }
-func (m *To_Nodes) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SecretWindow) GetByHeight() bool {
+	if m != nil {
+		return m.ByHeight
 	}
-	var l int
-	_ = l
-	if m.Nodes != nil {
-		l = m.Nodes.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return false
 }
-func (m *To_Node) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Node != nil {
-		l = len(m.Node)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+// DecryptShare
+type DecryptShare struct {
+	ShareIndex           int32    `protobuf:"varint,2,opt,name=share_index,json=shareIndex,proto3" json:"share_index,omitempty"`
+	XncSki               []byte   `protobuf:"bytes,3,opt,name=xnc_ski,json=xncSki,proto3" json:"xnc_ski,omitempty"`
+	Chlgi                []byte   `protobuf:"bytes,4,opt,name=chlgi,proto3" json:"chlgi,omitempty"`
+	Proofi               []byte   `protobuf:"bytes,5,opt,name=proofi,proto3" json:"proofi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *Nodes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.L) > 0 {
-		for _, b := range m.L {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+
+func (m *DecryptShare) Reset()         { *m = DecryptShare{} }
+func (m *DecryptShare) String() string { return proto.CompactTextString(m) }
+func (*DecryptShare) ProtoMessage()    {}
+func (*DecryptShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{53}
+}
+func (m *DecryptShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecryptShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecryptShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *DecryptShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecryptShare.Merge(m, src)
+}
+func (m *DecryptShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecryptShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecryptShare.DiscardUnknown(m)
 }
 
-func (m *TeeCall) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_DecryptShare proto.InternalMessageInfo
+
+func (m *DecryptShare) GetShareIndex() int32 {
+	if m != nil {
+		return m.ShareIndex
 	}
-	var l int
-	_ = l
-	l = len(m.Caller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *DecryptShare) GetXncSki() []byte {
+	if m != nil {
+		return m.XncSki
 	}
-	if m.Time != 0 {
-		n += 1 + sovTx(uint64(m.Time))
+	return nil
+}
+
+func (m *DecryptShare) GetChlgi() []byte {
+	if m != nil {
+		return m.Chlgi
 	}
-	if m.TeeType != 0 {
-		n += 1 + sovTx(uint64(m.TeeType))
+	return nil
+}
+
+func (m *DecryptShare) GetProofi() []byte {
+	if m != nil {
+		return m.Proofi
 	}
-	l = len(m.Report)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// DecryptShares
+type DecryptSharesResp struct {
+	Req                  *PodStart                `protobuf:"bytes,2,opt,name=req,proto3" json:"req,omitempty"`
+	SecretShares         map[uint64]*DecryptShare `protobuf:"bytes,3,rep,name=secret_shares,json=secretShares,proto3" json:"secret_shares,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DiskShares           map[uint64]*DecryptShare `protobuf:"bytes,4,rep,name=disk_shares,json=diskShares,proto3" json:"disk_shares,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Error                []byte                   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	SharedShares         []*DecryptShare          `protobuf:"bytes,6,rep,name=shared_shares,json=sharedShares,proto3" json:"shared_shares,omitempty"`
+	Replicas             []*ReplicaShares         `protobuf:"bytes,7,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *DecryptSharesResp) Reset()         { *m = DecryptSharesResp{} }
+func (m *DecryptSharesResp) String() string { return proto.CompactTextString(m) }
+func (*DecryptSharesResp) ProtoMessage()    {}
+func (*DecryptSharesResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{54}
+}
+func (m *DecryptSharesResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecryptSharesResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecryptSharesResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Tx != nil {
-		n += m.Tx.Size()
+}
+func (m *DecryptSharesResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecryptSharesResp.Merge(m, src)
+}
+func (m *DecryptSharesResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecryptSharesResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecryptSharesResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecryptSharesResp proto.InternalMessageInfo
+
+func (m *DecryptSharesResp) GetReq() *PodStart {
+	if m != nil {
+		return m.Req
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *DecryptSharesResp) GetSecretShares() map[uint64]*DecryptShare {
+	if m != nil {
+		return m.SecretShares
 	}
-	return n
+	return nil
 }
 
-func (m *TeeCall_PodStart) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DecryptSharesResp) GetDiskShares() map[uint64]*DecryptShare {
+	if m != nil {
+		return m.DiskShares
 	}
-	var l int
-	_ = l
-	if m.PodStart != nil {
-		l = m.PodStart.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *DecryptSharesResp) GetError() []byte {
+	if m != nil {
+		return m.Error
 	}
-	return n
+	return nil
 }
-func (m *TeeCall_PodMint) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *DecryptSharesResp) GetSharedShares() []*DecryptShare {
+	if m != nil {
+		return m.SharedShares
 	}
-	var l int
-	_ = l
-	if m.PodMint != nil {
-		l = m.PodMint.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *DecryptSharesResp) GetReplicas() []*ReplicaShares {
+	if m != nil {
+		return m.Replicas
 	}
-	return n
+	return nil
 }
-func (m *TeeCall_BridgeCall) Size() (n int) {
-	if m == nil {
-		return 0
+
+// 副本读者的重加密份额
+// Re-encrypted shares for a replica reader key
+type ReplicaShares struct {
+	SecretShares         map[uint64]*DecryptShare `protobuf:"bytes,1,rep,name=secret_shares,json=secretShares,proto3" json:"secret_shares,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DiskShares           map[uint64]*DecryptShare `protobuf:"bytes,2,rep,name=disk_shares,json=diskShares,proto3" json:"disk_shares,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SharedShares         []*DecryptShare          `protobuf:"bytes,3,rep,name=shared_shares,json=sharedShares,proto3" json:"shared_shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ReplicaShares) Reset()         { *m = ReplicaShares{} }
+func (m *ReplicaShares) String() string { return proto.CompactTextString(m) }
+func (*ReplicaShares) ProtoMessage()    {}
+func (*ReplicaShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{55}
+}
+func (m *ReplicaShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicaShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicaShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.BridgeCall != nil {
-		l = m.BridgeCall.Size()
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *ReplicaShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicaShares.Merge(m, src)
+}
+func (m *ReplicaShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicaShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicaShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicaShares proto.InternalMessageInfo
+
+func (m *ReplicaShares) GetSecretShares() map[uint64]*DecryptShare {
+	if m != nil {
+		return m.SecretShares
 	}
-	return n
+	return nil
 }
-func (m *TeeCall_Text) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ReplicaShares) GetDiskShares() map[uint64]*DecryptShare {
+	if m != nil {
+		return m.DiskShares
 	}
-	var l int
-	_ = l
-	if m.Text != nil {
-		l = len(m.Text)
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *ReplicaShares) GetSharedShares() []*DecryptShare {
+	if m != nil {
+		return m.SharedShares
 	}
-	return n
+	return nil
 }
-func (m *TeeCall_UploadSecret) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UploadSecret != nil {
-		l = m.UploadSecret.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+// Decrypt resp
+type DecryptResp struct {
+	DkgKey               []byte             `protobuf:"bytes,1,opt,name=dkg_key,json=dkgKey,proto3" json:"dkg_key,omitempty"`
+	Secrets              map[uint64]*Secret `protobuf:"bytes,3,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DiskKeys             map[uint64]*Secret `protobuf:"bytes,4,rep,name=disk_keys,json=diskKeys,proto3" json:"disk_keys,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Shared               []*Secret          `protobuf:"bytes,5,rep,name=shared,proto3" json:"shared,omitempty"`
+	InvalidShares        []*ShareFault      `protobuf:"bytes,6,rep,name=invalid_shares,json=invalidShares,proto3" json:"invalid_shares,omitempty"`
+	Unrecovered          []*ShareFault      `protobuf:"bytes,7,rep,name=unrecovered,proto3" json:"unrecovered,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (m *TeeCall_InitDisk) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.InitDisk != nil {
-		l = m.InitDisk.Size()
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *DecryptResp) Reset()         { *m = DecryptResp{} }
+func (m *DecryptResp) String() string { return proto.CompactTextString(m) }
+func (*DecryptResp) ProtoMessage()    {}
+func (*DecryptResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{56}
+}
+func (m *DecryptResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecryptResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecryptResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
 }
-func (m *PodStart) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DecryptResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecryptResp.Merge(m, src)
+}
+func (m *DecryptResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecryptResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecryptResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecryptResp proto.InternalMessageInfo
+
+func (m *DecryptResp) GetDkgKey() []byte {
+	if m != nil {
+		return m.DkgKey
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return nil
+}
+
+func (m *DecryptResp) GetSecrets() map[uint64]*Secret {
+	if m != nil {
+		return m.Secrets
 	}
-	l = len(m.AppId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *DecryptResp) GetDiskKeys() map[uint64]*Secret {
+	if m != nil {
+		return m.DiskKeys
 	}
-	l = len(m.NameSpace)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *DecryptResp) GetShared() []*Secret {
+	if m != nil {
+		return m.Shared
 	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *DecryptResp) GetInvalidShares() []*ShareFault {
+	if m != nil {
+		return m.InvalidShares
 	}
-	if len(m.Secrets) > 0 {
-		l = 0
-		for _, e := range m.Secrets {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return nil
+}
+
+func (m *DecryptResp) GetUnrecovered() []*ShareFault {
+	if m != nil {
+		return m.Unrecovered
 	}
-	if len(m.Disks) > 0 {
-		l = 0
-		for _, e := range m.Disks {
-			l += sovTx(uint64(e))
+	return nil
+}
+
+// 无效的重加密份额或无法恢复的 secret
+// Invalid re-encryption share or unrecovered secret
+type ShareFault struct {
+	Node                 string   `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Index                uint64   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Replica              uint32   `protobuf:"varint,5,opt,name=replica,proto3" json:"replica,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShareFault) Reset()         { *m = ShareFault{} }
+func (m *ShareFault) String() string { return proto.CompactTextString(m) }
+func (*ShareFault) ProtoMessage()    {}
+func (*ShareFault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{57}
+}
+func (m *ShareFault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShareFault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShareFault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+}
+func (m *ShareFault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareFault.Merge(m, src)
+}
+func (m *ShareFault) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShareFault) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareFault.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareFault proto.InternalMessageInfo
+
+func (m *ShareFault) GetNode() string {
+	if m != nil {
+		return m.Node
 	}
-	return n
+	return ""
 }
 
-func (m *PodMint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ShareFault) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	return ""
+}
+
+func (m *ShareFault) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	l = len(m.ReportHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *ShareFault) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *ShareFault) GetReplica() uint32 {
+	if m != nil {
+		return m.Replica
 	}
-	return n
+	return 0
 }
 
-func (m *BridgeCall) Size() (n int) {
-	if m == nil {
-		return 0
+// 重加密审计记录，每个读者一条
+// Audit record of a completed re-encryption, one per reader
+type SecretAudit struct {
+	ReqId                string          `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	PodId                uint64          `protobuf:"varint,2,opt,name=pod_id,json=podId,proto3" json:"pod_id,omitempty"`
+	Reader               []byte          `protobuf:"bytes,3,opt,name=reader,proto3" json:"reader,omitempty"`
+	NameSpace            []byte          `protobuf:"bytes,4,opt,name=name_space,json=nameSpace,proto3" json:"name_space,omitempty"`
+	Secrets              []uint64        `protobuf:"varint,5,rep,packed,name=secrets,proto3" json:"secrets,omitempty"`
+	Disks                []uint64        `protobuf:"varint,6,rep,packed,name=disks,proto3" json:"disks,omitempty"`
+	Shared               []*SharedSecret `protobuf:"bytes,7,rep,name=shared,proto3" json:"shared,omitempty"`
+	Epoch                uint32          `protobuf:"varint,8,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Validators           []string        `protobuf:"bytes,9,rep,name=validators,proto3" json:"validators,omitempty"`
+	Unrecovered          []*ShareFault   `protobuf:"bytes,10,rep,name=unrecovered,proto3" json:"unrecovered,omitempty"`
+	Height               int64           `protobuf:"varint,11,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SecretAudit) Reset()         { *m = SecretAudit{} }
+func (m *SecretAudit) String() string { return proto.CompactTextString(m) }
+func (*SecretAudit) ProtoMessage()    {}
+func (*SecretAudit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{58}
+}
+func (m *SecretAudit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretAudit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretAudit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Time != 0 {
-		n += 1 + sovTx(uint64(m.Time))
+}
+func (m *SecretAudit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretAudit.Merge(m, src)
+}
+func (m *SecretAudit) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretAudit) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretAudit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretAudit proto.InternalMessageInfo
+
+func (m *SecretAudit) GetReqId() string {
+	if m != nil {
+		return m.ReqId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *SecretAudit) GetPodId() uint64 {
+	if m != nil {
+		return m.PodId
 	}
-	return n
+	return 0
 }
 
-func (m *TeeVerifyResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SecretAudit) GetReader() []byte {
+	if m != nil {
+		return m.Reader
 	}
-	var l int
-	_ = l
-	if m.TeeType != 0 {
-		n += 1 + sovTx(uint64(m.TeeType))
+	return nil
+}
+
+func (m *SecretAudit) GetNameSpace() []byte {
+	if m != nil {
+		return m.NameSpace
 	}
-	l = len(m.CodeSigner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *SecretAudit) GetSecrets() []uint64 {
+	if m != nil {
+		return m.Secrets
 	}
-	l = len(m.CodeSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *SecretAudit) GetDisks() []uint64 {
+	if m != nil {
+		return m.Disks
 	}
-	l = len(m.CodeProductId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *SecretAudit) GetShared() []*SharedSecret {
+	if m != nil {
+		return m.Shared
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func (m *SecretAudit) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
 	}
-	return n
+	return 0
 }
 
-func (m *UploadSecret) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SecretAudit) GetValidators() []string {
+	if m != nil {
+		return m.Validators
 	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Index != 0 {
-		n += 1 + sovTx(uint64(m.Index))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Time != 0 {
-		n += 1 + sovTx(uint64(m.Time))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return nil
 }
 
-func (m *InitDisk) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.User)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Index != 0 {
-		n += 1 + sovTx(uint64(m.Index))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Time != 0 {
-		n += 1 + sovTx(uint64(m.Time))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SecretAudit) GetUnrecovered() []*ShareFault {
+	if m != nil {
+		return m.Unrecovered
 	}
-	return n
+	return nil
 }
 
-func (m *SecretBox) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Payload != nil {
-		n += m.Payload.Size()
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *SecretAudit) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return n
+	return 0
 }
 
-func (m *SecretBox_Req) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+type AuditLog struct {
+	Records              []*SecretAudit `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (m *SecretBox_SharesResp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SharesResp != nil {
-		l = m.SharesResp.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *AuditLog) Reset()         { *m = AuditLog{} }
+func (m *AuditLog) String() string { return proto.CompactTextString(m) }
+func (*AuditLog) ProtoMessage()    {}
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{59}
 }
-func (m *SecretBox_Resp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *AuditLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *SecretStore) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AuditLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.RawEncCmt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *AuditLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLog.Merge(m, src)
+}
+func (m *AuditLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditLog proto.InternalMessageInfo
+
+func (m *AuditLog) GetRecords() []*SecretAudit {
+	if m != nil {
+		return m.Records
 	}
-	if len(m.RawEncScrt) > 0 {
-		for _, b := range m.RawEncScrt {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// 验证节点在 DKG 证明阶段发现的作恶 dealer，保存到状态中用于惩罚
+// Dealers that failed to justify their deals, reported by a validator
+type DealerFaultReport struct {
+	Epoch                uint32         `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Group                string         `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	Faults               []*DealerFault `protobuf:"bytes,3,rep,name=faults,proto3" json:"faults,omitempty"`
+	Reporter             []byte         `protobuf:"bytes,4,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Height               int64          `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DealerFaultReport) Reset()         { *m = DealerFaultReport{} }
+func (m *DealerFaultReport) String() string { return proto.CompactTextString(m) }
+func (*DealerFaultReport) ProtoMessage()    {}
+func (*DealerFaultReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{60}
+}
+func (m *DealerFaultReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DealerFaultReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DealerFaultReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *DealerFaultReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealerFaultReport.Merge(m, src)
+}
+func (m *DealerFaultReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *DealerFaultReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealerFaultReport.DiscardUnknown(m)
 }
 
-func (m *DecryptShare) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_DealerFaultReport proto.InternalMessageInfo
+
+func (m *DealerFaultReport) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
 	}
-	var l int
-	_ = l
-	if m.ShareIndex != 0 {
-		n += 1 + sovTx(uint64(m.ShareIndex))
+	return 0
+}
+
+func (m *DealerFaultReport) GetGroup() string {
+	if m != nil {
+		return m.Group
 	}
-	l = len(m.XncSki)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *DealerFaultReport) GetFaults() []*DealerFault {
+	if m != nil {
+		return m.Faults
 	}
-	l = len(m.Chlgi)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *DealerFaultReport) GetReporter() []byte {
+	if m != nil {
+		return m.Reporter
 	}
-	l = len(m.Proofi)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *DealerFaultReport) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+type DealerFault struct {
+	DealerIndex          uint32   `protobuf:"varint,1,opt,name=dealer_index,json=dealerIndex,proto3" json:"dealer_index,omitempty"`
+	Validator            []byte   `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DealerFault) Reset()         { *m = DealerFault{} }
+func (m *DealerFault) String() string { return proto.CompactTextString(m) }
+func (*DealerFault) ProtoMessage()    {}
+func (*DealerFault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{61}
+}
+func (m *DealerFault) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DealerFault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DealerFault.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DealerFault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DealerFault.Merge(m, src)
+}
+func (m *DealerFault) XXX_Size() int {
+	return m.Size()
+}
+func (m *DealerFault) XXX_DiscardUnknown() {
+	xxx_messageInfo_DealerFault.DiscardUnknown(m)
 }
 
-func (m *DecryptSharesResp) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_DealerFault proto.InternalMessageInfo
+
+func (m *DealerFault) GetDealerIndex() uint32 {
+	if m != nil {
+		return m.DealerIndex
 	}
-	var l int
-	_ = l
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *DealerFault) GetValidator() []byte {
+	if m != nil {
+		return m.Validator
 	}
-	if len(m.SecretShares) > 0 {
-		for k, v := range m.SecretShares {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovTx(uint64(l))
-			}
-			mapEntrySize := 1 + sovTx(uint64(k)) + l
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
-		}
+	return nil
+}
+
+func (m *DealerFault) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.DiskShares) > 0 {
-		for k, v := range m.DiskShares {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovTx(uint64(l))
-			}
-			mapEntrySize := 1 + sovTx(uint64(k)) + l
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return ""
 }
 
-func (m *DecryptResp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DkgKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Secrets) > 0 {
-		for k, v := range m.Secrets {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovTx(uint64(l))
-			}
-			mapEntrySize := 1 + sovTx(uint64(k)) + l
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
-		}
-	}
-	if len(m.DiskKeys) > 0 {
-		for k, v := range m.DiskKeys {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovTx(uint64(l))
-			}
-			mapEntrySize := 1 + sovTx(uint64(k)) + l
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// Decrypted secret
+type Secret struct {
+	XncCmt               []byte   `protobuf:"bytes,1,opt,name=xnc_cmt,json=xncCmt,proto3" json:"xnc_cmt,omitempty"`
+	EncScrt              [][]byte `protobuf:"bytes,2,rep,name=enc_scrt,json=encScrt,proto3" json:"enc_scrt,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Secret) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.XncCmt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.EncScrt) > 0 {
-		for _, b := range m.EncScrt {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+func (m *Secret) Reset()         { *m = Secret{} }
+func (m *Secret) String() string { return proto.CompactTextString(m) }
+func (*Secret) ProtoMessage()    {}
+func (*Secret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{62}
+}
+func (m *Secret) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Secret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Secret.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *Secret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Secret.Merge(m, src)
+}
+func (m *Secret) XXX_Size() int {
+	return m.Size()
+}
+func (m *Secret) XXX_DiscardUnknown() {
+	xxx_messageInfo_Secret.DiscardUnknown(m)
 }
 
-func (m *TeeTrigger) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_Secret proto.InternalMessageInfo
+
+func (m *Secret) GetXncCmt() []byte {
+	if m != nil {
+		return m.XncCmt
 	}
-	var l int
-	_ = l
-	if m.Tee != nil {
-		l = m.Tee.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *Secret) GetEncScrt() [][]byte {
+	if m != nil {
+		return m.EncScrt
 	}
-	if m.ClusterId != 0 {
-		n += 1 + sovTx(uint64(m.ClusterId))
+	return nil
+}
+
+func (m *Secret) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	if len(m.Callids) > 0 {
-		l = 0
-		for _, e := range m.Callids {
-			l += sovTx(uint64(e))
+	return nil
+}
+
+type TeeTrigger struct {
+	Tee                  *TeeCall `protobuf:"bytes,1,opt,name=tee,proto3" json:"tee,omitempty"`
+	ClusterId            uint64   `protobuf:"varint,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Callids              []uint64 `protobuf:"varint,3,rep,packed,name=callids,proto3" json:"callids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TeeTrigger) Reset()         { *m = TeeTrigger{} }
+func (m *TeeTrigger) String() string { return proto.CompactTextString(m) }
+func (*TeeTrigger) ProtoMessage()    {}
+func (*TeeTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{63}
+}
+func (m *TeeTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TeeTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TeeTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return n
+}
+func (m *TeeTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TeeTrigger.Merge(m, src)
+}
+func (m *TeeTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *TeeTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_TeeTrigger.DiscardUnknown(m)
 }
 
-func (m *ApiReq) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var xxx_messageInfo_TeeTrigger proto.InternalMessageInfo
+
+func (m *TeeTrigger) GetTee() *TeeCall {
+	if m != nil {
+		return m.Tee
 	}
-	return n
+	return nil
 }
 
-func (m *ApiResp) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Code != 0 {
-		n += 1 + sovTx(uint64(m.Code))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *TeeTrigger) GetClusterId() uint64 {
+	if m != nil {
+		return m.ClusterId
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return 0
+}
+
+func (m *TeeTrigger) GetCallids() []uint64 {
+	if m != nil {
+		return m.Callids
 	}
-	return n
+	return nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// api req
+type ApiReq struct {
+	Url                  []byte   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	From                 []byte   `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ApiReq) Reset()         { *m = ApiReq{} }
+func (m *ApiReq) String() string { return proto.CompactTextString(m) }
+func (*ApiReq) ProtoMessage()    {}
+func (*ApiReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{64}
 }
-func (m *TxBox) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *ApiReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApiReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApiReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApiReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiReq.Merge(m, src)
+}
+func (m *ApiReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApiReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiReq proto.InternalMessageInfo
+
+func (m *ApiReq) GetUrl() []byte {
+	if m != nil {
+		return m.Url
+	}
+	return nil
+}
+
+func (m *ApiReq) GetFrom() []byte {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *ApiReq) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// api resp
+type ApiResp struct {
+	Code                 int32    `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiResp) Reset()         { *m = ApiResp{} }
+func (m *ApiResp) String() string { return proto.CompactTextString(m) }
+func (*ApiResp) ProtoMessage()    {}
+func (*ApiResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fd2153dc07d3b5c, []int{65}
+}
+func (m *ApiResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApiResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApiResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApiResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiResp.Merge(m, src)
+}
+func (m *ApiResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApiResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiResp proto.InternalMessageInfo
+
+func (m *ApiResp) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ApiResp) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TxBox)(nil), "model.TxBox")
+	proto.RegisterType((*Tx)(nil), "model.Tx")
+	proto.RegisterType((*SideValidator)(nil), "model.SideValidator")
+	proto.RegisterType((*EpochEnd)(nil), "model.EpochEnd")
+	proto.RegisterType((*Bridge)(nil), "model.Bridge")
+	proto.RegisterType((*HubCall)(nil), "model.HubCall")
+	proto.RegisterType((*DkgMessage)(nil), "model.DkgMessage")
+	proto.RegisterType((*BlockPartialSign)(nil), "model.BlockPartialSign")
+	proto.RegisterType((*To)(nil), "model.To")
+	proto.RegisterType((*Nodes)(nil), "model.Nodes")
+	proto.RegisterType((*P2PEnvelope)(nil), "model.P2PEnvelope")
+	proto.RegisterType((*TeeCall)(nil), "model.TeeCall")
+	proto.RegisterType((*PodStart)(nil), "model.PodStart")
+	proto.RegisterType((*SharedSecret)(nil), "model.SharedSecret")
+	proto.RegisterType((*PodMint)(nil), "model.PodMint")
+	proto.RegisterType((*BridgeCall)(nil), "model.BridgeCall")
+	proto.RegisterType((*TeeVerifyResult)(nil), "model.TeeVerifyResult")
+	proto.RegisterType((*UploadSecret)(nil), "model.UploadSecret")
+	proto.RegisterType((*InitDisk)(nil), "model.InitDisk")
+	proto.RegisterType((*GrantSecret)(nil), "model.GrantSecret")
+	proto.RegisterType((*RevokeSecret)(nil), "model.RevokeSecret")
+	proto.RegisterType((*SealDisclosure)(nil), "model.SealDisclosure")
+	proto.RegisterType((*DisclosureCondition)(nil), "model.DisclosureCondition")
+	proto.RegisterType((*ReleaseDisclosure)(nil), "model.ReleaseDisclosure")
+	proto.RegisterType((*Disclosure)(nil), "model.Disclosure")
+	proto.RegisterType((*DisclosureShare)(nil), "model.DisclosureShare")
+	proto.RegisterType((*EncryptedTx)(nil), "model.EncryptedTx")
+	proto.RegisterType((*EncryptedTxShare)(nil), "model.EncryptedTxShare")
+	proto.RegisterType((*ThresholdPolicy)(nil), "model.ThresholdPolicy")
+	proto.RegisterType((*SecretSweepConfig)(nil), "model.SecretSweepConfig")
+	proto.RegisterType((*BeaconShare)(nil), "model.BeaconShare")
+	proto.RegisterType((*BeaconRound)(nil), "model.BeaconRound")
+	proto.RegisterType((*Beacon)(nil), "model.Beacon")
+	proto.RegisterType((*ShareRefresh)(nil), "model.ShareRefresh")
+	proto.RegisterType((*RoundAck)(nil), "model.RoundAck")
+	proto.RegisterType((*KeyGroup)(nil), "model.KeyGroup")
+	proto.RegisterType((*KeyGroupEpoch)(nil), "model.KeyGroupEpoch")
+	proto.RegisterType((*SecretBox)(nil), "model.SecretBox")
+	proto.RegisterType((*BlobBox)(nil), "model.BlobBox")
+	proto.RegisterType((*BlobResp)(nil), "model.BlobResp")
+	proto.RegisterType((*ThresholdSign)(nil), "model.ThresholdSign")
+	proto.RegisterType((*SignBox)(nil), "model.SignBox")
+	proto.RegisterType((*SignCommit)(nil), "model.SignCommit")
+	proto.RegisterType((*SignRound)(nil), "model.SignRound")
+	proto.RegisterType((*SignPartial)(nil), "model.SignPartial")
+	proto.RegisterType((*SecretStore)(nil), "model.SecretStore")
+	proto.RegisterType((*SecretMigrate)(nil), "model.SecretMigrate")
+	proto.RegisterType((*MigratedSecret)(nil), "model.MigratedSecret")
+	proto.RegisterType((*KeySwitchMember)(nil), "model.KeySwitchMember")
+	proto.RegisterType((*KeySwitchShare)(nil), "model.KeySwitchShare")
+	proto.RegisterType((*SecretMigrateReq)(nil), "model.SecretMigrateReq")
+	proto.RegisterType((*SecretMigrateShares)(nil), "model.SecretMigrateShares")
+	proto.RegisterType((*SecretWindow)(nil), "model.SecretWindow")
+	proto.RegisterType((*DecryptShare)(nil), "model.DecryptShare")
+	proto.RegisterType((*DecryptSharesResp)(nil), "model.DecryptSharesResp")
+	proto.RegisterMapType((map[uint64]*DecryptShare)(nil), "model.DecryptSharesResp.DiskSharesEntry")
+	proto.RegisterMapType((map[uint64]*DecryptShare)(nil), "model.DecryptSharesResp.SecretSharesEntry")
+	proto.RegisterType((*ReplicaShares)(nil), "model.ReplicaShares")
+	proto.RegisterMapType((map[uint64]*DecryptShare)(nil), "model.ReplicaShares.DiskSharesEntry")
+	proto.RegisterMapType((map[uint64]*DecryptShare)(nil), "model.ReplicaShares.SecretSharesEntry")
+	proto.RegisterType((*DecryptResp)(nil), "model.DecryptResp")
+	proto.RegisterMapType((map[uint64]*Secret)(nil), "model.DecryptResp.DiskKeysEntry")
+	proto.RegisterMapType((map[uint64]*Secret)(nil), "model.DecryptResp.SecretsEntry")
+	proto.RegisterType((*ShareFault)(nil), "model.ShareFault")
+	proto.RegisterType((*SecretAudit)(nil), "model.SecretAudit")
+	proto.RegisterType((*AuditLog)(nil), "model.AuditLog")
+	proto.RegisterType((*DealerFaultReport)(nil), "model.DealerFaultReport")
+	proto.RegisterType((*DealerFault)(nil), "model.DealerFault")
+	proto.RegisterType((*Secret)(nil), "model.Secret")
+	proto.RegisterType((*TeeTrigger)(nil), "model.TeeTrigger")
+	proto.RegisterType((*ApiReq)(nil), "model.ApiReq")
+	proto.RegisterType((*ApiResp)(nil), "model.ApiResp")
+}
+
+func init() { proto.RegisterFile("tx.proto", fileDescriptor_0fd2153dc07d3b5c) }
+
+var fileDescriptor_0fd2153dc07d3b5c = []byte{
+	// 3857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4d, 0x8f, 0xdb, 0x48,
+	0x76, 0x4d, 0x89, 0xa2, 0xa8, 0x47, 0xa9, 0x3f, 0xca, 0x1f, 0x43, 0x7b, 0x9d, 0x76, 0x0f, 0x3d,
+	0x5e, 0x78, 0xec, 0xa4, 0xe3, 0x78, 0x37, 0x88, 0x67, 0x82, 0x09, 0xd6, 0xdd, 0xf6, 0xba, 0x65,
+	0xc7, 0x93, 0x06, 0xbb, 0x33, 0x01, 0x72, 0x21, 0x28, 0xb2, 0x5a, 0x62, 0x24, 0x91, 0x34, 0x49,
+	0x75, 0x4b, 0x93, 0x60, 0x2f, 0x41, 0xb0, 0x40, 0x2e, 0x49, 0x80, 0x3d, 0x05, 0x01, 0xf2, 0x03,
+	0x92, 0x4b, 0xfe, 0x43, 0x0e, 0xb9, 0x2c, 0x10, 0x04, 0xf9, 0x01, 0xc1, 0xdc, 0xe6, 0x92, 0x73,
+	0x4e, 0x83, 0xe0, 0xd5, 0x07, 0x59, 0x54, 0x4b, 0x1e, 0xdb, 0x33, 0x13, 0x20, 0xb7, 0x7a, 0xaf,
+	0x5e, 0x55, 0xbd, 0x7a, 0xaf, 0xde, 0x47, 0xbd, 0x2a, 0x30, 0x8b, 0xf9, 0x7e, 0x9a, 0x25, 0x45,
+	0x42, 0x5a, 0xd3, 0x24, 0xa4, 0x13, 0xe7, 0x00, 0x5a, 0xa7, 0xf3, 0x83, 0x64, 0x4e, 0x3e, 0x80,
+	0x76, 0x41, 0xa9, 0x97, 0x47, 0x43, 0x5b, 0xdb, 0xd3, 0xee, 0x75, 0x5d, 0xa3, 0xa0, 0xf4, 0x24,
+	0x1a, 0x92, 0x6d, 0x68, 0x26, 0xd9, 0xd0, 0x6e, 0x30, 0x24, 0x36, 0xc9, 0x26, 0x34, 0x8a, 0xb9,
+	0xdd, 0x64, 0x88, 0x46, 0x31, 0x77, 0xfe, 0xc7, 0x84, 0xc6, 0xe9, 0x9c, 0x5c, 0x87, 0x16, 0x9d,
+	0xa6, 0xc5, 0xc2, 0x0e, 0xf6, 0xb4, 0x7b, 0xcd, 0xa3, 0x0d, 0x97, 0x83, 0x64, 0x1f, 0x3a, 0x34,
+	0x4d, 0x82, 0x91, 0x47, 0xe3, 0x90, 0xcd, 0x6d, 0x3d, 0xda, 0xda, 0x67, 0xab, 0xef, 0x3f, 0x43,
+	0xfc, 0xb3, 0x38, 0x3c, 0xda, 0x70, 0x4d, 0x2a, 0xda, 0xe4, 0x43, 0xb0, 0x38, 0x7d, 0x5e, 0xf8,
+	0x59, 0x61, 0x37, 0xc4, 0x6c, 0xc0, 0x90, 0x27, 0x88, 0x23, 0x0f, 0xc0, 0x1c, 0xcd, 0x06, 0x5e,
+	0xe0, 0x4f, 0x26, 0xb6, 0xce, 0x66, 0xdc, 0x14, 0x33, 0x1e, 0xcd, 0x06, 0x87, 0xfe, 0x64, 0x72,
+	0xb4, 0xe1, 0xb6, 0x47, 0xbc, 0x49, 0x3e, 0x82, 0x5e, 0xbe, 0x88, 0x03, 0xaf, 0x98, 0x8b, 0x19,
+	0x5b, 0x62, 0x46, 0x0b, 0xd1, 0xa7, 0x73, 0x3e, 0xe5, 0x1e, 0x58, 0x92, 0x0a, 0xf9, 0x34, 0x04,
+	0x4d, 0x87, 0xd3, 0x20, 0x5f, 0xca, 0x3c, 0x19, 0x2d, 0xb2, 0x85, 0xdd, 0xae, 0xcf, 0xe3, 0x22,
+	0x92, 0xfc, 0x08, 0xcc, 0xd0, 0x4f, 0x38, 0x6b, 0x26, 0x8a, 0x08, 0x59, 0x09, 0xfd, 0x84, 0xb1,
+	0xb2, 0x0f, 0x1d, 0x7f, 0x16, 0x46, 0x85, 0x37, 0x49, 0x86, 0x76, 0xa7, 0x26, 0x8a, 0x27, 0x88,
+	0xff, 0xc3, 0x64, 0x88, 0xa2, 0xf0, 0x45, 0x9b, 0x1c, 0xc2, 0x76, 0x18, 0xe5, 0xc1, 0x24, 0xc9,
+	0x67, 0x19, 0xf5, 0xf2, 0x91, 0x9f, 0x51, 0xbb, 0xcb, 0x86, 0x5d, 0x17, 0xc3, 0x9e, 0x96, 0xdd,
+	0x27, 0xd8, 0x7b, 0xb4, 0xe1, 0x6e, 0x85, 0x75, 0x14, 0xf9, 0x3d, 0xe8, 0xd2, 0x38, 0xc8, 0x16,
+	0x69, 0x41, 0x43, 0xaf, 0x98, 0xdb, 0x3d, 0x36, 0x01, 0x11, 0x13, 0x9c, 0xd0, 0x20, 0xa3, 0xc5,
+	0x49, 0x91, 0xb0, 0xc1, 0x56, 0x49, 0x79, 0x3a, 0x27, 0xcf, 0x81, 0xa8, 0x03, 0xc5, 0xfa, 0x9b,
+	0x6c, 0xf8, 0x07, 0x52, 0x83, 0x15, 0xbd, 0x64, 0x60, 0x9b, 0x2e, 0xe1, 0x90, 0x83, 0x01, 0xf5,
+	0x83, 0x24, 0x16, 0x53, 0x6c, 0xd5, 0x38, 0x38, 0x60, 0x5d, 0x72, 0xb4, 0x35, 0xa8, 0x40, 0xdc,
+	0x7f, 0x31, 0xca, 0x68, 0x3e, 0x4a, 0x26, 0xa1, 0x97, 0x26, 0x93, 0x28, 0x58, 0xd8, 0xdb, 0xb5,
+	0xfd, 0x9f, 0xca, 0xee, 0x63, 0xd6, 0x8b, 0xfb, 0x2f, 0xea, 0x28, 0xf2, 0x29, 0xf4, 0xd8, 0xb2,
+	0x5e, 0x46, 0xcf, 0xb0, 0xc7, 0xde, 0x61, 0x33, 0x5c, 0x91, 0x02, 0xc0, 0x3e, 0x97, 0x77, 0x1d,
+	0x6d, 0xb8, 0xdd, 0x5c, 0x81, 0xc9, 0x43, 0xb8, 0x52, 0x1b, 0x2b, 0x4e, 0x10, 0x11, 0x9a, 0xdf,
+	0x51, 0x89, 0xf9, 0x39, 0xda, 0x87, 0xce, 0x98, 0x2e, 0xbc, 0x61, 0x96, 0xcc, 0x52, 0xfb, 0x4a,
+	0x4d, 0xc5, 0x2f, 0xe9, 0xe2, 0x39, 0xa2, 0x51, 0xc5, 0x63, 0xd1, 0x26, 0x7f, 0x00, 0x5b, 0x25,
+	0xbd, 0xc7, 0x8e, 0xb8, 0x7d, 0x95, 0x8d, 0xba, 0xba, 0x34, 0x8a, 0xd9, 0xca, 0xd1, 0x86, 0xdb,
+	0x1b, 0xab, 0x08, 0xf2, 0x19, 0x74, 0x43, 0xea, 0x4f, 0x68, 0xe6, 0x9d, 0xf9, 0xb3, 0x49, 0x61,
+	0x5f, 0x63, 0x83, 0x6d, 0x79, 0x3c, 0x58, 0xd7, 0xcf, 0xb1, 0xc7, 0xa5, 0x69, 0x92, 0x15, 0x28,
+	0xe1, 0xb0, 0x42, 0x92, 0xcf, 0x60, 0x33, 0x67, 0x27, 0xc0, 0x9b, 0x46, 0xc3, 0xcc, 0x2f, 0xa8,
+	0x7d, 0xbd, 0xb6, 0x3a, 0x3f, 0x1e, 0xaf, 0x78, 0x1f, 0xae, 0x9e, 0xab, 0x08, 0x5c, 0x5d, 0x0c,
+	0xcf, 0x2f, 0x28, 0x4d, 0xed, 0x0f, 0x6a, 0xab, 0x8b, 0xb3, 0x85, 0x3d, 0x87, 0x49, 0x7c, 0x16,
+	0x0d, 0x99, 0xb1, 0x54, 0x48, 0x72, 0x1d, 0x0c, 0x34, 0x14, 0x9a, 0xd9, 0xc0, 0x7d, 0x0e, 0x87,
+	0xc8, 0x2d, 0xe8, 0xe4, 0xd1, 0x30, 0xf6, 0x8b, 0x59, 0x46, 0x6d, 0x8b, 0x75, 0x55, 0x88, 0x83,
+	0x0e, 0xb4, 0x53, 0x7f, 0x31, 0x49, 0xfc, 0xd0, 0x39, 0x85, 0xde, 0x49, 0x14, 0xd2, 0x2f, 0xfc,
+	0x49, 0x14, 0xfa, 0x45, 0x92, 0xe1, 0x8c, 0xe9, 0x6c, 0x30, 0xa6, 0x0b, 0xe9, 0xc5, 0x38, 0x44,
+	0xae, 0x42, 0x2b, 0x4d, 0x2e, 0x68, 0xc6, 0xdd, 0x89, 0xcb, 0x01, 0x72, 0x0d, 0x8c, 0xf4, 0x51,
+	0xea, 0x45, 0xa1, 0xf0, 0x66, 0xad, 0xf4, 0x51, 0xda, 0x0f, 0x9d, 0xbf, 0xd5, 0xc0, 0x94, 0xae,
+	0x09, 0x47, 0x72, 0xb5, 0xe0, 0x84, 0x3d, 0x97, 0x03, 0xe4, 0xa7, 0x00, 0xe7, 0x72, 0xd1, 0xdc,
+	0x6e, 0xec, 0x35, 0x55, 0x99, 0xa9, 0x1c, 0xb9, 0x0a, 0x1d, 0x3a, 0xd9, 0x70, 0x3c, 0xf4, 0xd2,
+	0xd9, 0x40, 0x2c, 0x68, 0x84, 0xe3, 0xe1, 0xf1, 0x6c, 0x40, 0x6e, 0x83, 0x85, 0x1d, 0x41, 0x32,
+	0x9d, 0x46, 0x45, 0xce, 0x7c, 0x5a, 0xd7, 0x85, 0x70, 0x3c, 0x3c, 0xe4, 0x18, 0xe7, 0x13, 0x30,
+	0x0e, 0xb2, 0x28, 0x1c, 0x52, 0xe4, 0x79, 0x9a, 0x0f, 0x91, 0x67, 0x64, 0xa8, 0xe3, 0xb6, 0xa6,
+	0xf9, 0xb0, 0x1f, 0x12, 0xbb, 0x14, 0x8a, 0x70, 0xd5, 0xa5, 0x8c, 0x8e, 0xa0, 0x2d, 0xbc, 0x22,
+	0xb9, 0x01, 0x66, 0x30, 0xf2, 0xa3, 0x58, 0x8e, 0xee, 0xb9, 0x6d, 0x06, 0xf7, 0x43, 0xe2, 0x80,
+	0xce, 0x7c, 0x16, 0xdf, 0x8a, 0x74, 0xa7, 0xa7, 0x94, 0xe2, 0x40, 0x97, 0xf5, 0x39, 0xff, 0xac,
+	0x01, 0x3c, 0x1d, 0x0f, 0x5f, 0xd1, 0x3c, 0xf7, 0x87, 0x94, 0x10, 0xd0, 0xcf, 0xb2, 0x64, 0x2a,
+	0xf8, 0x60, 0x6d, 0x72, 0x03, 0x1a, 0x45, 0xc2, 0x38, 0xb0, 0x1e, 0x75, 0xe4, 0x24, 0x89, 0xdb,
+	0x28, 0x12, 0x85, 0xf1, 0xe6, 0x1a, 0xc6, 0xf5, 0x1a, 0xe3, 0x4c, 0xf2, 0x59, 0x96, 0x64, 0xcc,
+	0x61, 0x77, 0x5c, 0x0e, 0xe0, 0xaa, 0xc5, 0x22, 0xa5, 0xcc, 0x43, 0x77, 0x5c, 0xd6, 0x46, 0x4a,
+	0x6e, 0x70, 0x6d, 0x4e, 0xc9, 0x00, 0x67, 0x06, 0xdb, 0x07, 0x93, 0x24, 0x18, 0x1f, 0xfb, 0x59,
+	0x11, 0xf9, 0x93, 0x93, 0x68, 0x18, 0xbf, 0x2b, 0xcf, 0x37, 0x30, 0x62, 0x7a, 0x51, 0x1c, 0x52,
+	0x1e, 0xf0, 0x9a, 0x6e, 0xbb, 0x98, 0xf7, 0x11, 0x44, 0x5d, 0x62, 0x0c, 0xc2, 0x80, 0xc9, 0xf9,
+	0x36, 0x46, 0xb3, 0xc1, 0x49, 0x34, 0x74, 0xc6, 0xd0, 0x38, 0x4d, 0xc8, 0x2e, 0x74, 0x06, 0x59,
+	0xe2, 0x87, 0x81, 0x9f, 0x17, 0x6c, 0x35, 0x13, 0xa3, 0x49, 0x89, 0x22, 0x1f, 0x41, 0x2b, 0x4e,
+	0x42, 0x9a, 0x8b, 0x75, 0xbb, 0x62, 0xdd, 0xcf, 0x11, 0x87, 0xb1, 0x93, 0x75, 0x92, 0xab, 0xa0,
+	0x63, 0x83, 0x9f, 0x96, 0xa3, 0x0d, 0x97, 0x41, 0xaa, 0x01, 0x5c, 0x83, 0x16, 0x1b, 0x42, 0xba,
+	0xa0, 0x71, 0xe5, 0x75, 0x5d, 0x6d, 0xe2, 0xfc, 0xa3, 0x06, 0xd6, 0xf1, 0xa3, 0xe3, 0x67, 0xf1,
+	0x39, 0x9d, 0x24, 0x69, 0x5d, 0x55, 0x5d, 0xb1, 0xed, 0x5b, 0xd0, 0x29, 0xa2, 0x29, 0xcd, 0x0b,
+	0x7f, 0x9a, 0x0a, 0xb3, 0xa8, 0x10, 0x28, 0xd2, 0x38, 0x89, 0x03, 0x2a, 0x2d, 0x83, 0x01, 0xa8,
+	0xac, 0x60, 0xe4, 0xc7, 0x31, 0xe5, 0x71, 0xb7, 0xe7, 0x4a, 0x10, 0xd3, 0x84, 0x69, 0x3e, 0x64,
+	0xaa, 0xea, 0xba, 0xd8, 0xac, 0x1b, 0xb1, 0xb1, 0x64, 0xc4, 0xce, 0xbf, 0xb6, 0xa0, 0x2d, 0x4e,
+	0x97, 0xe2, 0x06, 0xb4, 0x9a, 0x1b, 0x40, 0x55, 0x47, 0x53, 0x2a, 0x98, 0x63, 0x6d, 0xa6, 0x11,
+	0x4a, 0x3d, 0x76, 0x04, 0x9a, 0x9c, 0x85, 0x82, 0xd2, 0x53, 0x3c, 0x05, 0xd7, 0xc1, 0xc8, 0x98,
+	0x93, 0x93, 0x0a, 0xe1, 0x10, 0xba, 0xe4, 0x34, 0x09, 0x95, 0xe0, 0x5f, 0xb9, 0xe4, 0xe3, 0x24,
+	0x64, 0x6e, 0x1b, 0x5d, 0x72, 0x9a, 0x84, 0x65, 0x76, 0x81, 0xf4, 0xd3, 0x28, 0x2e, 0x18, 0xdf,
+	0x95, 0x39, 0x1c, 0x27, 0xe1, 0xab, 0x28, 0x46, 0xea, 0x76, 0xca, 0x9b, 0xe4, 0xa7, 0x60, 0x0d,
+	0x98, 0x61, 0xf2, 0x90, 0xdf, 0x66, 0xf4, 0x3b, 0x32, 0xb4, 0xb1, 0x1e, 0x91, 0x90, 0xc0, 0xa0,
+	0x84, 0x50, 0xaf, 0x05, 0x9d, 0x17, 0x65, 0x86, 0xc0, 0x20, 0x8c, 0x54, 0xb3, 0x14, 0xd5, 0xea,
+	0x71, 0x27, 0x69, 0x77, 0x6a, 0x91, 0xea, 0x8f, 0x59, 0x1f, 0x77, 0xaa, 0x18, 0xa9, 0x66, 0x0a,
+	0x8c, 0x9b, 0x8c, 0xe2, 0xa8, 0xf0, 0xc2, 0x28, 0x1f, 0xdb, 0x50, 0xdb, 0x64, 0x3f, 0x8e, 0x8a,
+	0xa7, 0x51, 0x3e, 0xc6, 0x4d, 0x46, 0xa2, 0x8d, 0x31, 0x79, 0x98, 0xf9, 0x71, 0x21, 0x97, 0xb2,
+	0x6a, 0x31, 0xf9, 0x39, 0x76, 0x95, 0x2b, 0x59, 0xc3, 0x0a, 0x44, 0x26, 0x33, 0x7a, 0x9e, 0x8c,
+	0xa9, 0x1c, 0xd9, 0xad, 0x31, 0xe9, 0xb2, 0xbe, 0x8a, 0xc9, 0x4c, 0x81, 0x31, 0xda, 0x54, 0xf1,
+	0x1c, 0xcf, 0x82, 0x48, 0x46, 0xae, 0x2e, 0x47, 0x73, 0xb4, 0x55, 0x8c, 0x36, 0x85, 0x8a, 0x20,
+	0x3f, 0x83, 0xad, 0x9c, 0xfa, 0x13, 0xaf, 0xca, 0x70, 0x44, 0x36, 0x72, 0xad, 0x0c, 0x38, 0xfe,
+	0xa4, 0xca, 0x88, 0x8e, 0x36, 0xdc, 0xcd, 0xbc, 0x86, 0x21, 0x7d, 0x20, 0x19, 0x9d, 0x50, 0x3f,
+	0xa7, 0xea, 0x24, 0x5b, 0xb5, 0xa8, 0xe5, 0x72, 0x82, 0xda, 0x3c, 0x3b, 0xd9, 0x32, 0xf2, 0x40,
+	0xc7, 0x2c, 0xd8, 0xf9, 0x5a, 0x03, 0x53, 0x1e, 0x22, 0x4c, 0x8c, 0x85, 0x63, 0xd5, 0xdd, 0x46,
+	0x14, 0xa2, 0xc7, 0xf3, 0x53, 0x16, 0x5e, 0xb8, 0x4b, 0x6e, 0xf9, 0x69, 0xda, 0x0f, 0xc9, 0x6f,
+	0x00, 0xc4, 0xfe, 0x94, 0x7a, 0x79, 0xea, 0x97, 0xf6, 0xd5, 0x41, 0xcc, 0x09, 0x22, 0xd0, 0xb1,
+	0xa4, 0xb3, 0x81, 0x87, 0x31, 0x4c, 0x2f, 0x63, 0xd8, 0x4b, 0xba, 0x40, 0xe3, 0xe3, 0x22, 0xcf,
+	0xed, 0xd6, 0x5e, 0xf3, 0x9e, 0xee, 0x4a, 0x10, 0x8d, 0x15, 0xf5, 0x9e, 0xdb, 0x06, 0xc3, 0x73,
+	0x80, 0x3c, 0x00, 0x83, 0xe5, 0x27, 0xa1, 0xdd, 0xde, 0x6b, 0x2a, 0x2a, 0x62, 0x19, 0x8f, 0x38,
+	0x37, 0xae, 0x20, 0x21, 0x1f, 0x42, 0x37, 0xa3, 0xe9, 0x24, 0x0a, 0x7c, 0x5c, 0x39, 0xb7, 0x4d,
+	0xe6, 0x4a, 0x2c, 0x81, 0x7b, 0x49, 0x17, 0xb9, 0xf3, 0x39, 0x74, 0xd5, 0xa1, 0xb8, 0x6a, 0x72,
+	0x11, 0x97, 0x56, 0xcb, 0x01, 0xc4, 0x72, 0x7f, 0xd9, 0x60, 0x72, 0xe0, 0x00, 0x9a, 0x32, 0x3b,
+	0x99, 0xb8, 0x5b, 0xd3, 0x65, 0x6d, 0xe7, 0x13, 0x68, 0x0b, 0x83, 0x42, 0xc9, 0xf5, 0x4b, 0xc9,
+	0xf5, 0x43, 0xb2, 0x0b, 0xc0, 0x8d, 0xf7, 0xc8, 0xcf, 0x47, 0x42, 0x44, 0x0a, 0xc6, 0xd9, 0x03,
+	0xa8, 0x6c, 0xab, 0xf4, 0x13, 0x5a, 0xe5, 0x27, 0x9c, 0x7f, 0xd0, 0x60, 0xeb, 0x94, 0xd2, 0x2f,
+	0x68, 0x16, 0x9d, 0x2d, 0x5c, 0x9a, 0x63, 0xb2, 0xa3, 0xfa, 0x0e, 0xad, 0xee, 0x3b, 0x6e, 0x83,
+	0x15, 0x24, 0x21, 0xbb, 0xff, 0xc4, 0x22, 0x4b, 0xe8, 0xba, 0x80, 0xa8, 0x13, 0x86, 0x21, 0x77,
+	0x61, 0xb3, 0x24, 0xe0, 0x2e, 0x8d, 0x73, 0xd5, 0x93, 0x34, 0x0c, 0x49, 0x7e, 0x0c, 0x5b, 0x8c,
+	0x2c, 0xcd, 0x92, 0x70, 0x16, 0x14, 0xa8, 0x7b, 0xbd, 0xa2, 0x3b, 0xe6, 0xd8, 0x7e, 0xe8, 0xfc,
+	0x8d, 0x06, 0x5d, 0xd5, 0x9e, 0x71, 0x0f, 0xb3, 0xbc, 0x94, 0x25, 0x6b, 0xbf, 0x41, 0x94, 0x7e,
+	0xe1, 0x8b, 0xf5, 0x59, 0xbb, 0x94, 0x80, 0xce, 0x08, 0x59, 0x1b, 0x71, 0x23, 0x94, 0x1e, 0x77,
+	0xc9, 0xac, 0x8d, 0x12, 0xc0, 0x6c, 0x53, 0x09, 0xa0, 0xed, 0x31, 0x5d, 0xa0, 0x04, 0x9c, 0x14,
+	0x4c, 0xe9, 0x28, 0xfe, 0x6f, 0x98, 0x71, 0xfe, 0x45, 0x03, 0x4b, 0x71, 0x34, 0xdf, 0xf5, 0x3c,
+	0xa1, 0x7d, 0x30, 0x47, 0x45, 0xa9, 0xcc, 0x24, 0x04, 0x88, 0x91, 0x81, 0xce, 0xd3, 0x28, 0xa3,
+	0x6c, 0x7d, 0xdd, 0x15, 0x50, 0xc9, 0xa9, 0xa1, 0x70, 0x5a, 0x0b, 0x5b, 0xed, 0xe5, 0xb0, 0xf5,
+	0xf7, 0x1a, 0x74, 0x55, 0x17, 0xf7, 0x03, 0x32, 0x2d, 0x99, 0x6b, 0xad, 0x63, 0xee, 0x52, 0x4c,
+	0xfd, 0x4f, 0x0d, 0x36, 0xeb, 0x2e, 0xf0, 0x9d, 0xd8, 0xbb, 0x0f, 0x86, 0x70, 0xe9, 0xcd, 0x75,
+	0x57, 0x44, 0x57, 0x50, 0x90, 0xc7, 0xd0, 0x09, 0x92, 0x38, 0x8c, 0x8a, 0x28, 0x89, 0xc5, 0x15,
+	0xfc, 0xe6, 0xa5, 0x2b, 0xe9, 0xa1, 0xa4, 0x70, 0x2b, 0xe2, 0xf7, 0xd8, 0xd6, 0x5f, 0x6a, 0x70,
+	0x65, 0xc5, 0xa4, 0xe4, 0x0e, 0x74, 0xf1, 0xaa, 0x9d, 0x66, 0x49, 0x9a, 0xe4, 0xfe, 0x84, 0x9b,
+	0x34, 0xbb, 0xe0, 0xf8, 0xc9, 0xb1, 0x40, 0x12, 0x1b, 0x8c, 0x11, 0x8d, 0x86, 0x23, 0x5e, 0x48,
+	0xd0, 0x8f, 0x36, 0x5c, 0x01, 0x93, 0xbb, 0xd0, 0x63, 0xd2, 0xf0, 0x84, 0x6f, 0xe7, 0x6a, 0xc1,
+	0x98, 0xc5, 0xd0, 0x22, 0x0c, 0x1c, 0x18, 0xa0, 0x8f, 0xa3, 0x38, 0x74, 0x5e, 0xc3, 0xce, 0xa5,
+	0xc8, 0xf0, 0xae, 0xda, 0x67, 0x1b, 0x6f, 0xae, 0xdb, 0xb8, 0xbe, 0xbc, 0xf1, 0x5f, 0x35, 0x00,
+	0x94, 0xc5, 0x3e, 0x06, 0x1d, 0xc3, 0x99, 0xad, 0xbd, 0x21, 0xe6, 0xb9, 0x8c, 0x04, 0x0f, 0x7c,
+	0x5e, 0xf8, 0xc5, 0x8c, 0xa7, 0x97, 0x3d, 0x57, 0x40, 0xdc, 0xcb, 0xfb, 0xe1, 0xc2, 0x13, 0x32,
+	0xe1, 0x39, 0xad, 0xc5, 0x70, 0x47, 0x5c, 0x2c, 0x1f, 0x97, 0x35, 0x07, 0x1a, 0x4a, 0x32, 0x9d,
+	0x91, 0x6d, 0x95, 0x78, 0x41, 0x7a, 0x0b, 0x3a, 0xe9, 0xc4, 0x8f, 0x62, 0x96, 0xca, 0x70, 0xcb,
+	0xae, 0x10, 0x55, 0xfa, 0x6e, 0xa8, 0xe9, 0x7b, 0x79, 0x9d, 0x6a, 0xab, 0xd7, 0x29, 0x19, 0xaa,
+	0x78, 0xdc, 0xa9, 0x42, 0xd5, 0x53, 0xca, 0x4a, 0x09, 0xfc, 0x8e, 0x2e, 0x48, 0x9c, 0x5f, 0xc0,
+	0xd6, 0x52, 0xd9, 0xe3, 0x9d, 0xf4, 0x50, 0x72, 0xd0, 0x54, 0x39, 0xf8, 0x18, 0x5a, 0x6c, 0x7a,
+	0x71, 0x98, 0x57, 0x32, 0xc0, 0x29, 0x9c, 0xff, 0xd6, 0xc0, 0x52, 0xea, 0x1e, 0x64, 0x1f, 0x4c,
+	0x2a, 0x12, 0x6d, 0x5b, 0x5b, 0x6b, 0x39, 0x25, 0x0d, 0x2a, 0x47, 0x39, 0x92, 0xcd, 0xf2, 0x40,
+	0xde, 0xc4, 0xbc, 0x33, 0xe7, 0x26, 0xc5, 0x79, 0x2b, 0xe1, 0x8a, 0x69, 0x7d, 0xb5, 0xd8, 0x5a,
+	0xdf, 0x2a, 0x36, 0xd4, 0x56, 0x48, 0x05, 0xd7, 0x4c, 0x27, 0xa6, 0x5b, 0x21, 0x2a, 0x6d, 0x99,
+	0x8a, 0xb6, 0x5e, 0xe8, 0x66, 0x7b, 0xdb, 0x74, 0x7e, 0xa9, 0xc1, 0xf6, 0x72, 0xa1, 0x47, 0xd9,
+	0x85, 0xb6, 0x76, 0x17, 0x8d, 0x75, 0xbb, 0x78, 0x5f, 0xd1, 0xe7, 0xb0, 0xb5, 0x54, 0xf1, 0xc1,
+	0x8b, 0x47, 0x3c, 0x9b, 0x8a, 0x78, 0x8e, 0x4d, 0xc4, 0x84, 0x54, 0x2e, 0x8e, 0x4d, 0xcc, 0xb8,
+	0x5e, 0xcf, 0x92, 0x6c, 0x36, 0xf5, 0x90, 0x94, 0x2f, 0xde, 0xe1, 0x98, 0xcf, 0x67, 0x53, 0xa5,
+	0x1b, 0xc7, 0xe9, 0x6a, 0xf7, 0x53, 0x1a, 0x3b, 0x17, 0xb0, 0x73, 0xa9, 0x92, 0x81, 0xdb, 0xc4,
+	0x73, 0x9e, 0x9d, 0x0b, 0x83, 0x6c, 0xba, 0x25, 0x8c, 0x56, 0x16, 0xd2, 0x89, 0xbf, 0xf0, 0x06,
+	0x78, 0xfd, 0xcc, 0xc5, 0xf1, 0xb3, 0x18, 0x8e, 0xdd, 0x48, 0x73, 0x72, 0x07, 0x7a, 0x9c, 0x24,
+	0xa7, 0xe8, 0x1a, 0x73, 0xe1, 0x15, 0xf8, 0xb8, 0x13, 0x8e, 0x73, 0xfe, 0x1c, 0x2c, 0xa5, 0x38,
+	0x86, 0xd2, 0xcb, 0x92, 0x59, 0x2c, 0xf3, 0x24, 0x0e, 0x54, 0x32, 0x6d, 0xa8, 0x32, 0x2d, 0x8f,
+	0xbe, 0x90, 0x74, 0x79, 0xf4, 0xcf, 0xfd, 0xc9, 0x4c, 0xba, 0x1a, 0x0e, 0x20, 0x36, 0xcd, 0x92,
+	0xe4, 0x4c, 0x98, 0x30, 0x07, 0x9c, 0xbf, 0xd3, 0xe4, 0xea, 0xae, 0x5c, 0x67, 0xc5, 0xea, 0xeb,
+	0xce, 0x32, 0x01, 0x3d, 0xcd, 0xe8, 0xb9, 0xcc, 0x0b, 0xb0, 0xbd, 0xe6, 0x0c, 0xdf, 0x5f, 0x3a,
+	0xc3, 0x2b, 0xca, 0x82, 0xa5, 0xe5, 0xff, 0x4a, 0x03, 0x83, 0xe3, 0xdf, 0x91, 0x9d, 0x5b, 0xd0,
+	0x39, 0x8b, 0x62, 0x7f, 0x12, 0x7d, 0x49, 0x43, 0xe1, 0xf4, 0x2a, 0x44, 0xc9, 0xac, 0x5e, 0x67,
+	0x96, 0x8b, 0xaa, 0xb5, 0x24, 0x2a, 0xbe, 0x05, 0x43, 0xd9, 0x82, 0xf3, 0x6b, 0x4d, 0x64, 0xc6,
+	0xb2, 0x6c, 0xb8, 0xba, 0x66, 0x64, 0x43, 0x5b, 0x96, 0x20, 0xf9, 0x89, 0x90, 0x20, 0xf6, 0xc8,
+	0xd2, 0x0f, 0x17, 0x98, 0x04, 0x95, 0x0d, 0xe9, 0xeb, 0x37, 0xd4, 0x5a, 0xde, 0x90, 0x0d, 0x6d,
+	0xbf, 0x28, 0xb0, 0xfc, 0x2e, 0x18, 0x95, 0x20, 0xb9, 0x03, 0xba, 0x8f, 0x47, 0x92, 0xdf, 0x08,
+	0xe4, 0x0d, 0x91, 0x69, 0xf8, 0x49, 0x30, 0x76, 0x59, 0xa7, 0xf3, 0x73, 0x30, 0x25, 0x06, 0x17,
+	0x2a, 0x0b, 0x58, 0xc2, 0xbb, 0x56, 0x88, 0x7a, 0xfc, 0x6a, 0x2c, 0xc7, 0xaf, 0xbf, 0x6a, 0x80,
+	0x29, 0xcb, 0x97, 0xca, 0xe5, 0xa8, 0xc3, 0x2e, 0x47, 0x36, 0xb4, 0xa7, 0x74, 0x3a, 0xa0, 0xa2,
+	0x7c, 0xd6, 0x75, 0x25, 0x48, 0xf6, 0xc1, 0x10, 0xb5, 0xde, 0xe6, 0x9b, 0x6a, 0xbd, 0xae, 0xa0,
+	0x22, 0x3f, 0x82, 0x4e, 0x46, 0x87, 0x51, 0x12, 0xcb, 0x6c, 0xbb, 0xe7, 0x9a, 0x1c, 0xd1, 0x57,
+	0xcc, 0xa3, 0xa5, 0xaa, 0x42, 0x29, 0xc4, 0x19, 0x6f, 0x2a, 0xc4, 0xb5, 0x97, 0x0b, 0x71, 0xac,
+	0x5c, 0x45, 0xe3, 0x30, 0x8a, 0x87, 0xcc, 0x53, 0xf6, 0x5c, 0x09, 0xaa, 0x42, 0xef, 0xd4, 0x84,
+	0x8e, 0xc7, 0xb6, 0x57, 0x2b, 0xe3, 0x5e, 0x12, 0xc6, 0x6a, 0x23, 0x7e, 0xef, 0x72, 0x61, 0xa9,
+	0xe6, 0xd6, 0x9b, 0xd4, 0xfc, 0x75, 0x03, 0x3a, 0xdc, 0xb1, 0xe1, 0x03, 0xd0, 0xbb, 0x57, 0xf3,
+	0x32, 0xfa, 0x5a, 0xa9, 0xe6, 0x65, 0xf4, 0x75, 0x3f, 0x24, 0x77, 0xa0, 0x99, 0xd1, 0xd7, 0xc2,
+	0x93, 0xaf, 0xa8, 0xb2, 0x60, 0x2f, 0xf9, 0x7d, 0xb0, 0xb8, 0x41, 0x7b, 0x19, 0xcd, 0x53, 0xbb,
+	0x55, 0xbb, 0x7e, 0xab, 0x6e, 0x3f, 0x77, 0x69, 0x8e, 0xe5, 0x72, 0xc8, 0x4b, 0x88, 0xdc, 0x03,
+	0x9d, 0x8d, 0x32, 0x6a, 0x91, 0x56, 0x8c, 0x12, 0xf4, 0x8c, 0x82, 0x7c, 0x0a, 0x96, 0x28, 0x6a,
+	0x7b, 0xc8, 0x53, 0xbb, 0xf6, 0x70, 0x51, 0x2b, 0x6c, 0xbb, 0xf4, 0x35, 0xae, 0x32, 0x2d, 0x21,
+	0x72, 0x08, 0x9b, 0x72, 0x6c, 0x99, 0x98, 0xa8, 0x49, 0x6e, 0x6d, 0x38, 0xe7, 0x15, 0xeb, 0x15,
+	0x53, 0x15, 0xa1, 0xd6, 0xe9, 0x7e, 0x01, 0xed, 0x83, 0x49, 0x32, 0x78, 0x0f, 0x41, 0x13, 0x2e,
+	0x51, 0x59, 0x01, 0x44, 0x80, 0xdc, 0x15, 0x32, 0xa8, 0x8b, 0x19, 0x17, 0x50, 0x05, 0xa0, 0xae,
+	0xff, 0x10, 0x4c, 0xd9, 0x8d, 0xf1, 0x31, 0x10, 0xa7, 0xaf, 0xeb, 0x62, 0xb3, 0xbc, 0xc5, 0x35,
+	0xaa, 0x5b, 0x9c, 0xf3, 0x33, 0xe8, 0xd5, 0xca, 0x31, 0xa8, 0x71, 0xbc, 0x3b, 0x56, 0x85, 0xe7,
+	0x31, 0x5d, 0xf4, 0x85, 0x1d, 0xb3, 0x82, 0xb0, 0x18, 0x2e, 0x41, 0x34, 0xff, 0x36, 0x8e, 0xfc,
+	0xfe, 0x4e, 0x97, 0xa3, 0x9e, 0xae, 0xa5, 0x1a, 0xb5, 0x94, 0xcd, 0x03, 0x30, 0xb8, 0x5d, 0xd8,
+	0xad, 0x5a, 0x2d, 0x0e, 0x39, 0xe1, 0xe6, 0x81, 0x77, 0x00, 0x4e, 0x42, 0xee, 0xc9, 0x28, 0xc2,
+	0x4f, 0xd3, 0xb6, 0x42, 0xcb, 0x8c, 0x05, 0x2b, 0xb1, 0x8c, 0x80, 0xec, 0xa3, 0x2c, 0x59, 0x1d,
+	0xd9, 0x6e, 0xd7, 0x4e, 0x1e, 0xd2, 0x8a, 0x0a, 0x33, 0xab, 0x0b, 0xf2, 0xa6, 0x2a, 0xfb, 0x3f,
+	0x03, 0xa8, 0x16, 0xaf, 0x22, 0xb3, 0xa6, 0x46, 0x66, 0xf4, 0xf3, 0x11, 0xf3, 0x2a, 0x0d, 0x51,
+	0x4c, 0x8e, 0xa4, 0x53, 0x19, 0x44, 0xdc, 0xdd, 0x88, 0xc8, 0x20, 0xc0, 0x2a, 0x61, 0x13, 0xb1,
+	0x9c, 0x01, 0xce, 0x63, 0xe8, 0x94, 0xcc, 0x93, 0x07, 0x55, 0x58, 0xd1, 0xf6, 0x9a, 0x2b, 0x65,
+	0x51, 0x46, 0x1a, 0xe7, 0x39, 0x58, 0xca, 0x56, 0xd6, 0xb0, 0xd9, 0x05, 0xed, 0x4b, 0xc1, 0xa1,
+	0xf6, 0x65, 0xc5, 0x42, 0x53, 0x65, 0xe1, 0x1b, 0x0d, 0x2c, 0x25, 0xf1, 0x25, 0xbb, 0x60, 0x65,
+	0xfe, 0x85, 0x47, 0xe3, 0xc0, 0x0b, 0xa6, 0x85, 0x8c, 0x21, 0x99, 0x7f, 0xf1, 0x2c, 0x0e, 0x0e,
+	0xa7, 0xf8, 0xf2, 0xda, 0x95, 0xfd, 0x79, 0x90, 0x15, 0x22, 0x1a, 0x00, 0x27, 0x38, 0x09, 0xb2,
+	0x42, 0x7d, 0x22, 0x68, 0xd6, 0x9f, 0x08, 0x6e, 0x83, 0x25, 0x9a, 0x5e, 0x50, 0x96, 0x5a, 0x40,
+	0xa0, 0x0e, 0x23, 0x14, 0x81, 0x71, 0x11, 0xc5, 0x61, 0x72, 0x61, 0xb7, 0x6a, 0xc9, 0x25, 0x67,
+	0xf0, 0x4f, 0x58, 0x97, 0x2b, 0x48, 0xaa, 0x67, 0x04, 0x43, 0x79, 0x46, 0xa8, 0xd2, 0xa3, 0xb6,
+	0x92, 0x1e, 0xd5, 0x2a, 0x29, 0x66, 0xbd, 0x92, 0x92, 0x41, 0xaf, 0xe6, 0x1e, 0xc8, 0x6f, 0x57,
+	0x85, 0x3b, 0xae, 0x07, 0x79, 0x77, 0x13, 0x04, 0xb2, 0x16, 0x27, 0xa9, 0xc8, 0xc3, 0x7a, 0x6c,
+	0xac, 0x42, 0xe0, 0x4b, 0xba, 0x38, 0xb9, 0x88, 0x8a, 0x60, 0xf4, 0x8a, 0x75, 0x97, 0x31, 0xd3,
+	0xf9, 0x27, 0x0d, 0x36, 0xeb, 0xb3, 0x95, 0xd5, 0x06, 0x4d, 0xa9, 0x36, 0x94, 0xf7, 0xa4, 0xc6,
+	0xca, 0x7b, 0x52, 0x53, 0xbd, 0x27, 0xed, 0x82, 0x85, 0x65, 0x5a, 0xa9, 0x37, 0x71, 0x3b, 0x4d,
+	0x26, 0xa1, 0xd0, 0xdb, 0x6f, 0x95, 0x89, 0x5b, 0xbb, 0xb6, 0xa9, 0x92, 0xc7, 0x5a, 0xee, 0xf6,
+	0x42, 0x37, 0x5b, 0xdb, 0xc6, 0x0b, 0xdd, 0x34, 0xb6, 0xdb, 0xce, 0xef, 0xc2, 0xd6, 0xd2, 0x4e,
+	0x6a, 0x0e, 0xa2, 0x27, 0x1c, 0xc4, 0x66, 0xe9, 0x20, 0x7a, 0xe8, 0x15, 0x1c, 0x17, 0x36, 0xeb,
+	0x93, 0xe3, 0x79, 0x3c, 0x17, 0x27, 0x4a, 0x3b, 0xc7, 0x6c, 0x24, 0x18, 0xe1, 0xd3, 0x41, 0x5c,
+	0x3a, 0xa3, 0x0a, 0x51, 0xe9, 0xb1, 0xa9, 0xa6, 0xb9, 0x33, 0xd8, 0x5e, 0x0e, 0x05, 0xaa, 0xf8,
+	0xb5, 0xb7, 0x12, 0xbf, 0xaa, 0xe1, 0xc6, 0xdb, 0x68, 0xd8, 0xf9, 0x6b, 0x0d, 0xae, 0xac, 0x88,
+	0x21, 0x98, 0xfb, 0xf0, 0x39, 0xc5, 0x4d, 0x72, 0xdd, 0xca, 0x82, 0x4a, 0x51, 0x42, 0xe3, 0x2d,
+	0x94, 0xb0, 0xc6, 0x62, 0x87, 0xd0, 0x55, 0xed, 0x81, 0x15, 0xa8, 0x93, 0xc2, 0x1b, 0xd0, 0xb3,
+	0x24, 0xa3, 0x22, 0xc1, 0xee, 0xc4, 0x49, 0x71, 0xc0, 0x10, 0x98, 0x6f, 0x61, 0xb7, 0x7f, 0x56,
+	0x88, 0x83, 0xa4, 0xbb, 0x66, 0x9c, 0x14, 0x4f, 0x10, 0xc6, 0xce, 0x41, 0xad, 0xbc, 0x60, 0xba,
+	0xe6, 0x40, 0xd4, 0x16, 0x9c, 0x73, 0xe8, 0xaa, 0xe1, 0x1d, 0xcd, 0x97, 0x3f, 0xaf, 0x57, 0xd7,
+	0xf4, 0x96, 0x08, 0xf6, 0xe5, 0x23, 0xdb, 0x1c, 0xfd, 0xc2, 0x38, 0x92, 0x19, 0xd0, 0x3c, 0x0e,
+	0x4e, 0xc6, 0x11, 0x6e, 0x24, 0x18, 0x4d, 0x86, 0x91, 0xf4, 0x7e, 0x0c, 0x60, 0xaf, 0xbf, 0xa8,
+	0xd5, 0x48, 0x64, 0xed, 0x02, 0x72, 0xbe, 0x69, 0xc2, 0xce, 0xa5, 0xbc, 0x82, 0x7c, 0xc8, 0xa3,
+	0x49, 0x63, 0x65, 0xae, 0xc2, 0x83, 0xc9, 0x1f, 0x41, 0x4f, 0xbe, 0x6f, 0x73, 0x29, 0x37, 0x99,
+	0x94, 0xef, 0xaf, 0xcb, 0x55, 0xe4, 0x8d, 0x9f, 0x21, 0x9e, 0xc5, 0x45, 0xb6, 0x70, 0xbb, 0xb9,
+	0x82, 0x22, 0x7d, 0xb0, 0xd0, 0x10, 0xe5, 0x74, 0x3a, 0x9b, 0xee, 0xde, 0xda, 0xe9, 0xb0, 0x1a,
+	0xab, 0x4e, 0x06, 0x61, 0x89, 0xa8, 0x3f, 0x8f, 0x4a, 0x5d, 0x92, 0xc7, 0xe2, 0xb7, 0x43, 0x28,
+	0x97, 0x30, 0xd6, 0x57, 0x06, 0xf8, 0x5f, 0x87, 0x50, 0xcc, 0xf7, 0x10, 0x4c, 0x51, 0xed, 0x97,
+	0x16, 0x7d, 0xb5, 0x7c, 0x11, 0x61, 0x68, 0xc1, 0x57, 0x49, 0x75, 0xf3, 0xb4, 0xbc, 0x18, 0x57,
+	0x2c, 0x62, 0x76, 0x21, 0x9f, 0xdf, 0x75, 0x17, 0x9b, 0x78, 0xbf, 0xe7, 0x57, 0xa9, 0xc6, 0x1b,
+	0xee, 0xf7, 0x8c, 0xe2, 0xd3, 0xc6, 0x63, 0xed, 0xa6, 0xcb, 0xca, 0x3b, 0xe3, 0xef, 0x73, 0x4e,
+	0xe7, 0x97, 0x4d, 0xe8, 0xd5, 0x76, 0x41, 0x5e, 0x2e, 0x6b, 0x96, 0x5b, 0xfa, 0x8f, 0x57, 0x6d,
+	0xf9, 0x5b, 0xb5, 0xfa, 0xac, 0xae, 0x55, 0x6e, 0x8a, 0x1f, 0xad, 0x9c, 0xea, 0x4d, 0x1a, 0xbd,
+	0xa4, 0xbb, 0xe6, 0x5b, 0xea, 0xee, 0xff, 0x91, 0x26, 0x7e, 0xdd, 0x04, 0x4b, 0x49, 0xd6, 0xe5,
+	0x1d, 0x47, 0xf9, 0xb1, 0x11, 0x8e, 0x87, 0xf8, 0xda, 0xf5, 0x49, 0xe5, 0x52, 0xb9, 0x18, 0x6e,
+	0x5f, 0x4e, 0xf5, 0x85, 0x62, 0x84, 0x28, 0x25, 0x3d, 0xf9, 0x0c, 0x3a, 0x4c, 0x1d, 0xec, 0x21,
+	0x8b, 0x9b, 0xd8, 0xde, 0x8a, 0xc1, 0xb8, 0x37, 0x7c, 0xd8, 0xe2, 0xa3, 0xcd, 0x50, 0x80, 0xe4,
+	0x6e, 0xf9, 0x6e, 0xc6, 0xaf, 0x4f, 0xbd, 0x5a, 0xce, 0x50, 0xbe, 0x98, 0x3d, 0x86, 0xcd, 0x28,
+	0x66, 0x57, 0xe1, 0xba, 0xa9, 0xed, 0xa8, 0xcf, 0x6c, 0xfc, 0xeb, 0x4d, 0x4f, 0x10, 0x0a, 0x3d,
+	0xff, 0x04, 0xac, 0x59, 0x9c, 0xd1, 0x20, 0x39, 0xa7, 0xd5, 0xeb, 0xdc, 0x8a, 0x61, 0x2a, 0xd5,
+	0xcd, 0xbe, 0x74, 0xd2, 0x6b, 0x35, 0x71, 0xa7, 0xae, 0x89, 0x25, 0xb6, 0x15, 0xbd, 0xbe, 0x80,
+	0x5e, 0x6d, 0xef, 0xdf, 0x61, 0x2e, 0xe7, 0x2f, 0x00, 0x2a, 0x8e, 0x31, 0x8a, 0xb3, 0xff, 0x0a,
+	0x22, 0xcd, 0xc7, 0x36, 0x21, 0xbc, 0x80, 0xce, 0x66, 0xea, 0xb8, 0xac, 0xbd, 0x26, 0xe3, 0x60,
+	0x0f, 0xf8, 0x7e, 0x2e, 0x5e, 0x14, 0x3a, 0xae, 0x80, 0x78, 0xe1, 0x84, 0x19, 0x91, 0xb8, 0xc5,
+	0x4b, 0xd0, 0xf9, 0x8f, 0x86, 0xcc, 0x35, 0xd9, 0xff, 0x39, 0xe5, 0xde, 0xa0, 0xa9, 0xf7, 0x06,
+	0xfc, 0xe7, 0x93, 0x84, 0xf2, 0x21, 0x56, 0xc7, 0xef, 0x3f, 0x61, 0x3f, 0x14, 0xeb, 0x85, 0x54,
+	0x86, 0x43, 0x01, 0x2d, 0x3d, 0xd0, 0xea, 0xcb, 0x0f, 0xb4, 0x3f, 0xe8, 0x3b, 0x6c, 0x59, 0x09,
+	0x30, 0xd5, 0x4a, 0xc0, 0x6e, 0xed, 0xbb, 0x51, 0x67, 0xaf, 0x79, 0xaf, 0x53, 0xfb, 0x58, 0xb4,
+	0x74, 0xa2, 0xe0, 0x6d, 0x4e, 0x94, 0x52, 0x5b, 0xb2, 0xd4, 0xda, 0x92, 0xf3, 0x18, 0x4c, 0xf9,
+	0x1b, 0x91, 0xfc, 0x26, 0x8a, 0x3e, 0x48, 0xb2, 0x50, 0x3a, 0xc8, 0x7a, 0x69, 0x9b, 0xd1, 0xb9,
+	0x92, 0x04, 0xbf, 0x9d, 0xec, 0x5c, 0xfa, 0x72, 0xb6, 0xa6, 0x1a, 0x56, 0x26, 0xdb, 0x0d, 0x35,
+	0xd9, 0xbe, 0x0f, 0x06, 0xfb, 0xc7, 0x26, 0x8d, 0x9e, 0xac, 0xf8, 0xc8, 0x26, 0x28, 0xb0, 0x04,
+	0xcb, 0x9f, 0x84, 0xa9, 0xbc, 0x04, 0x95, 0xb0, 0xb2, 0xb7, 0x56, 0x6d, 0x6f, 0x67, 0x60, 0x29,
+	0x53, 0xf1, 0x4a, 0x2d, 0x82, 0x9e, 0x7a, 0xd9, 0x11, 0x3f, 0xe4, 0x78, 0x0a, 0x52, 0x2b, 0x80,
+	0x35, 0x96, 0x0b, 0x60, 0xd5, 0x91, 0x6d, 0xaa, 0x47, 0xd6, 0xf9, 0x02, 0x0c, 0x91, 0x86, 0x8b,
+	0x14, 0xa6, 0xba, 0xfa, 0x18, 0x73, 0x9e, 0x3f, 0xdf, 0x00, 0x73, 0xe9, 0xce, 0xd3, 0xa6, 0xdf,
+	0x76, 0xe1, 0x71, 0x86, 0x00, 0xa7, 0x94, 0x9e, 0x66, 0xd1, 0x70, 0x48, 0x33, 0xb2, 0x07, 0xcd,
+	0x82, 0xca, 0x47, 0x87, 0xe5, 0x3f, 0x5b, 0xd8, 0x85, 0x47, 0x39, 0x98, 0xcc, 0xf2, 0x82, 0x66,
+	0xd5, 0xe9, 0xef, 0x08, 0x0c, 0xbf, 0xbc, 0xe3, 0xb7, 0x95, 0x28, 0xe4, 0xf2, 0xd6, 0x5d, 0x09,
+	0x3a, 0x07, 0x60, 0x3c, 0x49, 0x23, 0xcc, 0x86, 0xb7, 0xa1, 0x39, 0xcb, 0x26, 0x62, 0xeb, 0xd8,
+	0x2c, 0x73, 0xf5, 0xa6, 0xf2, 0x9b, 0x48, 0x96, 0x10, 0x74, 0xa5, 0x84, 0xf0, 0x3b, 0xd0, 0x66,
+	0x73, 0xe4, 0x29, 0x76, 0xe3, 0x03, 0xb8, 0x48, 0xf1, 0x58, 0x7b, 0xd5, 0xdb, 0xf1, 0xc1, 0xe6,
+	0xbf, 0x7d, 0xb5, 0xab, 0xfd, 0xfb, 0x57, 0xbb, 0xda, 0x7f, 0x7d, 0xb5, 0xab, 0xfd, 0xe9, 0xc6,
+	0xc0, 0x60, 0xbf, 0x95, 0x7f, 0xf2, 0xbf, 0x03, 0x00, 0xec, 0xa5, 0x9a, 0x8e, 0xb9, 0x2c, 0x00,
+	0x00,
+}
+
+func (m *TxBox) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxBox) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Org) > 0 {
+		i -= len(m.Org)
+		copy(dAtA[i:], m.Org)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Org)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TeeSig) > 0 {
+		i -= len(m.TeeSig)
+		copy(dAtA[i:], m.TeeSig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TeeSig)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Payload != nil {
+		{
+			size := m.Payload.Size()
+			i -= size
+			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tx_EpochEnd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_EpochEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EpochEnd != nil {
+		{
+			size, err := m.EpochEnd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_EpochStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_EpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.EpochStart))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *Tx_HubCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_HubCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HubCall != nil {
+		{
+			size, err := m.HubCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_SyncTxStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_SyncTxStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.SyncTxStart))
+	i--
+	dAtA[i] = 0x28
+	return len(dAtA) - i, nil
+}
+func (m *Tx_SyncTxEnd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_SyncTxEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.SyncTxEnd))
+	i--
+	dAtA[i] = 0x30
+	return len(dAtA) - i, nil
+}
+func (m *Tx_SyncTxRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_SyncTxRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.SyncTxRetry))
+	i--
+	dAtA[i] = 0x38
+	return len(dAtA) - i, nil
+}
+func (m *Tx_DaoCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_DaoCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DaoCall != nil {
+		i -= len(m.DaoCall)
+		copy(dAtA[i:], m.DaoCall)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DaoCall)))
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_AuditLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_AuditLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AuditLog != nil {
+		{
+			size, err := m.AuditLog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_DisclosureShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_DisclosureShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DisclosureShare != nil {
+		{
+			size, err := m.DisclosureShare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_EncryptedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_EncryptedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EncryptedTx != nil {
+		{
+			size, err := m.EncryptedTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_EncryptedTxShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_EncryptedTxShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EncryptedTxShare != nil {
+		{
+			size, err := m.EncryptedTxShare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_BeaconShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_BeaconShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BeaconShare != nil {
+		{
+			size, err := m.BeaconShare.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_ThresholdPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_ThresholdPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ThresholdPolicy != nil {
+		{
+			size, err := m.ThresholdPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_ShareRefresh) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_ShareRefresh) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ShareRefresh != nil {
+		{
+			size, err := m.ShareRefresh.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_ShareRefreshStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_ShareRefreshStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.ShareRefreshStart))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x90
+	return len(dAtA) - i, nil
+}
+func (m *Tx_KeyGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_KeyGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.KeyGroup != nil {
+		{
+			size, err := m.KeyGroup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_KeyGroupEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_KeyGroupEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.KeyGroupEpoch != nil {
+		{
+			size, err := m.KeyGroupEpoch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_DealerFault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_DealerFault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DealerFault != nil {
+		{
+			size, err := m.DealerFault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_SecretMigrate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_SecretMigrate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SecretMigrate != nil {
+		{
+			size, err := m.SecretMigrate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_SecretSweep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_SecretSweep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SecretSweep != nil {
+		{
+			size, err := m.SecretSweep.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Tx_Empty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tx_Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.Empty))
+	i--
+	dAtA[i] = 0x6
+	i--
+	dAtA[i] = 0x98
+	return len(dAtA) - i, nil
+}
+func (m *SideValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SideValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SideValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.P2PId) > 0 {
+		i -= len(m.P2PId)
+		copy(dAtA[i:], m.P2PId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.P2PId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Power != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochEnd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochEnd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochEnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DkgCommits) > 0 {
+		i -= len(m.DkgCommits)
+		copy(dAtA[i:], m.DkgCommits)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgCommits)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DkgPub) > 0 {
+		i -= len(m.DkgPub)
+		copy(dAtA[i:], m.DkgPub)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgPub)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Bridge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bridge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Bridge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HubCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HubCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HubCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Call) > 0 {
+		for iNdEx := len(m.Call) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Call[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DkgMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DkgMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DkgMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgId) > 0 {
+		i -= len(m.MsgId)
+		copy(dAtA[i:], m.MsgId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockPartialSign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockPartialSign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockPartialSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.HubSig) > 0 {
+		i -= len(m.HubSig)
+		copy(dAtA[i:], m.HubSig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HubSig)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *To) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *To) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *To) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Payload != nil {
+		{
+			size := m.Payload.Size()
+			i -= size
+			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *To_Broadcast) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *To_Broadcast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.Broadcast {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *To_Nodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *To_Nodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Nodes != nil {
+		{
+			size, err := m.Nodes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *To_Node) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *To_Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Node != nil {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Nodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Nodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Nodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.L) > 0 {
+		for iNdEx := len(m.L) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.L[iNdEx])
+			copy(dAtA[i:], m.L[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.L[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *P2PEnvelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *P2PEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *P2PEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Channel != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Channel))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeeCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeeCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Tx != nil {
+		{
+			size := m.Tx.Size()
+			i -= size
+			if _, err := m.Tx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Report) > 0 {
+		i -= len(m.Report)
+		copy(dAtA[i:], m.Report)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Report)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TeeType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TeeType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Time != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeeCall_PodStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_PodStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PodStart != nil {
+		{
+			size, err := m.PodStart.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TeeCall_PodMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_PodMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PodMint != nil {
+		{
+			size, err := m.PodMint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TeeCall_BridgeCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_BridgeCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BridgeCall != nil {
+		{
+			size, err := m.BridgeCall.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TeeCall_Text) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Text != nil {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TeeCall_UploadSecret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_UploadSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UploadSecret != nil {
+		{
+			size, err := m.UploadSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TeeCall_InitDisk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_InitDisk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.InitDisk != nil {
+		{
+			size, err := m.InitDisk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TeeCall_GrantSecret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_GrantSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GrantSecret != nil {
+		{
+			size, err := m.GrantSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TeeCall_RevokeSecret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_RevokeSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RevokeSecret != nil {
+		{
+			size, err := m.RevokeSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TeeCall_ThresholdSign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_ThresholdSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ThresholdSign != nil {
+		{
+			size, err := m.ThresholdSign.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TeeCall_SealDisclosure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_SealDisclosure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SealDisclosure != nil {
+		{
+			size, err := m.SealDisclosure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TeeCall_ReleaseDisclosure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeCall_ReleaseDisclosure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReleaseDisclosure != nil {
+		{
+			size, err := m.ReleaseDisclosure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *PodStart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PodStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ReplicaKeys) > 0 {
+		for iNdEx := len(m.ReplicaKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReplicaKeys[iNdEx])
+			copy(dAtA[i:], m.ReplicaKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReplicaKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Shared) > 0 {
+		for iNdEx := len(m.Shared) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shared[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Disks) > 0 {
+		dAtA29 := make([]byte, len(m.Disks)*10)
+		var j28 int
+		for _, num := range m.Disks {
+			for num >= 1<<7 {
+				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j28++
+			}
+			dAtA29[j28] = uint8(num)
+			j28++
+		}
+		i -= j28
+		copy(dAtA[i:], dAtA29[:j28])
+		i = encodeVarintTx(dAtA, i, uint64(j28))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Secrets) > 0 {
+		dAtA31 := make([]byte, len(m.Secrets)*10)
+		var j30 int
+		for _, num := range m.Secrets {
+			for num >= 1<<7 {
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j30++
+			}
+			dAtA31[j30] = uint8(num)
+			j30++
+		}
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintTx(dAtA, i, uint64(j30))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AppId) > 0 {
+		i -= len(m.AppId)
+		copy(dAtA[i:], m.AppId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AppId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SharedSecret) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SharedSecret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharedSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Disk {
+		i--
+		if m.Disk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PodMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PodMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ReportHash) > 0 {
+		i -= len(m.ReportHash)
+		copy(dAtA[i:], m.ReportHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReportHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Time != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeeVerifyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeeVerifyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeVerifyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.CodeProductId) > 0 {
+		i -= len(m.CodeProductId)
+		copy(dAtA[i:], m.CodeProductId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeProductId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CodeSignature) > 0 {
+		i -= len(m.CodeSignature)
+		copy(dAtA[i:], m.CodeSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeSignature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CodeSigner) > 0 {
+		i -= len(m.CodeSigner)
+		copy(dAtA[i:], m.CodeSigner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TeeType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TeeType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UploadSecret) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadSecret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyType)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Time != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InitDisk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitDisk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InitDisk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Time != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantSecret) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantSecret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Time != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Expire != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Expire))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Disk {
+		i--
+		if m.Disk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeSecret) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeSecret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Time != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Disk {
+		i--
+		if m.Disk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SealDisclosure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SealDisclosure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealDisclosure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Time != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Condition != nil {
+		{
+			size, err := m.Condition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Secret != nil {
+		{
+			size, err := m.Secret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisclosureCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisclosureCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisclosureCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Kind != nil {
+		{
+			size := m.Kind.Size()
+			i -= size
+			if _, err := m.Kind.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisclosureCondition_DaoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisclosureCondition_DaoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.DaoProposal))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *DisclosureCondition_Height) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisclosureCondition_Height) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTx(dAtA, i, uint64(m.Height))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *DisclosureCondition_OwnerRelease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisclosureCondition_OwnerRelease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.OwnerRelease {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *ReleaseDisclosure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseDisclosure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseDisclosure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Time != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Disclosure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Disclosure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Disclosure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Plaintext) > 0 {
+		i -= len(m.Plaintext)
+		copy(dAtA[i:], m.Plaintext)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Plaintext)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DisclosedHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisclosedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ReadyHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReadyHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Seal != nil {
+		{
+			size, err := m.Seal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisclosureShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisclosureShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisclosureShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Share != nil {
+		{
+			size, err := m.Share.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Decrypted {
+		i--
+		if m.Decrypted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Position != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Envelope != nil {
+		{
+			size, err := m.Envelope.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedTxShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedTxShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedTxShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Share != nil {
+		{
+			size, err := m.Share.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Position != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QuorumDen != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QuorumDen))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QuorumNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QuorumNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Den != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Den))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Num != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Num))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretSweepConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretSweepConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretSweepConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DelaySeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelaySeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DelayBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DelayBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Round != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BeaconRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Prev) > 0 {
+		i -= len(m.Prev)
+		copy(dAtA[i:], m.Prev)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Prev)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Round != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Beacon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Beacon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Beacon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Prev) > 0 {
+		i -= len(m.Prev)
+		copy(dAtA[i:], m.Prev)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Prev)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Finalized != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Finalized))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Round != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShareRefresh) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareRefresh) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareRefresh) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Acks) > 0 {
+		for iNdEx := len(m.Acks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Acks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Finalized != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Finalized))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Commits) > 0 {
+		i -= len(m.Commits)
+		copy(dAtA[i:], m.Commits)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commits)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Refresh != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Refresh))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Pending != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.DkgCommits) > 0 {
+		i -= len(m.DkgCommits)
+		copy(dAtA[i:], m.DkgCommits)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgCommits)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DkgPub) > 0 {
+		i -= len(m.DkgPub)
+		copy(dAtA[i:], m.DkgPub)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgPub)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RegionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RegionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyGroupEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyGroupEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyGroupEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Acks) > 0 {
+		for iNdEx := len(m.Acks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Acks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DkgCommits) > 0 {
+		i -= len(m.DkgCommits)
+		copy(dAtA[i:], m.DkgCommits)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgCommits)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DkgPub) > 0 {
+		i -= len(m.DkgPub)
+		copy(dAtA[i:], m.DkgPub)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgPub)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretBox) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretBox) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Payload != nil {
+		{
+			size := m.Payload.Size()
+			i -= size
+			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretBox_Req) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretBox_Req) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SecretBox_SharesResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretBox_SharesResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SharesResp != nil {
+		{
+			size, err := m.SharesResp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SecretBox_Resp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretBox_Resp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SecretBox_MigrateReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretBox_MigrateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MigrateReq != nil {
+		{
+			size, err := m.MigrateReq.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SecretBox_MigrateShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretBox_MigrateShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MigrateShares != nil {
+		{
+			size, err := m.MigrateShares.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlobBox) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobBox) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Payload != nil {
+		{
+			size := m.Payload.Size()
+			i -= size
+			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobBox_Req) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobBox_Req) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Req != nil {
+		i -= len(m.Req)
+		copy(dAtA[i:], m.Req)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Req)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlobBox_Resp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobBox_Resp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *BlobResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdSign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdSign) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdSign) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.KeyId) > 0 {
+		i -= len(m.KeyId)
+		copy(dAtA[i:], m.KeyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignBox) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignBox) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignBox) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Payload != nil {
+		{
+			size := m.Payload.Size()
+			i -= size
+			if _, err := m.Payload.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignBox_Req) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignBox_Req) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SignBox_Commit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignBox_Commit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SignBox_Round) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignBox_Round) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Round != nil {
+		{
+			size, err := m.Round.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SignBox_Partial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignBox_Partial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Partial != nil {
+		{
+			size, err := m.Partial.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SignCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Binding) > 0 {
+		i -= len(m.Binding)
+		copy(dAtA[i:], m.Binding)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Binding)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hiding) > 0 {
+		i -= len(m.Hiding)
+		copy(dAtA[i:], m.Hiding)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hiding)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignPartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignPartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Z) > 0 {
+		i -= len(m.Z)
+		copy(dAtA[i:], m.Z)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Z)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretStore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretStore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.KeyType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PayloadCid) > 0 {
+		i -= len(m.PayloadCid)
+		copy(dAtA[i:], m.PayloadCid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayloadCid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RawEncScrt) > 0 {
+		for iNdEx := len(m.RawEncScrt) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RawEncScrt[iNdEx])
+			copy(dAtA[i:], m.RawEncScrt[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RawEncScrt[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RawEncCmt) > 0 {
+		i -= len(m.RawEncCmt)
+		copy(dAtA[i:], m.RawEncCmt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RawEncCmt)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretMigrate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretMigrate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretMigrate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigratedSecret) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigratedSecret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigratedSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OldEncCmt) > 0 {
+		i -= len(m.OldEncCmt)
+		copy(dAtA[i:], m.OldEncCmt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OldEncCmt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Disk {
+		i--
+		if m.Disk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeySwitchMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeySwitchMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeySwitchMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.To != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.To))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.From != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeySwitchShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeySwitchShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeySwitchShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.V) > 0 {
+		i -= len(m.V)
+		copy(dAtA[i:], m.V)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.V)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretMigrateReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretMigrateReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretMigrateReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Secrets) > 0 {
+		for iNdEx := len(m.Secrets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Secrets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretMigrateShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretMigrateShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretMigrateShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Member != nil {
+		{
+			size, err := m.Member.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ByHeight {
+		i--
+		if m.ByHeight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NotAfter != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NotAfter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NotBefore != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NotBefore))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecryptShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecryptShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecryptShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Proofi) > 0 {
+		i -= len(m.Proofi)
+		copy(dAtA[i:], m.Proofi)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proofi)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Chlgi) > 0 {
+		i -= len(m.Chlgi)
+		copy(dAtA[i:], m.Chlgi)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Chlgi)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.XncSki) > 0 {
+		i -= len(m.XncSki)
+		copy(dAtA[i:], m.XncSki)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.XncSki)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShareIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ShareIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecryptSharesResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecryptSharesResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecryptSharesResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Replicas) > 0 {
+		for iNdEx := len(m.Replicas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replicas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SharedShares) > 0 {
+		for iNdEx := len(m.SharedShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharedShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DiskShares) > 0 {
+		for k := range m.DiskShares {
+			v := m.DiskShares[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTx(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SecretShares) > 0 {
+		for k := range m.SecretShares {
+			v := m.SecretShares[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTx(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicaShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicaShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicaShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SharedShares) > 0 {
+		for iNdEx := len(m.SharedShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SharedShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DiskShares) > 0 {
+		for k := range m.DiskShares {
+			v := m.DiskShares[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTx(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SecretShares) > 0 {
+		for k := range m.SecretShares {
+			v := m.SecretShares[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTx(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DecryptResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecryptResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecryptResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Unrecovered) > 0 {
+		for iNdEx := len(m.Unrecovered) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unrecovered[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.InvalidShares) > 0 {
+		for iNdEx := len(m.InvalidShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InvalidShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Shared) > 0 {
+		for iNdEx := len(m.Shared) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shared[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DiskKeys) > 0 {
+		for k := range m.DiskKeys {
+			v := m.DiskKeys[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTx(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for k := range m.Secrets {
+			v := m.Secrets[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTx(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTx(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTx(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DkgKey) > 0 {
+		i -= len(m.DkgKey)
+		copy(dAtA[i:], m.DkgKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DkgKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShareFault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShareFault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShareFault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Replica != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Replica))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretAudit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretAudit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretAudit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Unrecovered) > 0 {
+		for iNdEx := len(m.Unrecovered) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unrecovered[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Shared) > 0 {
+		for iNdEx := len(m.Shared) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shared[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Disks) > 0 {
+		dAtA62 := make([]byte, len(m.Disks)*10)
+		var j61 int
+		for _, num := range m.Disks {
+			for num >= 1<<7 {
+				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j61++
+			}
+			dAtA62[j61] = uint8(num)
+			j61++
+		}
+		i -= j61
+		copy(dAtA[i:], dAtA62[:j61])
+		i = encodeVarintTx(dAtA, i, uint64(j61))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Secrets) > 0 {
+		dAtA64 := make([]byte, len(m.Secrets)*10)
+		var j63 int
+		for _, num := range m.Secrets {
+			for num >= 1<<7 {
+				dAtA64[j63] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j63++
+			}
+			dAtA64[j63] = uint8(num)
+			j63++
+		}
+		i -= j63
+		copy(dAtA[i:], dAtA64[:j63])
+		i = encodeVarintTx(dAtA, i, uint64(j63))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NameSpace) > 0 {
+		i -= len(m.NameSpace)
+		copy(dAtA[i:], m.NameSpace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NameSpace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reader) > 0 {
+		i -= len(m.Reader)
+		copy(dAtA[i:], m.Reader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PodId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PodId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ReqId) > 0 {
+		i -= len(m.ReqId)
+		copy(dAtA[i:], m.ReqId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReqId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DealerFaultReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DealerFaultReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DealerFaultReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Faults) > 0 {
+		for iNdEx := len(m.Faults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Faults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DealerFault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DealerFault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DealerFault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DealerIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DealerIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Secret) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Secret) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Secret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EncScrt) > 0 {
+		for iNdEx := len(m.EncScrt) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EncScrt[iNdEx])
+			copy(dAtA[i:], m.EncScrt[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.EncScrt[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.XncCmt) > 0 {
+		i -= len(m.XncCmt)
+		copy(dAtA[i:], m.XncCmt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.XncCmt)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TeeTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TeeTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TeeTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Callids) > 0 {
+		dAtA66 := make([]byte, len(m.Callids)*10)
+		var j65 int
+		for _, num := range m.Callids {
+			for num >= 1<<7 {
+				dAtA66[j65] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j65++
+			}
+			dAtA66[j65] = uint8(num)
+			j65++
+		}
+		i -= j65
+		copy(dAtA[i:], dAtA66[:j65])
+		i = encodeVarintTx(dAtA, i, uint64(j65))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ClusterId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ClusterId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Tee != nil {
+		{
+			size, err := m.Tee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApiReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApiResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApiResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApiResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxBox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TeeSig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Org)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Tx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		n += m.Payload.Size()
+	}
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Tx_EpochEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochEnd != nil {
+		l = m.EpochEnd.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_EpochStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.EpochStart))
+	return n
+}
+func (m *Tx_HubCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HubCall != nil {
+		l = m.HubCall.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_SyncTxStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.SyncTxStart))
+	return n
+}
+func (m *Tx_SyncTxEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.SyncTxEnd))
+	return n
+}
+func (m *Tx_SyncTxRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.SyncTxRetry))
+	return n
+}
+func (m *Tx_DaoCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DaoCall != nil {
+		l = len(m.DaoCall)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_AuditLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuditLog != nil {
+		l = m.AuditLog.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_DisclosureShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisclosureShare != nil {
+		l = m.DisclosureShare.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_EncryptedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EncryptedTx != nil {
+		l = m.EncryptedTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_EncryptedTxShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EncryptedTxShare != nil {
+		l = m.EncryptedTxShare.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BeaconShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeaconShare != nil {
+		l = m.BeaconShare.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ThresholdPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdPolicy != nil {
+		l = m.ThresholdPolicy.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ShareRefresh) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShareRefresh != nil {
+		l = m.ShareRefresh.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ShareRefreshStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sovTx(uint64(m.ShareRefreshStart))
+	return n
+}
+func (m *Tx_KeyGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyGroup != nil {
+		l = m.KeyGroup.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_KeyGroupEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyGroupEpoch != nil {
+		l = m.KeyGroupEpoch.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_DealerFault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealerFault != nil {
+		l = m.DealerFault.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_SecretMigrate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecretMigrate != nil {
+		l = m.SecretMigrate.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_SecretSweep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecretSweep != nil {
+		l = m.SecretSweep.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Tx_Empty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sovTx(uint64(m.Empty))
+	return n
+}
+func (m *SideValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovTx(uint64(m.Power))
+	}
+	l = len(m.P2PId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EpochEnd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.DkgPub)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DkgCommits)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Bridge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HubCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	if len(m.Call) > 0 {
+		for _, e := range m.Call {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DkgMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockPartialSign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTx(uint64(m.TxIndex))
+	}
+	l = len(m.HubSig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *To) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		n += m.Payload.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *To_Broadcast) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *To_Nodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nodes != nil {
+		l = m.Nodes.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *To_Node) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = len(m.Node)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *Nodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.L) > 0 {
+		for _, b := range m.L {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *P2PEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTx(uint64(m.Timestamp))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Channel != 0 {
+		n += 1 + sovTx(uint64(m.Channel))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TeeCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTx(uint64(m.Time))
+	}
+	if m.TeeType != 0 {
+		n += 1 + sovTx(uint64(m.TeeType))
+	}
+	l = len(m.Report)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Tx != nil {
+		n += m.Tx.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TeeCall_PodStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodStart != nil {
+		l = m.PodStart.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TeeCall_PodMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodMint != nil {
+		l = m.PodMint.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TeeCall_BridgeCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BridgeCall != nil {
+		l = m.BridgeCall.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TeeCall_Text) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Text != nil {
+		l = len(m.Text)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TeeCall_UploadSecret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UploadSecret != nil {
+		l = m.UploadSecret.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TeeCall_InitDisk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InitDisk != nil {
+		l = m.InitDisk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TeeCall_GrantSecret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GrantSecret != nil {
+		l = m.GrantSecret.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TeeCall_RevokeSecret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RevokeSecret != nil {
+		l = m.RevokeSecret.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TeeCall_ThresholdSign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ThresholdSign != nil {
+		l = m.ThresholdSign.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TeeCall_SealDisclosure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SealDisclosure != nil {
+		l = m.SealDisclosure.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TeeCall_ReleaseDisclosure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReleaseDisclosure != nil {
+		l = m.ReleaseDisclosure.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *PodStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.AppId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Secrets) > 0 {
+		l = 0
+		for _, e := range m.Secrets {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Disks) > 0 {
+		l = 0
+		for _, e := range m.Disks {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Shared) > 0 {
+		for _, e := range m.Shared {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ReplicaKeys) > 0 {
+		for _, b := range m.ReplicaKeys {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SharedSecret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.Disk {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PodMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.ReportHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BridgeCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != 0 {
+		n += 1 + sovTx(uint64(m.Time))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TeeVerifyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TeeType != 0 {
+		n += 1 + sovTx(uint64(m.TeeType))
+	}
+	l = len(m.CodeSigner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeProductId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UploadSecret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTx(uint64(m.Time))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *InitDisk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTx(uint64(m.Time))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GrantSecret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.Disk {
+		n += 2
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Expire != 0 {
+		n += 1 + sovTx(uint64(m.Expire))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTx(uint64(m.Time))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeSecret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.Disk {
+		n += 2
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTx(uint64(m.Time))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SealDisclosure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.Secret != nil {
+		l = m.Secret.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Condition != nil {
+		l = m.Condition.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTx(uint64(m.Time))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DisclosureCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != nil {
+		n += m.Kind.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DisclosureCondition_DaoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.DaoProposal))
+	return n
+}
+func (m *DisclosureCondition_Height) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTx(uint64(m.Height))
+	return n
+}
+func (m *DisclosureCondition_OwnerRelease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *ReleaseDisclosure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTx(uint64(m.Time))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Disclosure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seal != nil {
+		l = m.Seal.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	if m.ReadyHeight != 0 {
+		n += 1 + sovTx(uint64(m.ReadyHeight))
+	}
+	if m.DisclosedHeight != 0 {
+		n += 1 + sovTx(uint64(m.DisclosedHeight))
+	}
+	l = len(m.Plaintext)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DisclosureShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if m.Share != nil {
+		l = m.Share.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EncryptedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Position != 0 {
+		n += 1 + sovTx(uint64(m.Position))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Decrypted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EncryptedTxShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Position != 0 {
+		n += 1 + sovTx(uint64(m.Position))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if m.Share != nil {
+		l = m.Share.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ThresholdPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Num != 0 {
+		n += 1 + sovTx(uint64(m.Num))
+	}
+	if m.Den != 0 {
+		n += 1 + sovTx(uint64(m.Den))
+	}
+	if m.QuorumNum != 0 {
+		n += 1 + sovTx(uint64(m.QuorumNum))
+	}
+	if m.QuorumDen != 0 {
+		n += 1 + sovTx(uint64(m.QuorumDen))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretSweepConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.DelayBlocks != 0 {
+		n += 1 + sovTx(uint64(m.DelayBlocks))
+	}
+	if m.DelaySeconds != 0 {
+		n += 1 + sovTx(uint64(m.DelaySeconds))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovTx(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BeaconRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovTx(uint64(m.Round))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.Prev)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Beacon) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovTx(uint64(m.Round))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Finalized != 0 {
+		n += 1 + sovTx(uint64(m.Finalized))
+	}
+	l = len(m.Prev)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShareRefresh) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if m.Refresh != 0 {
+		n += 1 + sovTx(uint64(m.Refresh))
+	}
+	l = len(m.Commits)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Finalized != 0 {
+		n += 1 + sovTx(uint64(m.Finalized))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTx(uint64(m.Attempt))
+	}
+	if len(m.Acks) > 0 {
+		for _, e := range m.Acks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RoundAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KeyGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, b := range m.Members {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RegionId != 0 {
+		n += 1 + sovTx(uint64(m.RegionId))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.DkgPub)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DkgCommits)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovTx(uint64(m.Pending))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTx(uint64(m.Attempt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KeyGroupEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.DkgPub)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DkgCommits)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Acks) > 0 {
+		for _, e := range m.Acks {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretBox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Payload != nil {
+		n += m.Payload.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretBox_Req) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *SecretBox_SharesResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SharesResp != nil {
+		l = m.SharesResp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *SecretBox_Resp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *SecretBox_MigrateReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrateReq != nil {
+		l = m.MigrateReq.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *SecretBox_MigrateShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrateShares != nil {
+		l = m.MigrateShares.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *BlobBox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Payload != nil {
+		n += m.Payload.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlobBox_Req) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Req != nil {
+		l = len(m.Req)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *BlobBox_Resp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *BlobResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ThresholdSign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignBox) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Payload != nil {
+		n += m.Payload.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignBox_Req) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *SignBox_Commit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *SignBox_Round) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != nil {
+		l = m.Round.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *SignBox_Partial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partial != nil {
+		l = m.Partial.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *SignCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Hiding)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Binding)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Z)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretStore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RawEncCmt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RawEncScrt) > 0 {
+		for _, b := range m.RawEncScrt {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PayloadCid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretMigrate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MigratedSecret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Disk {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.OldEncCmt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KeySwitchMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != 0 {
+		n += 1 + sovTx(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovTx(uint64(m.To))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KeySwitchShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.V)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretMigrateReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretMigrateShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Member != nil {
+		l = m.Member.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NotBefore != 0 {
+		n += 1 + sovTx(uint64(m.NotBefore))
+	}
+	if m.NotAfter != 0 {
+		n += 1 + sovTx(uint64(m.NotAfter))
+	}
+	if m.ByHeight {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecryptShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShareIndex != 0 {
+		n += 1 + sovTx(uint64(m.ShareIndex))
+	}
+	l = len(m.XncSki)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Chlgi)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proofi)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecryptSharesResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SecretShares) > 0 {
+		for k, v := range m.SecretShares {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + sovTx(uint64(k)) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DiskShares) > 0 {
+		for k, v := range m.DiskShares {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + sovTx(uint64(k)) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SharedShares) > 0 {
+		for _, e := range m.SharedShares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Replicas) > 0 {
+		for _, e := range m.Replicas {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReplicaShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SecretShares) > 0 {
+		for k, v := range m.SecretShares {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + sovTx(uint64(k)) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DiskShares) > 0 {
+		for k, v := range m.DiskShares {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + sovTx(uint64(k)) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SharedShares) > 0 {
+		for _, e := range m.SharedShares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DecryptResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DkgKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Secrets) > 0 {
+		for k, v := range m.Secrets {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + sovTx(uint64(k)) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DiskKeys) > 0 {
+		for k, v := range m.DiskKeys {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTx(uint64(l))
+			}
+			mapEntrySize := 1 + sovTx(uint64(k)) + l
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Shared) > 0 {
+		for _, e := range m.Shared {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.InvalidShares) > 0 {
+		for _, e := range m.InvalidShares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Unrecovered) > 0 {
+		for _, e := range m.Unrecovered {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ShareFault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Replica != 0 {
+		n += 1 + sovTx(uint64(m.Replica))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecretAudit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReqId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PodId != 0 {
+		n += 1 + sovTx(uint64(m.PodId))
+	}
+	l = len(m.Reader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NameSpace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Secrets) > 0 {
+		l = 0
+		for _, e := range m.Secrets {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Disks) > 0 {
+		l = 0
+		for _, e := range m.Disks {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Shared) > 0 {
+		for _, e := range m.Shared {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Unrecovered) > 0 {
+		for _, e := range m.Unrecovered {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AuditLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DealerFaultReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Faults) > 0 {
+		for _, e := range m.Faults {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DealerFault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DealerIndex != 0 {
+		n += 1 + sovTx(uint64(m.DealerIndex))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Secret) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.XncCmt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.EncScrt) > 0 {
+		for _, b := range m.EncScrt {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TeeTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tee != nil {
+		l = m.Tee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ClusterId != 0 {
+		n += 1 + sovTx(uint64(m.ClusterId))
+	}
+	if len(m.Callids) > 0 {
+		l = 0
+		for _, e := range m.Callids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApiReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApiResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovTx(uint64(m.Code))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TxBox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxBox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxBox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeeSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TeeSig = append(m.TeeSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.TeeSig == nil {
+				m.TeeSig = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Org", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Org = append(m.Org[:0], dAtA[iNdEx:postIndex]...)
+			if m.Org == nil {
+				m.Org = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EpochEnd{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_EpochEnd{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Payload = &Tx_EpochStart{v}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubCall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HubCall{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_HubCall{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncTxStart", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Payload = &Tx_SyncTxStart{v}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncTxEnd", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Payload = &Tx_SyncTxEnd{v}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncTxRetry", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Payload = &Tx_SyncTxRetry{v}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Payload = &Tx_DaoCall{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AuditLog{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_AuditLog{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = append(m.Caller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Caller == nil {
+				m.Caller = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisclosureShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DisclosureShare{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_DisclosureShare{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SecretStore{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_EncryptedTx{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedTxShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EncryptedTxShare{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_EncryptedTxShare{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeaconShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BeaconShare{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_BeaconShare{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ThresholdPolicy{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_ThresholdPolicy{v}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareRefresh", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ShareRefresh{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_ShareRefresh{v}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareRefreshStart", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Payload = &Tx_ShareRefreshStart{v}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyGroup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &KeyGroup{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_KeyGroup{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyGroupEpoch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &KeyGroupEpoch{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_KeyGroupEpoch{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealerFault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DealerFaultReport{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_DealerFault{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretMigrate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SecretMigrate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_SecretMigrate{v}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretSweep", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SecretSweepConfig{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &Tx_SecretSweep{v}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Payload = &Tx_Empty{v}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SideValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SideValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SideValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pubkey == nil {
+				m.Pubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field P2PId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.P2PId = append(m.P2PId[:0], dAtA[iNdEx:postIndex]...)
+			if m.P2PId == nil {
+				m.P2PId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochEnd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochEnd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochEnd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &SideValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgPub", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgPub = append(m.DkgPub[:0], dAtA[iNdEx:postIndex]...)
+			if m.DkgPub == nil {
+				m.DkgPub = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgCommits", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgCommits = append(m.DkgCommits[:0], dAtA[iNdEx:postIndex]...)
+			if m.DkgCommits == nil {
+				m.DkgCommits = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bridge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bridge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bridge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HubCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HubCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HubCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Call = append(m.Call, &TeeCall{})
+			if err := m.Call[len(m.Call)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DkgMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DkgMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DkgMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &To{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockPartialSign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockPartialSign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockPartialSign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &To{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HubSig = append(m.HubSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.HubSig == nil {
+				m.HubSig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *To) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: To: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: To: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broadcast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Payload = &To_Broadcast{b}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Nodes{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &To_Nodes{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Payload = &To_Node{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Nodes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Nodes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Nodes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L = append(m.L, make([]byte, postIndex-iNdEx))
+			copy(m.L[len(m.L)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *P2PEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: P2PEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: P2PEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = append(m.From[:0], dAtA[iNdEx:postIndex]...)
+			if m.From == nil {
+				m.From = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.Nonce == nil {
+				m.Nonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			m.Channel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Channel |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeeCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeeCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeeCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = append(m.Caller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Caller == nil {
+				m.Caller = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeeType", wireType)
+			}
+			m.TeeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeeType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Report = append(m.Report[:0], dAtA[iNdEx:postIndex]...)
+			if m.Report == nil {
+				m.Report = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PodStart{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Tx = &TeeCall_PodStart{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodMint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PodMint{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Tx = &TeeCall_PodMint{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeCall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BridgeCall{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Tx = &TeeCall_BridgeCall{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Tx = &TeeCall_Text{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UploadSecret{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Tx = &TeeCall_UploadSecret{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitDisk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &InitDisk{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Tx = &TeeCall_InitDisk{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GrantSecret{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Tx = &TeeCall_GrantSecret{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeSecret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RevokeSecret{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Tx = &TeeCall_RevokeSecret{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdSign", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ThresholdSign{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Tx = &TeeCall_ThresholdSign{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealDisclosure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SealDisclosure{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Tx = &TeeCall_SealDisclosure{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseDisclosure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReleaseDisclosure{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Tx = &TeeCall_ReleaseDisclosure{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodStart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodStart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodStart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppId = append(m.AppId[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppId == nil {
+				m.AppId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Secrets = append(m.Secrets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Secrets) == 0 {
+					m.Secrets = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Secrets = append(m.Secrets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Disks = append(m.Disks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Disks) == 0 {
+					m.Disks = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Disks = append(m.Disks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shared", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shared = append(m.Shared, &SharedSecret{})
+			if err := m.Shared[len(m.Shared)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicaKeys = append(m.ReplicaKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ReplicaKeys[len(m.ReplicaKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SharedSecret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SharedSecret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SharedSecret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disk = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportHash = append(m.ReportHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReportHash == nil {
+				m.ReportHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TeeVerifyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TeeVerifyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TeeVerifyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeeType", wireType)
+			}
+			m.TeeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeeType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeSigner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeSigner = append(m.CodeSigner[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeSigner == nil {
+				m.CodeSigner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeSignature = append(m.CodeSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeSignature == nil {
+				m.CodeSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeProductId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeProductId = append(m.CodeProductId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeProductId == nil {
+				m.CodeProductId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadSecret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadSecret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadSecret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = append(m.User[:0], dAtA[iNdEx:postIndex]...)
+			if m.User == nil {
+				m.User = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitDisk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitDisk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitDisk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = append(m.User[:0], dAtA[iNdEx:postIndex]...)
+			if m.User == nil {
+				m.User = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GrantSecret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantSecret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantSecret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disk = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = append(m.Grantee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Grantee == nil {
+				m.Grantee = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expire", wireType)
+			}
+			m.Expire = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokeSecret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeSecret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeSecret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disk = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = append(m.Grantee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Grantee == nil {
+				m.Grantee = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealDisclosure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealDisclosure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealDisclosure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Secret == nil {
+				m.Secret = &SecretStore{}
+			}
+			if err := m.Secret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Condition == nil {
+				m.Condition = &DisclosureCondition{}
+			}
+			if err := m.Condition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisclosureCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisclosureCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisclosureCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaoProposal", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Kind = &DisclosureCondition_DaoProposal{v}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Kind = &DisclosureCondition_Height{v}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerRelease", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Kind = &DisclosureCondition_OwnerRelease{b}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseDisclosure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseDisclosure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseDisclosure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Disclosure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Disclosure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Disclosure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Seal == nil {
+				m.Seal = &SealDisclosure{}
+			}
+			if err := m.Seal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyHeight", wireType)
+			}
+			m.ReadyHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisclosedHeight", wireType)
+			}
+			m.DisclosedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisclosedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plaintext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plaintext = append(m.Plaintext[:0], dAtA[iNdEx:postIndex]...)
+			if m.Plaintext == nil {
+				m.Plaintext = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, &DecryptShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisclosureShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisclosureShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisclosureShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Share == nil {
+				m.Share = &DecryptShare{}
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &SecretStore{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, &DecryptShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decrypted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decrypted = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedTxShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedTxShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedTxShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Share == nil {
+				m.Share = &DecryptShare{}
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Num", wireType)
+			}
+			m.Num = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Num |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Den", wireType)
+			}
+			m.Den = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Den |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumNum", wireType)
+			}
+			m.QuorumNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumDen", wireType)
+			}
+			m.QuorumDen = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumDen |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretSweepConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretSweepConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretSweepConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlocks", wireType)
+			}
+			m.DelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelaySeconds", wireType)
+			}
+			m.DelaySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelaySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BeaconRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prev", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prev = append(m.Prev[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prev == nil {
+				m.Prev = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, &BeaconShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Beacon) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Beacon: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Beacon: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			m.Finalized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finalized |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prev", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prev = append(m.Prev[:0], dAtA[iNdEx:postIndex]...)
+			if m.Prev == nil {
+				m.Prev = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShareRefresh) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShareRefresh: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShareRefresh: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refresh", wireType)
+			}
+			m.Refresh = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refresh |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commits == nil {
+				m.Commits = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			m.Finalized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finalized |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acks = append(m.Acks, &RoundAck{})
+			if err := m.Acks[len(m.Acks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, make([]byte, postIndex-iNdEx))
+			copy(m.Members[len(m.Members)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &ThresholdPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionId", wireType)
+			}
+			m.RegionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegionId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgPub", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgPub = append(m.DkgPub[:0], dAtA[iNdEx:postIndex]...)
+			if m.DkgPub == nil {
+				m.DkgPub = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgCommits", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgCommits = append(m.DkgCommits[:0], dAtA[iNdEx:postIndex]...)
+			if m.DkgCommits == nil {
+				m.DkgCommits = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyGroupEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyGroupEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyGroupEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgPub", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgPub = append(m.DkgPub[:0], dAtA[iNdEx:postIndex]...)
+			if m.DkgPub == nil {
+				m.DkgPub = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgCommits", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DkgCommits = append(m.DkgCommits[:0], dAtA[iNdEx:postIndex]...)
+			if m.DkgCommits == nil {
+				m.DkgCommits = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acks = append(m.Acks, &RoundAck{})
+			if err := m.Acks[len(m.Acks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretBox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretBox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretBox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &To{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PodStart{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &SecretBox_Req{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesResp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DecryptSharesResp{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &SecretBox_SharesResp{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DecryptResp{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &SecretBox_Resp{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateReq", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SecretMigrateReq{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &SecretBox_MigrateReq{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SecretMigrateShares{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &SecretBox_MigrateShares{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobBox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobBox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobBox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &To{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Payload = &BlobBox_Req{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &BlobResp{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &BlobBox_Resp{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = append(m.Cid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cid == nil {
+				m.Cid = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdSign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdSign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdSign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignBox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignBox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignBox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &To{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReqId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &TeeCall{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxBox: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxBox: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Payload = &SignBox_Req{v}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeeSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4887,31 +21726,32 @@ func (m *TxBox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TeeSig = append(m.TeeSig[:0], dAtA[iNdEx:postIndex]...)
-			if m.TeeSig == nil {
-				m.TeeSig = []byte{}
+			v := &SignCommit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Payload = &SignBox_Commit{v}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Org", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4921,31 +21761,32 @@ func (m *TxBox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Org = append(m.Org[:0], dAtA[iNdEx:postIndex]...)
-			if m.Org == nil {
-				m.Org = []byte{}
+			v := &SignRound{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Payload = &SignBox_Round{v}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partial", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4955,25 +21796,26 @@ func (m *TxBox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
-			if m.Tx == nil {
-				m.Tx = []byte{}
+			v := &SignPartial{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Payload = &SignBox_Partial{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4997,7 +21839,7 @@ func (m *TxBox) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Tx) Unmarshal(dAtA []byte) error {
+func (m *SignCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5020,52 +21862,17 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochEnd", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &EpochEnd{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &Tx_EpochEnd{v}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var v int64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5075,17 +21882,16 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.Index |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Payload = &Tx_EpochStart{v}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HubCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hiding", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5095,90 +21901,29 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HubCall{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hiding = append(m.Hiding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hiding == nil {
+				m.Hiding = []byte{}
 			}
-			m.Payload = &Tx_HubCall{v}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncTxStart", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Payload = &Tx_SyncTxStart{v}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncTxEnd", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Payload = &Tx_SyncTxEnd{v}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncTxRetry", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Payload = &Tx_SyncTxRetry{v}
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DaoCall", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5205,13 +21950,14 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Payload = &Tx_DaoCall{v}
+			m.Binding = append(m.Binding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Binding == nil {
+				m.Binding = []byte{}
+			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5238,16 +21984,67 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = append(m.Caller[:0], dAtA[iNdEx:postIndex]...)
-			if m.Caller == nil {
-				m.Caller = []byte{}
+			m.Error = append(m.Error[:0], dAtA[iNdEx:postIndex]...)
+			if m.Error == nil {
+				m.Error = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5257,46 +22054,26 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
+			m.Commits = append(m.Commits, &SignCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 99:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Payload = &Tx_Empty{v}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5319,7 +22096,7 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SideValidator) Unmarshal(dAtA []byte) error {
+func (m *SignPartial) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5342,15 +22119,34 @@ func (m *SideValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SideValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignPartial: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SideValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignPartial: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Z", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5377,16 +22173,16 @@ func (m *SideValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
-			if m.Pubkey == nil {
-				m.Pubkey = []byte{}
+			m.Z = append(m.Z[:0], dAtA[iNdEx:postIndex]...)
+			if m.Z == nil {
+				m.Z = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			m.Power = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5396,11 +22192,26 @@ func (m *SideValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = append(m.Error[:0], dAtA[iNdEx:postIndex]...)
+			if m.Error == nil {
+				m.Error = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5423,7 +22234,7 @@ func (m *SideValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EpochEnd) Unmarshal(dAtA []byte) error {
+func (m *SecretStore) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5446,17 +22257,17 @@ func (m *EpochEnd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochEnd: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretStore: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochEnd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretStore: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawEncCmt", wireType)
 			}
-			m.Epoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5466,16 +22277,31 @@ func (m *EpochEnd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawEncCmt = append(m.RawEncCmt[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawEncCmt == nil {
+				m.RawEncCmt = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RawEncScrt", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5485,29 +22311,27 @@ func (m *EpochEnd) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &SideValidator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RawEncScrt = append(m.RawEncScrt, make([]byte, postIndex-iNdEx))
+			copy(m.RawEncScrt[len(m.RawEncScrt)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DkgPub", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5534,14 +22358,14 @@ func (m *EpochEnd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DkgPub = append(m.DkgPub[:0], dAtA[iNdEx:postIndex]...)
-			if m.DkgPub == nil {
-				m.DkgPub = []byte{}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DkgCommits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadCid", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5568,67 +22392,84 @@ func (m *EpochEnd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DkgCommits = append(m.DkgCommits[:0], dAtA[iNdEx:postIndex]...)
-			if m.DkgCommits == nil {
-				m.DkgCommits = []byte{}
+			m.PayloadCid = append(m.PayloadCid[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadCid == nil {
+				m.PayloadCid = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Bridge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if m.Window == nil {
+				m.Window = &SecretWindow{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Bridge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bridge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5638,29 +22479,31 @@ func (m *Bridge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgId = string(dAtA[iNdEx:postIndex])
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5670,25 +22513,23 @@ func (m *Bridge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5712,7 +22553,7 @@ func (m *Bridge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HubCall) Unmarshal(dAtA []byte) error {
+func (m *SecretMigrate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5735,17 +22576,17 @@ func (m *HubCall) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HubCall: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretMigrate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HubCall: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretMigrate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
 			}
-			m.ChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5755,14 +22596,29 @@ func (m *HubCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &MigratedSecret{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5789,8 +22645,8 @@ func (m *HubCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Call = append(m.Call, &TeeCall{})
-			if err := m.Call[len(m.Call)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Members = append(m.Members, &KeySwitchMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5816,7 +22672,7 @@ func (m *HubCall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DkgMessage) Unmarshal(dAtA []byte) error {
+func (m *MigratedSecret) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5839,17 +22695,17 @@ func (m *DkgMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DkgMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigratedSecret: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DkgMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigratedSecret: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5859,29 +22715,17 @@ func (m *DkgMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Disk = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5891,33 +22735,31 @@ func (m *DkgMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &To{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5927,27 +22769,14 @@ func (m *DkgMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MsgId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldEncCmt", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5974,16 +22803,16 @@ func (m *DkgMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			m.OldEncCmt = append(m.OldEncCmt[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldEncCmt == nil {
+				m.OldEncCmt = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5993,55 +22822,25 @@ func (m *DkgMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Shares = append(m.Shares, &KeySwitchShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6065,7 +22864,7 @@ func (m *DkgMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockPartialSign) Unmarshal(dAtA []byte) error {
+func (m *KeySwitchMember) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6088,17 +22887,17 @@ func (m *BlockPartialSign) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockPartialSign: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeySwitchMember: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockPartialSign: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeySwitchMember: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			m.From = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6108,84 +22907,16 @@ func (m *BlockPartialSign) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.From |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.To == nil {
-				m.To = &To{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
-			}
-			m.TxIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HubSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			var byteLen int
+			m.To = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6195,26 +22926,11 @@ func (m *BlockPartialSign) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.To |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HubSig = append(m.HubSig[:0], dAtA[iNdEx:postIndex]...)
-			if m.HubSig == nil {
-				m.HubSig = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6237,7 +22953,7 @@ func (m *BlockPartialSign) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *To) Unmarshal(dAtA []byte) error {
+func (m *KeySwitchShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6260,17 +22976,17 @@ func (m *To) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: To: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeySwitchShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: To: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeySwitchShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Broadcast", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6280,18 +22996,31 @@ func (m *To) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Payload = &To_Broadcast{b}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6301,30 +23030,29 @@ func (m *To) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Nodes{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Challenge = append(m.Challenge[:0], dAtA[iNdEx:postIndex]...)
+			if m.Challenge == nil {
+				m.Challenge = []byte{}
 			}
-			m.Payload = &To_Nodes{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6351,9 +23079,10 @@ func (m *To) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Payload = &To_Node{v}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6377,7 +23106,7 @@ func (m *To) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Nodes) Unmarshal(dAtA []byte) error {
+func (m *SecretMigrateReq) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6400,17 +23129,51 @@ func (m *Nodes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Nodes: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretMigrateReq: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Nodes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretMigrateReq: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &KeySwitchMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field L", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6420,23 +23183,25 @@ func (m *Nodes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.L = append(m.L, make([]byte, postIndex-iNdEx))
-			copy(m.L[len(m.L)-1], dAtA[iNdEx:postIndex])
+			m.Secrets = append(m.Secrets, &MigratedSecret{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6460,7 +23225,7 @@ func (m *Nodes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TeeCall) Unmarshal(dAtA []byte) error {
+func (m *SecretMigrateShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6483,17 +23248,17 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TeeCall: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretMigrateShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TeeCall: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretMigrateShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6503,31 +23268,33 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = append(m.Caller[:0], dAtA[iNdEx:postIndex]...)
-			if m.Caller == nil {
-				m.Caller = []byte{}
+			if m.Member == nil {
+				m.Member = &KeySwitchMember{}
+			}
+			if err := m.Member.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			m.Time = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6537,33 +23304,29 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeeType", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.TeeType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TeeType |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, &KeySwitchShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6590,16 +23353,67 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Report = append(m.Report[:0], dAtA[iNdEx:postIndex]...)
-			if m.Report == nil {
-				m.Report = []byte{}
+			m.Error = append(m.Error[:0], dAtA[iNdEx:postIndex]...)
+			if m.Error == nil {
+				m.Error = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodStart", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			m.NotBefore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6609,32 +23423,35 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NotBefore |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
 			}
-			v := &PodStart{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NotAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotAfter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Tx = &TeeCall_PodStart{v}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodMint", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByHeight", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6644,32 +23461,68 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			m.ByHeight = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecryptShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PodMint{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Tx = &TeeCall_PodMint{v}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeCall", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecryptShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecryptShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareIndex", wireType)
 			}
-			var msglen int
+			m.ShareIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6679,30 +23532,14 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShareIndex |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &BridgeCall{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Tx = &TeeCall_BridgeCall{v}
-			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field XncSki", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -6729,15 +23566,16 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Tx = &TeeCall_Text{v}
+			m.XncSki = append(m.XncSki[:0], dAtA[iNdEx:postIndex]...)
+			if m.XncSki == nil {
+				m.XncSki = []byte{}
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chlgi", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6747,32 +23585,31 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &UploadSecret{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Chlgi = append(m.Chlgi[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chlgi == nil {
+				m.Chlgi = []byte{}
 			}
-			m.Tx = &TeeCall_UploadSecret{v}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitDisk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofi", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6782,26 +23619,25 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &InitDisk{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Proofi = append(m.Proofi[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proofi == nil {
+				m.Proofi = []byte{}
 			}
-			m.Tx = &TeeCall_InitDisk{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6825,7 +23661,7 @@ func (m *TeeCall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodStart) Unmarshal(dAtA []byte) error {
+func (m *DecryptSharesResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6848,36 +23684,17 @@ func (m *PodStart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodStart: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecryptSharesResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodStart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecryptSharesResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6887,31 +23704,33 @@ func (m *PodStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppId = append(m.AppId[:0], dAtA[iNdEx:postIndex]...)
-			if m.AppId == nil {
-				m.AppId = []byte{}
+			if m.Req == nil {
+				m.Req = &PodStart{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretShares", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6921,31 +23740,112 @@ func (m *PodStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
-			if m.NameSpace == nil {
-				m.NameSpace = []byte{}
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretShares == nil {
+				m.SecretShares = make(map[uint64]*DecryptShare)
+			}
+			var mapkey uint64
+			var mapvalue *DecryptShare
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTx
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DecryptShare{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SecretShares[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskShares", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6955,46 +23855,29 @@ func (m *PodStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
+			if m.DiskShares == nil {
+				m.DiskShares = make(map[uint64]*DecryptShare)
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Secrets = append(m.Secrets, v)
-			} else if wireType == 2 {
-				var packedLen int
+			var mapkey uint64
+			var mapvalue *DecryptShare
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowTx
@@ -7004,34 +23887,29 @@ func (m *PodStart) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= int(b&0x7F) << shift
+					wire |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
 					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Secrets) == 0 {
-					m.Secrets = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
+				} else if fieldNum == 2 {
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowTx
@@ -7041,92 +23919,145 @@ func (m *PodStart) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.Secrets = append(m.Secrets, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
-			}
-		case 6:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTx
 					}
-					if iNdEx >= l {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					mapvalue = &DecryptShare{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
 					}
-				}
-				m.Disks = append(m.Disks, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
 					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
 					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
 					}
+					iNdEx += skippy
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			m.DiskShares[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Disks) == 0 {
-					m.Disks = make([]uint64, 0, elementCount)
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = append(m.Error[:0], dAtA[iNdEx:postIndex]...)
+			if m.Error == nil {
+				m.Error = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Disks = append(m.Disks, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharedShares = append(m.SharedShares, &DecryptShare{})
+			if err := m.SharedShares[len(m.SharedShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Replicas = append(m.Replicas, &ReplicaShares{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7149,7 +24080,7 @@ func (m *PodStart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PodMint) Unmarshal(dAtA []byte) error {
+func (m *ReplicaShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7172,17 +24103,17 @@ func (m *PodMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PodMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicaShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicaShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretShares", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7192,16 +24123,112 @@ func (m *PodMint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretShares == nil {
+				m.SecretShares = make(map[uint64]*DecryptShare)
+			}
+			var mapkey uint64
+			var mapvalue *DecryptShare
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTx
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DecryptShare{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SecretShares[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskShares", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7211,82 +24238,112 @@ func (m *PodMint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReportHash = append(m.ReportHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ReportHash == nil {
-				m.ReportHash = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BridgeCall) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DiskShares == nil {
+				m.DiskShares = make(map[uint64]*DecryptShare)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey uint64
+			var mapvalue *DecryptShare
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTx
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &DecryptShare{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeCall: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeCall: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			m.DiskShares[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharedShares", wireType)
 			}
-			m.Time = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7296,11 +24353,26 @@ func (m *BridgeCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharedShares = append(m.SharedShares, &DecryptShare{})
+			if err := m.SharedShares[len(m.SharedShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7323,7 +24395,7 @@ func (m *BridgeCall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TeeVerifyResult) Unmarshal(dAtA []byte) error {
+func (m *DecryptResp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7346,34 +24418,15 @@ func (m *TeeVerifyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TeeVerifyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: DecryptResp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TeeVerifyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DecryptResp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TeeType", wireType)
-			}
-			m.TeeType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TeeType |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeSigner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DkgKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7400,16 +24453,16 @@ func (m *TeeVerifyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeSigner = append(m.CodeSigner[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeSigner == nil {
-				m.CodeSigner = []byte{}
+			m.DkgKey = append(m.DkgKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DkgKey == nil {
+				m.DkgKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7419,116 +24472,112 @@ func (m *TeeVerifyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeSignature = append(m.CodeSignature[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeSignature == nil {
-				m.CodeSignature = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeProductId", wireType)
+			if m.Secrets == nil {
+				m.Secrets = make(map[uint64]*Secret)
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey uint64
+			var mapvalue *Secret
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CodeProductId = append(m.CodeProductId[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeProductId == nil {
-				m.CodeProductId = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UploadSecret) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UploadSecret: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UploadSecret: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTx
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Secret{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Secrets[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskKeys", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7538,50 +24587,112 @@ func (m *UploadSecret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = append(m.User[:0], dAtA[iNdEx:postIndex]...)
-			if m.User == nil {
-				m.User = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if m.DiskKeys == nil {
+				m.DiskKeys = make(map[uint64]*Secret)
 			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey uint64
+			var mapvalue *Secret
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTx
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTx
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Secret{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTx(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTx
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-		case 3:
+			m.DiskKeys[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shared", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7591,31 +24702,31 @@ func (m *UploadSecret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Shared = append(m.Shared, &Secret{})
+			if err := m.Shared[len(m.Shared)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidShares", wireType)
 			}
-			m.Time = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7625,16 +24736,31 @@ func (m *UploadSecret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidShares = append(m.InvalidShares, &ShareFault{})
+			if err := m.InvalidShares[len(m.InvalidShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unrecovered", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7644,24 +24770,24 @@ func (m *UploadSecret) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			m.Unrecovered = append(m.Unrecovered, &ShareFault{})
+			if err := m.Unrecovered[len(m.Unrecovered)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -7686,7 +24812,7 @@ func (m *UploadSecret) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InitDisk) Unmarshal(dAtA []byte) error {
+func (m *ShareFault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7709,17 +24835,17 @@ func (m *InitDisk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InitDisk: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShareFault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitDisk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShareFault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7729,31 +24855,29 @@ func (m *InitDisk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = append(m.User[:0], dAtA[iNdEx:postIndex]...)
-			if m.User == nil {
-				m.User = []byte{}
-			}
+			m.Node = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			m.Index = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7763,16 +24887,29 @@ func (m *InitDisk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var byteLen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7782,31 +24919,16 @@ func (m *InitDisk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Time = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7816,16 +24938,29 @@ func (m *InitDisk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Time |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replica", wireType)
 			}
-			var byteLen int
+			m.Replica = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7835,26 +24970,11 @@ func (m *InitDisk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Replica |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7877,7 +24997,7 @@ func (m *InitDisk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretBox) Unmarshal(dAtA []byte) error {
+func (m *SecretAudit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7900,15 +25020,15 @@ func (m *SecretBox) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretBox: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretAudit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretBox: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretAudit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReqId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7936,11 +25056,250 @@ func (m *SecretBox) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.ReqId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodId", wireType)
+			}
+			m.PodId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reader = append(m.Reader[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reader == nil {
+				m.Reader = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSpace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSpace = append(m.NameSpace[:0], dAtA[iNdEx:postIndex]...)
+			if m.NameSpace == nil {
+				m.NameSpace = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Secrets = append(m.Secrets, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Secrets) == 0 {
+					m.Secrets = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Secrets = append(m.Secrets, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Disks = append(m.Disks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Disks) == 0 {
+					m.Disks = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Disks = append(m.Disks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disks", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shared", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7967,18 +25326,16 @@ func (m *SecretBox) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &To{}
-			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Shared = append(m.Shared, &SharedSecret{})
+			if err := m.Shared[len(m.Shared)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7988,32 +25345,16 @@ func (m *SecretBox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &PodStart{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &SecretBox_Req{v}
-			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesResp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8023,30 +25364,27 @@ func (m *SecretBox) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DecryptSharesResp{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &SecretBox_SharesResp{v}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unrecovered", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8073,102 +25411,16 @@ func (m *SecretBox) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DecryptResp{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &SecretBox_Resp{v}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			m.Unrecovered = append(m.Unrecovered, &ShareFault{})
+			if err := m.Unrecovered[len(m.Unrecovered)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SecretStore) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SecretStore: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretStore: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawEncCmt", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RawEncCmt = append(m.RawEncCmt[:0], dAtA[iNdEx:postIndex]...)
-			if m.RawEncCmt == nil {
-				m.RawEncCmt = []byte{}
-			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawEncScrt", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var byteLen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8178,24 +25430,11 @@ func (m *SecretStore) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RawEncScrt = append(m.RawEncScrt, make([]byte, postIndex-iNdEx))
-			copy(m.RawEncScrt[len(m.RawEncScrt)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8218,7 +25457,7 @@ func (m *SecretStore) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptShare) Unmarshal(dAtA []byte) error {
+func (m *AuditLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8237,108 +25476,21 @@ func (m *DecryptShare) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptShare: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptShare: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareIndex", wireType)
-			}
-			m.ShareIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShareIndex |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field XncSki", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XncSki = append(m.XncSki[:0], dAtA[iNdEx:postIndex]...)
-			if m.XncSki == nil {
-				m.XncSki = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chlgi", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Chlgi = append(m.Chlgi[:0], dAtA[iNdEx:postIndex]...)
-			if m.Chlgi == nil {
-				m.Chlgi = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proofi", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8348,24 +25500,24 @@ func (m *DecryptShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proofi = append(m.Proofi[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proofi == nil {
-				m.Proofi = []byte{}
+			m.Records = append(m.Records, &SecretAudit{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -8390,7 +25542,7 @@ func (m *DecryptShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptSharesResp) Unmarshal(dAtA []byte) error {
+func (m *DealerFaultReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8413,17 +25565,17 @@ func (m *DecryptSharesResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptSharesResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: DealerFaultReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptSharesResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DealerFaultReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8433,33 +25585,16 @@ func (m *DecryptSharesResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Req == nil {
-				m.Req = &PodStart{}
-			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8469,110 +25604,27 @@ func (m *DecryptSharesResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretShares == nil {
-				m.SecretShares = make(map[uint64]*DecryptShare)
-			}
-			var mapkey uint64
-			var mapvalue *DecryptShare
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthTx
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DecryptShare{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.SecretShares[mapkey] = mapvalue
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Faults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8599,95 +25651,14 @@ func (m *DecryptSharesResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DiskShares == nil {
-				m.DiskShares = make(map[uint64]*DecryptShare)
-			}
-			var mapkey uint64
-			var mapvalue *DecryptShare
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthTx
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &DecryptShare{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Faults = append(m.Faults, &DealerFault{})
+			if err := m.Faults[len(m.Faults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DiskShares[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8714,11 +25685,30 @@ func (m *DecryptSharesResp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = append(m.Error[:0], dAtA[iNdEx:postIndex]...)
-			if m.Error == nil {
-				m.Error = []byte{}
+			m.Reporter = append(m.Reporter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporter == nil {
+				m.Reporter = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8741,7 +25731,7 @@ func (m *DecryptSharesResp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecryptResp) Unmarshal(dAtA []byte) error {
+func (m *DealerFault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8764,17 +25754,17 @@ func (m *DecryptResp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecryptResp: wiretype end group for non-group")
+			return fmt.Errorf("proto: DealerFault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecryptResp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DealerFault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DkgKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DealerIndex", wireType)
 			}
-			var byteLen int
+			m.DealerIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8784,31 +25774,16 @@ func (m *DecryptResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.DealerIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DkgKey = append(m.DkgKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.DkgKey == nil {
-				m.DkgKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8818,112 +25793,31 @@ func (m *DecryptResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Secrets == nil {
-				m.Secrets = make(map[uint64]*Secret)
-			}
-			var mapkey uint64
-			var mapvalue *Secret
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthTx
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Secret{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
 			}
-			m.Secrets[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiskKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8933,106 +25827,23 @@ func (m *DecryptResp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DiskKeys == nil {
-				m.DiskKeys = make(map[uint64]*Secret)
-			}
-			var mapkey uint64
-			var mapvalue *Secret
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthTx
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthTx
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Secret{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipTx(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthTx
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.DiskKeys[mapkey] = mapvalue
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9151,6 +25962,40 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 			m.EncScrt = append(m.EncScrt, make([]byte, postIndex-iNdEx))
 			copy(m.EncScrt[len(m.EncScrt)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,111 @@
+package bftbrigde
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/gogo/protobuf/proto"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+// 所有 reactor 消息都放在发送节点 P2P 密钥签名的信封中发送
+// 接收时验证信封的签名者就是发送消息的节点，并丢弃过期和重放的消息
+
+// EnvelopeMaxAge 信封时间与本地时间允许的最大偏差
+var EnvelopeMaxAge = time.Minute
+
+// seal 使用本节点的 P2P 密钥签名消息
+func (r *BTFReactor) seal(channel byte, msg proto.Message) (*model.P2PEnvelope, error) {
+	if r.key == nil {
+		return nil, errors.New("p2p key is not set")
+	}
+	return model.NewP2PEnvelope(r.key, uint32(channel), msg)
+}
+
+// open 验证信封并返回发送节点和消息
+func (r *BTFReactor) open(src p2p.ID, channel byte, env *model.P2PEnvelope) (*model.PubKey, proto.Message, error) {
+	if env.Channel != uint32(channel) {
+		return nil, nil, errors.New("channel mismatch")
+	}
+
+	// 信封必须由发送消息的节点签名
+	pub, err := r.GetPubkeyFromPeerID(src)
+	if err != nil {
+		return nil, nil, errors.New("unknown node " + string(src))
+	}
+	if !bytes.Equal(pub.Byte(), env.From) {
+		return nil, nil, errors.New("sender mismatch " + string(src))
+	}
+
+	now := time.Now()
+	if err := env.Verify(EnvelopeMaxAge, now); err != nil {
+		return nil, nil, err
+	}
+	if !r.replay.check(env.From, env.Nonce, env.Timestamp, now) {
+		return nil, nil, errors.New("replayed message")
+	}
+
+	msg := channelMessage(channel)
+	if msg == nil {
+		return nil, nil, errors.New("unknown channel")
+	}
+	if err := proto.Unmarshal(env.Msg, msg); err != nil {
+		return nil, nil, err
+	}
+	return pub, msg, nil
+}
+
+// channelMessage 通道对应的消息类型
+func channelMessage(channel byte) proto.Message {
+	switch channel {
+	case topics["dkg"].ID:
+		return &model.DkgMessage{}
+	case topics["block-partial-sign"].ID:
+		return &model.BlockPartialSign{}
+	case topics["secret"].ID:
+		return &model.SecretBox{}
+	case topics["blob"].ID:
+		return &model.BlobBox{}
+	case topics["sign"].ID:
+		return &model.SignBox{}
+	}
+	return nil
+}
+
+// replayGuard 记录有效期内收到的信封随机数
+type replayGuard struct {
+	mu        sync.Mutex
+	seen      map[string]int64
+	lastPrune int64
+}
+
+// check 随机数未出现过时记录并返回 true
+func (g *replayGuard) check(from, nonce []byte, timestamp int64, now time.Time) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.seen == nil {
+		g.seen = map[string]int64{}
+	}
+
+	// 过期的信封不能通过时间检查，不需要继续记录
+	maxAge := int64(EnvelopeMaxAge / time.Second)
+	if now.Unix()-g.lastPrune > maxAge {
+		for k, ts := range g.seen {
+			if now.Unix()-ts > maxAge {
+				delete(g.seen, k)
+			}
+		}
+		g.lastPrune = now.Unix()
+	}
+
+	key := string(from) + string(nonce)
+	if _, ok := g.seen[key]; ok {
+		return false
+	}
+	g.seen[key] = timestamp
+	return true
+}
@@ -25,10 +25,25 @@ func (p *BTFReactor) Send(to *model.To, message any) error {
 		sendData.ChannelID = topics["secret"].ID
 		msg.To = to
 		sendData.Message = msg
+	case *model.BlobBox:
+		sendData.ChannelID = topics["blob"].ID
+		msg.To = to
+		sendData.Message = msg
+	case *model.SignBox:
+		sendData.ChannelID = topics["sign"].ID
+		msg.To = to
+		sendData.Message = msg
 	default:
 		return errors.New("unknown message type")
 	}
 
+	// 签名消息
+	env, err := p.seal(sendData.ChannelID, sendData.Message)
+	if err != nil {
+		return errors.New("seal message error: " + err.Error())
+	}
+	sendData.Message = env
+
 	if to.Check(p.id) {
 		p.Receive(sendData)
 	}
@@ -58,6 +73,10 @@ func (p *BTFReactor) Sub(topic string, handler func(any) error) error {
 		p.blockPartialSignHandler = handler
 	case "secret":
 		p.secretHandler = handler
+	case "blob":
+		p.blobHandler = handler
+	case "sign":
+		p.signHandler = handler
 	default:
 		return errors.New("topic not found")
 	}
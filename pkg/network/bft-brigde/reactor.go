@@ -21,7 +21,7 @@ var topics = map[string]p2p.ChannelDescriptor{
 		SendQueueCapacity:   1000,
 		RecvBufferCapacity:  50 * 4096,
 		RecvMessageCapacity: MaxMsgSize,
-		MessageType:         &model.DkgMessage{},
+		MessageType:         &model.P2PEnvelope{},
 	},
 	"block-partial-sign": { // block partial sign msg
 		ID:                  254,
@@ -29,7 +29,7 @@ var topics = map[string]p2p.ChannelDescriptor{
 		SendQueueCapacity:   1000,
 		RecvBufferCapacity:  50 * 4096,
 		RecvMessageCapacity: MaxMsgSize,
-		MessageType:         &model.BlockPartialSign{},
+		MessageType:         &model.P2PEnvelope{},
 	},
 	"secret": {
 		ID:                  253,
@@ -37,7 +37,23 @@ var topics = map[string]p2p.ChannelDescriptor{
 		SendQueueCapacity:   1000,
 		RecvBufferCapacity:  50 * 4096,
 		RecvMessageCapacity: MaxMsgSize,
-		MessageType:         &model.SecretBox{},
+		MessageType:         &model.P2PEnvelope{},
+	},
+	"blob": { // encrypted secret payload
+		ID:                  252,
+		Priority:            1000,
+		SendQueueCapacity:   100,
+		RecvBufferCapacity:  50 * 4096,
+		RecvMessageCapacity: MaxMsgSize,
+		MessageType:         &model.P2PEnvelope{},
+	},
+	"sign": { // app threshold sign
+		ID:                  251,
+		Priority:            10000,
+		SendQueueCapacity:   1000,
+		RecvBufferCapacity:  50 * 4096,
+		RecvMessageCapacity: MaxMsgSize,
+		MessageType:         &model.P2PEnvelope{},
 	},
 }
 
@@ -46,15 +62,19 @@ type BTFReactor struct {
 	Switch *p2p.Switch
 
 	id                      *model.PubKey
+	key                     *model.PrivKey
+	replay                  replayGuard
 	validators              []*model.PubKey
 	nodekeys                []*model.PubKey
 	dkgHandler              func(any) error
 	blockPartialSignHandler func(any) error
 	secretHandler           func(any) error
+	blobHandler             func(any) error
+	signHandler             func(any) error
 }
 
-func NewBTFReactor(name string) *BTFReactor {
-	r := &BTFReactor{}
+func NewBTFReactor(name string, key *model.PrivKey) *BTFReactor {
+	r := &BTFReactor{key: key}
 	r.BaseService = *service.NewBaseService(nil, name, r)
 
 	return r
@@ -101,7 +121,20 @@ func (r *BTFReactor) RemovePeer(p2p.Peer, any) {
 }
 
 func (r *BTFReactor) Receive(e p2p.Envelope) {
-	switch msg := e.Message.(type) {
+	env, ok := e.Message.(*model.P2PEnvelope)
+	if !ok {
+		util.LogWithRed("P2P Receive", "Receive unsigned message from", e.Src.ID())
+		return
+	}
+
+	// 验证发送节点签名，丢弃伪造和重放的消息
+	pub, message, err := r.open(e.Src.ID(), e.ChannelID, env)
+	if err != nil {
+		util.LogWithRed("P2P Receive", "drop message from", e.Src.ID(), err.Error())
+		return
+	}
+
+	switch msg := message.(type) {
 	case *model.DkgMessage:
 		if !msg.To.Check(r.id) {
 			return
@@ -112,11 +145,6 @@ func (r *BTFReactor) Receive(e p2p.Envelope) {
 			return
 		}
 
-		pub, err := r.GetPubkeyFromPeerID(e.Src.ID())
-		if err != nil {
-			util.LogWithRed("P2P PubkeyFromPeerID", "Receive unknown node", e.Src.ID())
-		}
-
 		msg.From = pub.String()
 		err = r.dkgHandler(msg)
 		if err != nil {
@@ -133,11 +161,6 @@ func (r *BTFReactor) Receive(e p2p.Envelope) {
 			return
 		}
 
-		pub, err := r.GetPubkeyFromPeerID(e.Src.ID())
-		if err != nil {
-			util.LogWithRed("P2P PubkeyFromPeerID", "Receive unknown node", e.Src.ID())
-		}
-
 		msg.From = pub.String()
 		err = r.blockPartialSignHandler(msg)
 		if err != nil {
@@ -148,9 +171,9 @@ func (r *BTFReactor) Receive(e p2p.Envelope) {
 			return
 		}
 
-		pub, err := r.GetPubkeyFromPeerID(e.Src.ID())
-		if err != nil {
-			util.LogWithRed("P2P PubkeyFromPeerID", "Receive unknown node", e.Src.ID())
+		if r.secretHandler == nil {
+			util.LogWithRed("P2P Receive", "secretHandler not set")
+			return
 		}
 
 		msg.From = pub.String()
@@ -158,6 +181,36 @@ func (r *BTFReactor) Receive(e p2p.Envelope) {
 		if err != nil {
 			util.LogWithRed("P2P Receive error", "secretHandler", err)
 		}
+	case *model.BlobBox:
+		if !msg.To.Check(r.id) {
+			return
+		}
+
+		if r.blobHandler == nil {
+			util.LogWithRed("P2P Receive", "blobHandler not set")
+			return
+		}
+
+		msg.From = pub.String()
+		err = r.blobHandler(msg)
+		if err != nil {
+			util.LogWithRed("P2P Receive error", "blobHandler", err)
+		}
+	case *model.SignBox:
+		if !msg.To.Check(r.id) {
+			return
+		}
+
+		if r.signHandler == nil {
+			util.LogWithRed("P2P Receive", "signHandler not set")
+			return
+		}
+
+		msg.From = pub.String()
+		err = r.signHandler(msg)
+		if err != nil {
+			util.LogWithRed("P2P Receive error", "signHandler", err)
+		}
 	default:
 		util.LogWithRed("P2P Receive", "Receive error", "msg", msg)
 	}
@@ -0,0 +1,234 @@
+package local
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+// Network 进程内的模拟网络，节点之间的消息在内存中异步投递
+// 可以注入丢包、延迟、乱序、网络分区和节点崩溃，用于在 go test 中模拟故障
+type Network struct {
+	mu    sync.RWMutex
+	peers map[string]*Peer
+
+	randMu sync.Mutex
+	rand   *rand.Rand
+
+	// 丢包概率和自定义的丢包规则
+	dropRate float64
+	drop     func(from, to *model.PubKey, topic string) bool
+	// 固定延迟和随机抖动，抖动大于 0 时消息会乱序到达
+	delay  time.Duration
+	jitter time.Duration
+	// 节点所在的分区，不同分区之间的消息丢弃
+	partition map[string]int
+
+	delivered atomic.Int64
+	dropped   atomic.Int64
+}
+
+// NewMemNetwork 创建一个没有故障的模拟网络
+func NewMemNetwork() *Network {
+	return &Network{
+		peers: make(map[string]*Peer),
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// NewPeer 在网络中加入节点，相同私钥的节点会替换之前的节点
+func (n *Network) NewPeer(priv *model.PrivKey, nodes []*model.PubKey) *Peer {
+	id := priv.GetPublic().String()
+
+	// 创建 P2P 网络实例
+	peer := &Peer{
+		id:       id,
+		net:      n,
+		privKey:  priv.PrivateKey,
+		nodes:    nodes,
+		handlers: make(map[string]func(any) error),
+		callBack: func(ty string) error {
+			fmt.Println("::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::: netHook not found error")
+			return nil
+		},
+		version: 1,
+	}
+
+	n.mu.Lock()
+	n.peers[id] = peer
+	n.mu.Unlock()
+
+	return peer
+}
+
+// SetSeed 设置丢包和抖动使用的随机数种子，使故障可以复现
+func (n *Network) SetSeed(seed int64) {
+	n.randMu.Lock()
+	defer n.randMu.Unlock()
+	n.rand = rand.New(rand.NewSource(seed))
+}
+
+// SetDropRate 按概率丢弃消息，0 为不丢包
+func (n *Network) SetDropRate(rate float64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.dropRate = rate
+}
+
+// SetDropFilter 丢弃 filter 返回 true 的消息，nil 为取消
+func (n *Network) SetDropFilter(filter func(from, to *model.PubKey, topic string) bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.drop = filter
+}
+
+// SetDelay 每条消息延迟 delay 加上 [0, jitter) 的随机时间后到达，jitter 大于 0 时消息乱序
+func (n *Network) SetDelay(delay, jitter time.Duration) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.delay = delay
+	n.jitter = jitter
+}
+
+// Partition 把节点分成多个分区，分区之间不能通信，未列出的节点在同一个分区中
+func (n *Network) Partition(groups ...[]*model.PubKey) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.partition = make(map[string]int)
+	for i, group := range groups {
+		for _, node := range group {
+			n.partition[node.String()] = i + 1
+		}
+	}
+}
+
+// Heal 取消网络分区
+func (n *Network) Heal() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.partition = nil
+}
+
+// Crash 节点崩溃：不再收发消息，延迟中的消息丢弃，所有订阅清空
+func (n *Network) Crash(node *model.PubKey) {
+	if peer := n.peer(node.String()); peer != nil {
+		peer.crash()
+	}
+}
+
+// Restart 崩溃的节点重新收发消息，节点需要重新订阅
+func (n *Network) Restart(node *model.PubKey) {
+	if peer := n.peer(node.String()); peer != nil {
+		peer.restart()
+	}
+}
+
+// Stats 已投递和丢弃的消息数量
+func (n *Network) Stats() (delivered, dropped int64) {
+	return n.delivered.Load(), n.dropped.Load()
+}
+
+func (n *Network) peer(id string) *Peer {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.peers[id]
+}
+
+// deliver 按当前的故障配置异步投递一条消息
+func (n *Network) deliver(from, to, topic string, message any) {
+	n.mu.RLock()
+	sender := n.peers[from]
+	peer := n.peers[to]
+	drop := peer == nil || (sender != nil && sender.isCrashed())
+	if !drop && n.partition != nil && n.partition[from] != n.partition[to] {
+		drop = true
+	}
+	if !drop && n.drop != nil {
+		drop = n.drop(pubKeyFromID(from), pubKeyFromID(to), topic)
+	}
+	if !drop && n.dropRate > 0 {
+		drop = n.float64() < n.dropRate
+	}
+	delay := n.delay
+	if n.jitter > 0 {
+		delay += time.Duration(n.int63n(int64(n.jitter)))
+	}
+	n.mu.RUnlock()
+
+	if drop {
+		n.dropped.Add(1)
+		return
+	}
+
+	// 和 bft-brigde 一样由网络填写发送节点，每个接收节点使用消息的副本
+	message = withFrom(message, from)
+	go func() {
+		if delay > 0 {
+			time.Sleep(delay)
+		}
+		if peer.receive(topic, message) {
+			n.delivered.Add(1)
+		} else {
+			n.dropped.Add(1)
+		}
+	}()
+}
+
+// withFrom 复制消息并设置发送节点
+func withFrom(message any, from string) any {
+	m, ok := message.(proto.Message)
+	if !ok {
+		return message
+	}
+	switch msg := proto.Clone(m).(type) {
+	case *model.DkgMessage:
+		msg.From = from
+		return msg
+	case *model.BlockPartialSign:
+		msg.From = from
+		return msg
+	case *model.SecretBox:
+		msg.From = from
+		return msg
+	case *model.BlobBox:
+		msg.From = from
+		return msg
+	case *model.SignBox:
+		msg.From = from
+		return msg
+	default:
+		return msg
+	}
+}
+
+func (n *Network) float64() float64 {
+	n.randMu.Lock()
+	defer n.randMu.Unlock()
+	return n.rand.Float64()
+}
+
+func (n *Network) int63n(max int64) int64 {
+	n.randMu.Lock()
+	defer n.randMu.Unlock()
+	return n.rand.Int63n(max)
+}
+
+func (p *Peer) isCrashed() bool {
+	p.runMu.RLock()
+	defer p.runMu.RUnlock()
+	return p.crashed
+}
+
+func pubKeyFromID(id string) *model.PubKey {
+	pub, err := model.PubKeyFromHex(id)
+	if err != nil {
+		return nil
+	}
+	return pub
+}
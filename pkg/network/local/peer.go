@@ -5,89 +5,78 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/wetee-dao/tee-dsecret/pkg/model"
 	"go.dedis.ch/kyber/v4"
 )
 
 var (
-	peers = make(map[string]*Peer)
+	// 默认网络，NewNetwork 创建的节点都在这个网络中
+	defaultNetwork = NewMemNetwork()
 )
 
 func NewNetwork(priv *model.PrivKey, boots []string, nodes []*model.PubKey, tcp, udp uint32) (*Peer, error) {
-	id := priv.GetPublic().String()
-
-	// 创建 P2P 网络实例
-	peer := &Peer{
-		id:       id,
-		privKey:  priv.PrivateKey,
-		nodes:    nodes,
-		handlers: make(map[string]func(any) error),
-		callBack: func(ty string) error {
-			fmt.Println("::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::: netHook not found error")
-			return nil
-		},
-		version: 1,
-	}
-
-	peers[id] = peer
-
-	return peer, nil
+	return defaultNetwork.NewPeer(priv, nodes), nil
 }
 
 type Peer struct {
 	id         string
+	net        *Network
 	privKey    ed25519.PrivateKey
 	nodes      []*model.PubKey
 	handlers   map[string]func(any) error
 	callBack   func(string) error
 	version    uint32
 	PreCommits []kyber.Point
+
+	mu sync.RWMutex
+	// 处理中的消息持有读锁，节点崩溃时等待处理中的消息完成
+	runMu   sync.RWMutex
+	crashed bool
 }
 
 func (p *Peer) Send(to *model.To, message any) error {
-	var topic string
-	switch message.(type) {
-	case *model.DkgMessage:
-		topic = "dkg"
-	case *model.BlockPartialSign:
-		topic = "block-partial-sign"
-	default:
-		return errors.New("unknown message type")
+	topic, err := messageTopic(message)
+	if err != nil {
+		return err
 	}
 
 	// util.LogSendmsg(">>>>>> P2P Send()", "to", node.String(), "-", node.SS58(), "| type:", topic+"."+message.Type)
 	switch to.Payload.(type) {
 	case *model.To_Node:
-		peer := peers[hex.EncodeToString(to.GetNode())]
-		if handler, ok := peer.handlers[topic]; ok {
-			go handler(message)
-		} else {
-			fmt.Println("handler not found for topic: ", topic, "node", to)
-		}
+		p.net.deliver(p.id, hex.EncodeToString(to.GetNode()), topic, message)
 	case *model.To_Nodes:
-		for _, node := range p.nodes {
-			peer := peers[node.String()]
-			if handler, ok := peer.handlers[topic]; ok {
-				go handler(message)
-			} else {
-				fmt.Println("handler not found for topic: ", topic, "node", node)
-			}
+		for _, node := range to.GetNodes().GetL() {
+			p.net.deliver(p.id, hex.EncodeToString(node), topic, message)
 		}
 	case *model.To_Broadcast:
-		for _, node := range p.nodes {
-			peer := peers[node.String()]
-			if handler, ok := peer.handlers[topic]; ok {
-				go handler(message)
-			} else {
-				fmt.Println("handler not found for topic: ", topic, "node", node)
-			}
+		for _, node := range p.AllNodes() {
+			p.net.deliver(p.id, node.String(), topic, message)
 		}
 	}
 
 	return nil
 }
 
+// messageTopic 消息类型对应的 topic，和 bft-brigde 使用的通道一致
+func messageTopic(message any) (string, error) {
+	switch message.(type) {
+	case *model.DkgMessage:
+		return "dkg", nil
+	case *model.BlockPartialSign:
+		return "block-partial-sign", nil
+	case *model.BlobBox:
+		return "blob", nil
+	case *model.SignBox:
+		return "sign", nil
+	case *model.SecretBox:
+		return "secret", nil
+	default:
+		return "", errors.New("unknown message type")
+	}
+}
+
 func (p *Peer) PeerID() string {
 	return p.id
 }
@@ -97,18 +86,64 @@ func (p *Peer) Pub(topic string, data []byte) error {
 }
 
 func (p *Peer) Sub(topic string, handler func(any) error) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	p.handlers[topic] = handler
 	return nil
 }
 
 func (p *Peer) AvailableNodes() []*model.PubKey {
-	return p.nodes
+	return p.AllNodes()
 }
 
 func (p *Peer) AllNodes() []*model.PubKey {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 	return p.nodes
 }
 
 func (p *Peer) SetNodes(nodes []*model.PubKey) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	p.nodes = nodes
 }
+
+func (p *Peer) handler(topic string) func(any) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.handlers[topic]
+}
+
+// receive 处理一条消息，节点已崩溃时丢弃
+func (p *Peer) receive(topic string, message any) bool {
+	p.runMu.RLock()
+	defer p.runMu.RUnlock()
+	if p.crashed {
+		return false
+	}
+
+	handler := p.handler(topic)
+	if handler == nil {
+		fmt.Println("handler not found for topic: ", topic, "node", p.id)
+		return false
+	}
+	handler(message)
+	return true
+}
+
+// crash 节点崩溃，等待处理中的消息完成后清空所有订阅，重启后需要重新订阅
+func (p *Peer) crash() {
+	p.runMu.Lock()
+	defer p.runMu.Unlock()
+	p.crashed = true
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.handlers = make(map[string]func(any) error)
+}
+
+func (p *Peer) restart() {
+	p.runMu.Lock()
+	defer p.runMu.Unlock()
+	p.crashed = false
+}
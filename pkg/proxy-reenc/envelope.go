@@ -0,0 +1,143 @@
+package proxy_reenc
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/suites"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// DataKeySize is the size of the symmetric data key of an envelope
+const DataKeySize = chacha20poly1305.KeySize
+
+// EncryptEnvelope encrypts data with a random data key (XChaCha20-Poly1305),
+// and encrypts the data key with the aggregate public key of the DKG.
+//
+// Only the data key is re-encrypted, so the re-encryption cost does not
+// depend on the size of data.
+//
+// Output:
+//
+//	encCmt  - Schnorr commit (rG)
+//	encKey  - Encrypted data key slices (rsG + Ki)
+//	payload - nonce || AEAD ciphertext of data
+func EncryptEnvelope(
+	ste suites.Suite,
+	dkgPk kyber.Point,
+	data []byte,
+) (
+	encCmt kyber.Point,
+	encKey []kyber.Point,
+	payload []byte,
+	err error,
+) {
+	return encryptEnvelope(ste, dkgPk, data, ste.Scalar().Pick(ste.RandomStream()))
+}
+
+// encryptEnvelope is EncryptEnvelope with the given Schnorr nonce (r)
+func encryptEnvelope(
+	ste suites.Suite,
+	dkgPk kyber.Point,
+	data []byte,
+	r kyber.Scalar,
+) (
+	encCmt kyber.Point,
+	encKey []kyber.Point,
+	payload []byte,
+	err error,
+) {
+	key := make([]byte, DataKeySize)
+	if _, err = rand.Read(key); err != nil {
+		return nil, nil, nil, fmt.Errorf("generate data key: %w", err)
+	}
+
+	payload, err = SealPayload(key, data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	encCmt, encKey = encryptSecret(ste, dkgPk, key, r)
+	return encCmt, encKey, payload, nil
+}
+
+// DecryptEnvelope decrypts the data key with the reader's secret key and opens the payload.
+// An empty payload means the secret was embedded into the points directly, it is returned as is.
+func DecryptEnvelope(
+	ste suites.Suite,
+	encKey []kyber.Point,
+	dkgPk kyber.Point,
+	xncCmt kyber.Point,
+	rdrSk kyber.Scalar,
+	payload []byte,
+) ([]byte, error) {
+	key, err := DecryptSecret(ste, encKey, dkgPk, xncCmt, rdrSk)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) == 0 {
+		return key, nil
+	}
+
+	return OpenPayload(key, payload)
+}
+
+// OpenEnvelope decrypts an envelope encrypted to the public key of sk directly, without re-encryption.
+func OpenEnvelope(
+	ste suites.Suite,
+	encCmt kyber.Point,
+	encKey []kyber.Point,
+	sk kyber.Scalar,
+	payload []byte,
+) ([]byte, error) {
+	rsG := ste.Point().Mul(sk, encCmt) // rsG = s * rG
+
+	var key []byte
+	for _, k := range encKey {
+		keyi, err := ste.Point().Sub(k, rsG).Data()
+		if err != nil {
+			return nil, fmt.Errorf("extract key share from key point: %w", err)
+		}
+		key = append(key, keyi...)
+	}
+	if len(payload) == 0 {
+		return key, nil
+	}
+
+	return OpenPayload(key, payload)
+}
+
+// SealPayload encrypts data with the data key, returns nonce || ciphertext
+func SealPayload(key []byte, data []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, fmt.Errorf("new aead: %w", err)
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// OpenPayload decrypts a payload sealed by SealPayload
+func OpenPayload(key []byte, payload []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, fmt.Errorf("new aead: %w", err)
+	}
+	if len(payload) < aead.NonceSize()+aead.Overhead() {
+		return nil, errors.New("payload too short")
+	}
+
+	nonce, ciphertext := payload[:aead.NonceSize()], payload[aead.NonceSize():]
+	data, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("open payload: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,130 @@
+package proxy_reenc
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+// Labeled envelopes are decrypted publicly by the network (disclosures and encrypted txs).
+//
+// Like TDH2, the encryptor proves knowledge of the Schnorr nonce (r) of encCmt (rG),
+// and the proof is bound to a label and to the whole ciphertext:
+//
+//	W = wG
+//	c = Hash(label, encCmt, W, encScrt, payload)
+//	z = w + c * r
+//
+// Verify:
+//
+//	W = zG - c * rG
+//
+// Without r, an existing ciphertext (e.g. a stored secret) can not be submitted
+// with a new label, so public decryption can not be used as a decryption oracle.
+
+// SealLabeledEnvelope encrypts data like EncryptEnvelope, the proof is bound to the label.
+func SealLabeledEnvelope(
+	ste suites.Suite,
+	dkgPk kyber.Point,
+	data []byte,
+	label []byte,
+) (*model.SecretStore, error) {
+	r := ste.Scalar().Pick(ste.RandomStream())
+	encCmt, encKey, payload, err := encryptEnvelope(ste, dkgPk, data, r)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &model.SecretStore{Payload: payload}
+	secret.RawEncCmt, err = encCmt.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal encCmt: %w", err)
+	}
+	for _, k := range encKey {
+		rawKey, err := k.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("marshal encScrt: %w", err)
+		}
+		secret.RawEncScrt = append(secret.RawEncScrt, rawKey)
+	}
+
+	w := ste.Scalar().Pick(ste.RandomStream())
+	c, err := labelChallenge(ste, secret, label, ste.Point().Mul(w, nil))
+	if err != nil {
+		return nil, err
+	}
+	z := ste.Scalar().Add(w, ste.Scalar().Mul(c, r)) // z = w + c * r
+
+	rawC, err := c.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	rawZ, err := z.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	secret.Proof = append(rawC, rawZ...)
+	return secret, nil
+}
+
+// VerifyLabel verifies the proof of knowledge of r of a labeled envelope.
+func VerifyLabel(ste suites.Suite, secret *model.SecretStore, label []byte) error {
+	if len(secret.Proof) != 2*ste.ScalarLen() {
+		return errors.New("invalid label proof")
+	}
+	c := ste.Scalar()
+	if err := c.UnmarshalBinary(secret.Proof[:ste.ScalarLen()]); err != nil {
+		return fmt.Errorf("unmarshal challenge: %w", err)
+	}
+	z := ste.Scalar()
+	if err := z.UnmarshalBinary(secret.Proof[ste.ScalarLen():]); err != nil {
+		return fmt.Errorf("unmarshal proof: %w", err)
+	}
+	encCmt := ste.Point()
+	if err := encCmt.UnmarshalBinary(secret.RawEncCmt); err != nil {
+		return fmt.Errorf("unmarshal encCmt: %w", err)
+	}
+
+	// W = zG - c * rG
+	w := ste.Point().Sub(ste.Point().Mul(z, nil), ste.Point().Mul(c, encCmt))
+	chlg, err := labelChallenge(ste, secret, label, w)
+	if err != nil {
+		return err
+	}
+	if !chlg.Equal(c) {
+		return errors.New("invalid label proof")
+	}
+	return nil
+}
+
+// labelChallenge c = Hash(label, encCmt, W, encScrt, payload)
+func labelChallenge(ste suites.Suite, secret *model.SecretStore, label []byte, w kyber.Point) (kyber.Scalar, error) {
+	h := sha256.New()
+	writeField(h, []byte("wetee/labeled-envelope"))
+	writeField(h, label)
+	writeField(h, secret.RawEncCmt)
+	rawW, err := w.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal point: %w", err)
+	}
+	writeField(h, rawW)
+	for _, k := range secret.RawEncScrt {
+		writeField(h, k)
+	}
+	writeField(h, secret.Payload)
+	return ste.Scalar().SetBytes(h.Sum(nil)), nil
+}
+
+// writeField writes a length prefixed field
+func writeField(h hash.Hash, b []byte) {
+	var l [8]byte
+	binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+	h.Write(l[:])
+	h.Write(b)
+}
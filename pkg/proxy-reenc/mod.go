@@ -316,6 +316,19 @@ func EncryptSecret(
 	encScrt []kyber.Point,
 ) {
 	r := ste.Scalar().Pick(ste.RandomStream())
+	return encryptSecret(ste, dkgPk, scrt, r)
+}
+
+// encryptSecret encrypts a secret with the given Schnorr nonce (r)
+func encryptSecret(
+	ste suites.Suite,
+	dkgPk kyber.Point,
+	scrt []byte,
+	r kyber.Scalar,
+) (
+	encCmt kyber.Point,
+	encScrt []kyber.Point,
+) {
 	encCmt = ste.Point().Mul(r, nil) // rG = r * G
 	rsG := ste.Point().Mul(r, dkgPk) // rsG = r * sG
 
@@ -153,3 +153,117 @@ func TestReencryptAndVerify(t *testing.T) {
 	require.NoErrorf(t, err, "failed to decode key")
 	require.Equal(t, scrt, scrtHat)
 }
+
+func TestEnvelopeReencrypt(t *testing.T) {
+	var (
+		n     = 5 //参与者的数量
+		th    = 3 // 门限值
+		suite = suites.MustFind("Ed25519")
+
+		s       = suite.Scalar().Pick(suite.RandomStream())
+		priPoly = share.NewPriPoly(suite, th, s, suite.RandomStream())
+		pubPoly = priPoly.Commit(nil)
+
+		dkgCommit = pubPoly.Commit() // DKG 公钥
+
+		clientPriv = suite.Scalar().Pick(suite.RandomStream())
+		clientPub  = suite.Point().Mul(clientPriv, nil)
+	)
+
+	// 1MB secret file
+	data := make([]byte, 1<<20)
+	random.Bytes(data, random.New())
+
+	encCmt, encKey, payload, err := EncryptEnvelope(suite, dkgCommit, data)
+	require.NoError(t, err)
+	// data key 只需要两个点
+	require.Len(t, encKey, 2)
+
+	var pubShares []*share.PubShare
+	for idx := range n {
+		dkgSki := priPoly.Eval(uint32(idx)).V
+		xncSki, _, _, err := reencrypt(suite, dkgSki, clientPub, encCmt)
+		require.NoError(t, err)
+		pubShares = append(pubShares, &share.PubShare{I: uint32(idx), V: xncSki})
+	}
+	xncCmt, err := share.RecoverCommit(suite, pubShares, th, n)
+	require.NoError(t, err)
+
+	dataHat, err := DecryptEnvelope(suite, encKey, dkgCommit, xncCmt, clientPriv, payload)
+	require.NoError(t, err)
+	require.Equal(t, data, dataHat)
+
+	// tampered payload
+	payload[len(payload)-1] ^= 1
+	_, err = DecryptEnvelope(suite, encKey, dkgCommit, xncCmt, clientPriv, payload)
+	require.Error(t, err)
+}
+
+func TestOpenEnvelope(t *testing.T) {
+	suite := suites.MustFind("Ed25519")
+	sk := suite.Scalar().Pick(suite.RandomStream())
+	pk := suite.Point().Mul(sk, nil)
+
+	data := []byte("recovery piece")
+	encCmt, encKey, payload, err := EncryptEnvelope(suite, pk, data)
+	require.NoError(t, err)
+
+	dataHat, err := OpenEnvelope(suite, encCmt, encKey, sk, payload)
+	require.NoError(t, err)
+	require.Equal(t, data, dataHat)
+
+	// wrong key
+	other := suite.Scalar().Pick(suite.RandomStream())
+	_, err = OpenEnvelope(suite, encCmt, encKey, other, payload)
+	require.Error(t, err)
+}
+
+func TestLabeledEnvelope(t *testing.T) {
+	suite := suites.MustFind("Ed25519")
+	sk := suite.Scalar().Pick(suite.RandomStream())
+	pk := suite.Point().Mul(sk, nil)
+
+	data := []byte("disclosed later")
+	label := []byte("label")
+	secret, err := SealLabeledEnvelope(suite, pk, data, label)
+	require.NoError(t, err)
+	require.NoError(t, VerifyLabel(suite, secret, label))
+
+	// 证明绑定 label
+	require.Error(t, VerifyLabel(suite, secret, []byte("other")))
+
+	encCmt := suite.Point()
+	require.NoError(t, encCmt.UnmarshalBinary(secret.RawEncCmt))
+	var encKey []kyber.Point
+	for _, raw := range secret.RawEncScrt {
+		k := suite.Point()
+		require.NoError(t, k.UnmarshalBinary(raw))
+		encKey = append(encKey, k)
+	}
+	dataHat, err := OpenEnvelope(suite, encCmt, encKey, sk, secret.Payload)
+	require.NoError(t, err)
+	require.Equal(t, data, dataHat)
+
+	// 证明绑定整个密文
+	tampered := *secret
+	tampered.Payload = append([]byte{}, secret.Payload...)
+	tampered.Payload[0] ^= 1
+	require.Error(t, VerifyLabel(suite, &tampered, label))
+
+	// 不知道 r 的密文不能附加其他密文的证明
+	other, _, payload, err := EncryptEnvelope(suite, pk, data)
+	require.NoError(t, err)
+	rawCmt, err := other.MarshalBinary()
+	require.NoError(t, err)
+	stolen := &model.SecretStore{
+		RawEncCmt:  rawCmt,
+		RawEncScrt: secret.RawEncScrt,
+		Payload:    payload,
+		Proof:      secret.Proof,
+	}
+	require.Error(t, VerifyLabel(suite, stolen, label))
+
+	// 没有证明
+	secret.Proof = nil
+	require.Error(t, VerifyLabel(suite, secret, label))
+}
@@ -0,0 +1,197 @@
+package proxy_reenc
+
+import (
+	"errors"
+	"fmt"
+
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+)
+
+// Key switching moves a secret from one DKG key (s1) to another (s2) without
+// decrypting it. The Schnorr commit (rG) is kept, only the encrypted key slices change:
+//
+//	encScrt' = encScrt + (s2 - s1) * rG
+//	         = rs1G + K + rs2G - rs1G
+//	         = rs2G + K
+//
+// A fixed set of members holds shares of both keys (index i1 of s1, index i2 of s2).
+// For the set, member j computes
+//
+//	xj = l2j * s2j - l1j * s1j   (l1j, l2j - lagrange coefficients at 0 of the set)
+//	Dj = xj * rG
+//
+// and proves log_G(Yj) = log_rG(Dj) where Yj = l2j * c2j - l1j * c1j is computed
+// from the commitments of both keys, so anyone can verify Dj.
+//
+//	Sum(Dj) = (s2 - s1) * rG
+//
+// Dj never reveals s1 * rG or s2 * rG, no node learns the key K.
+
+// SwitchMember share indices of a member in the old and the new key
+type SwitchMember struct {
+	From uint32
+	To   uint32
+}
+
+// SwitchShare is the key switching share of a member for one secret
+type SwitchShare struct {
+	V         kyber.Point  // Dj
+	Challenge kyber.Scalar // random oracle challenge
+	Proof     kyber.Scalar // nizk proof of Dj
+}
+
+// SwitchKeyShare computes the key switching share of member j of the set.
+// from and to are the private shares of the member in the old and the new key.
+func SwitchKeyShare(
+	ste suites.Suite,
+	members []SwitchMember,
+	j int,
+	from *share.PriShare,
+	to *share.PriShare,
+	encCmt kyber.Point,
+) (*SwitchShare, error) {
+	if j < 0 || j >= len(members) || members[j].From != from.I || members[j].To != to.I {
+		return nil, errors.New("share is not a member of the switching set")
+	}
+	l1, l2, err := switchCoeffs(ste, members, j)
+	if err != nil {
+		return nil, err
+	}
+
+	// xj = l2j * s2j - l1j * s1j
+	xj := ste.Scalar().Sub(ste.Scalar().Mul(l2, to.V), ste.Scalar().Mul(l1, from.V))
+	yj := ste.Point().Mul(xj, nil)
+	dj := ste.Point().Mul(xj, encCmt)
+
+	// ej = Hash(rG, Yj, Dj, wG, w * rG)
+	// fj = w + ej * xj
+	w := ste.Scalar().Pick(ste.RandomStream())
+	chlg, err := switchChallenge(ste, encCmt, yj, dj, ste.Point().Mul(w, nil), ste.Point().Mul(w, encCmt))
+	if err != nil {
+		return nil, err
+	}
+	proof := ste.Scalar().Add(w, ste.Scalar().Mul(chlg, xj))
+
+	return &SwitchShare{V: dj, Challenge: chlg, Proof: proof}, nil
+}
+
+// VerifySwitchShare verifies the key switching share of member j against the commitments of both keys.
+func VerifySwitchShare(
+	ste suites.Suite,
+	fromCmt *share.PubPoly,
+	toCmt *share.PubPoly,
+	members []SwitchMember,
+	j int,
+	encCmt kyber.Point,
+	sh *SwitchShare,
+) error {
+	if j < 0 || j >= len(members) {
+		return errors.New("invalid member")
+	}
+	l1, l2, err := switchCoeffs(ste, members, j)
+	if err != nil {
+		return err
+	}
+
+	// Yj = l2j * c2j - l1j * c1j
+	yj := ste.Point().Sub(
+		ste.Point().Mul(l2, toCmt.Eval(members[j].To).V),
+		ste.Point().Mul(l1, fromCmt.Eval(members[j].From).V),
+	)
+
+	// wG      = fj * G  - ej * Yj
+	// w * rG  = fj * rG - ej * Dj
+	wG := ste.Point().Sub(ste.Point().Mul(sh.Proof, nil), ste.Point().Mul(sh.Challenge, yj))
+	wrG := ste.Point().Sub(ste.Point().Mul(sh.Proof, encCmt), ste.Point().Mul(sh.Challenge, sh.V))
+	chlg, err := switchChallenge(ste, encCmt, yj, sh.V, wG, wrG)
+	if err != nil {
+		return err
+	}
+	if !chlg.Equal(sh.Challenge) {
+		return errors.New("failed verification")
+	}
+	return nil
+}
+
+// SwitchKey verifies the shares of all members and switches the encrypted key slices to the new key.
+// The set must reach the threshold of both keys.
+func SwitchKey(
+	ste suites.Suite,
+	fromCmt *share.PubPoly,
+	toCmt *share.PubPoly,
+	members []SwitchMember,
+	encCmt kyber.Point,
+	encScrt []kyber.Point,
+	shares []*SwitchShare,
+) ([]kyber.Point, error) {
+	if len(members) < fromCmt.Threshold() || len(members) < toCmt.Threshold() {
+		return nil, fmt.Errorf("switching set %d is below threshold", len(members))
+	}
+	if len(shares) != len(members) {
+		return nil, fmt.Errorf("got %d shares for %d members", len(shares), len(members))
+	}
+
+	delta := ste.Point().Null()
+	for j, sh := range shares {
+		if sh == nil {
+			return nil, fmt.Errorf("missing share of member %d", j)
+		}
+		if err := VerifySwitchShare(ste, fromCmt, toCmt, members, j, encCmt, sh); err != nil {
+			return nil, fmt.Errorf("share of member %d: %w", j, err)
+		}
+		delta.Add(delta, sh.V)
+	}
+
+	switched := make([]kyber.Point, len(encScrt))
+	for i, k := range encScrt {
+		switched[i] = ste.Point().Add(k, delta)
+	}
+	return switched, nil
+}
+
+// switchCoeffs lagrange coefficients at 0 of member j in the old and the new key
+func switchCoeffs(ste suites.Suite, members []SwitchMember, j int) (kyber.Scalar, kyber.Scalar, error) {
+	from := make([]uint32, len(members))
+	to := make([]uint32, len(members))
+	for i, m := range members {
+		from[i], to[i] = m.From, m.To
+	}
+	l1, err := lagrangeAtZero(ste, from, j)
+	if err != nil {
+		return nil, nil, err
+	}
+	l2, err := lagrangeAtZero(ste, to, j)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l1, l2, nil
+}
+
+// lagrangeAtZero lagrange coefficient at 0 of share index idx[j], share i is evaluated at x = i + 1
+func lagrangeAtZero(ste suites.Suite, idx []uint32, j int) (kyber.Scalar, error) {
+	xj := ste.Scalar().SetInt64(int64(idx[j]) + 1)
+	num := ste.Scalar().One()
+	den := ste.Scalar().One()
+	for m, i := range idx {
+		if m == j {
+			continue
+		}
+		if i == idx[j] {
+			return nil, fmt.Errorf("duplicate share index %d", i)
+		}
+		xm := ste.Scalar().SetInt64(int64(i) + 1)
+		num.Mul(num, xm)
+		den.Mul(den, ste.Scalar().Sub(xm, xj))
+	}
+	return num.Div(num, den), nil
+}
+
+func switchChallenge(ste suites.Suite, points ...kyber.Point) (kyber.Scalar, error) {
+	b, err := hashPoints(points...)
+	if err != nil {
+		return nil, err
+	}
+	return ste.Scalar().SetBytes(b), nil
+}
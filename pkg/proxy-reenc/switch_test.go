@@ -0,0 +1,61 @@
+package proxy_reenc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+	"go.dedis.ch/kyber/v4/util/random"
+)
+
+func TestSwitchKey(t *testing.T) {
+	suite := suites.MustFind("Ed25519")
+
+	// 旧密钥 5 个节点门限 3，新密钥 4 个节点门限 2
+	fromPri := share.NewPriPoly(suite, 3, nil, suite.RandomStream())
+	fromPub := fromPri.Commit(nil)
+	toPri := share.NewPriPoly(suite, 2, nil, suite.RandomStream())
+	toPub := toPri.Commit(nil)
+
+	scrt := make([]byte, 48)
+	random.Bytes(scrt, random.New())
+	encCmt, encScrt := EncryptSecret(suite, fromPub.Commit(), scrt)
+
+	// 成员在两个密钥中的序号不同
+	members := []SwitchMember{{From: 0, To: 3}, {From: 2, To: 1}, {From: 4, To: 0}}
+	shares := make([]*SwitchShare, len(members))
+	for j, m := range members {
+		sh, err := SwitchKeyShare(suite, members, j, fromPri.Eval(m.From), toPri.Eval(m.To), encCmt)
+		require.NoError(t, err)
+		shares[j] = sh
+	}
+
+	switched, err := SwitchKey(suite, fromPub, toPub, members, encCmt, encScrt, shares)
+	require.NoError(t, err)
+
+	// 使用新密钥解密：rs2G = s2 * rG
+	xncCmt := suite.Point().Mul(toPri.Secret(), encCmt)
+	got, err := DecryptSecret(suite, switched, toPub.Commit(), xncCmt, suite.Scalar().Zero())
+	require.NoError(t, err)
+	require.Equal(t, scrt, got)
+
+	// 份额不属于成员
+	_, err = SwitchKeyShare(suite, members, 0, fromPri.Eval(1), toPri.Eval(3), encCmt)
+	require.Error(t, err)
+
+	// 篡改的份额
+	bad := *shares[1]
+	bad.V = suite.Point().Pick(suite.RandomStream())
+	_, err = SwitchKey(suite, fromPub, toPub, members, encCmt, encScrt, []*SwitchShare{shares[0], &bad, shares[2]})
+	require.Error(t, err)
+
+	// 低于旧密钥的门限
+	_, err = SwitchKey(suite, fromPub, toPub, members[:2], encCmt, encScrt, shares[:2])
+	require.Error(t, err)
+
+	// 重复的序号
+	dup := []SwitchMember{{From: 0, To: 3}, {From: 0, To: 1}, {From: 4, To: 0}}
+	_, err = SwitchKey(suite, fromPub, toPub, dup, encCmt, encScrt, shares)
+	require.Error(t, err)
+}
@@ -0,0 +1,359 @@
+package sim
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/chains"
+	"github.com/wetee-dao/tee-dsecret/pkg/chains/mock"
+	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/network/local"
+	sidechain "github.com/wetee-dao/tee-dsecret/side-chain"
+)
+
+// 进程内的多节点模拟
+// Cluster 在一个进程中运行 N 个节点，每个节点运行 DKG 和侧链的消息处理（重加密、部分签名、blob 和门限签名），
+// 节点之间的消息都通过 local.Network 投递，可以注入丢包、延迟、乱序、分区和节点崩溃重启，
+// 在 go test 中测试 epoch 切换、重加密和主链交易的同步
+// 主链使用 mock.Chain 并设置为 chains.MainChain，DKG 使用 dkg.WithoutEpochSign，新 epoch 不需要主链交易的部分签名
+// 侧链状态保存在进程全局的 model.DBINS 中，同一进程不能运行多个 BFT 节点，集群代替共识按顺序出块，
+// 每个运行中的节点的侧链分别执行区块（写入相同的共用状态），崩溃的节点重启后执行错过的区块
+// 使用前需要调用 model.NewDB 打开数据库，关闭数据库前调用 Stop
+
+// 等待集群操作完成的默认时间
+var DefaultTimeout = 10 * time.Second
+
+// 签名和重加密请求没有完成时重新发送的间隔
+var RetryInterval = 200 * time.Millisecond
+
+// Node 集群中的一个节点
+type Node struct {
+	Index     int
+	Priv      *model.PrivKey
+	Validator *model.Validator
+	Peer      *local.Peer
+	DKG       *dkg.DKG
+	Side      *sidechain.SideChain
+
+	cluster *Cluster
+	running atomic.Bool
+	// DKG 的消息循环已退出
+	exited chan struct{}
+	// 节点的侧链已执行的区块数
+	applied int
+}
+
+// Running 节点是否在运行
+func (n *Node) Running() bool {
+	return n.running.Load()
+}
+
+// DkgPubKey 节点的 DKG 当前的公钥，节点已停止时返回 nil
+func (n *Node) DkgPubKey() *model.PubKey {
+	var pub *model.PubKey
+	n.DKG.RunInLoop(func() { pub = n.DKG.DkgPubKey })
+	return pub
+}
+
+// ready 节点运行中且 DKG 已有份额
+func (n *Node) ready() bool {
+	ok := false
+	n.DKG.RunInLoop(func() { ok = n.DKG.DkgKeyShare != nil })
+	return n.Running() && ok
+}
+
+// switched 节点的 DKG 已完成 epoch 的轮次并切换到新份额
+func (n *Node) switched(epoch uint32) bool {
+	ok := false
+	n.DKG.RunInLoop(func() {
+		r := n.DKG.Round()
+		ok = n.DKG.DkgKeyShare != nil && r != nil && r.Epoch == epoch && r.Phase == dkg.RoundPhaseDone
+	})
+	return ok
+}
+
+// block 侧链打包的一个区块，每个节点的侧链分别执行
+type block func(side *sidechain.SideChain, txn *model.Txn) error
+
+// Cluster 进程内的模拟集群
+type Cluster struct {
+	Net     *local.Network
+	Main    *mock.Chain
+	Nodes   []*Node
+	Timeout time.Duration
+
+	// 已出的区块，只在调用集群方法的 goroutine 中使用
+	blocks []block
+	nextId atomic.Uint64
+}
+
+// NewCluster 创建 n 个节点的集群，节点还没有 DKG 密钥，需要调用 NextEpoch 开始第一个 epoch
+func NewCluster(n int) (*Cluster, error) {
+	priv, _, err := model.GenerateEd25519KeyPair(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Cluster{
+		Net:     local.NewMemNetwork(),
+		Main:    mock.NewChain(priv, mock.DefaultConfig()),
+		Timeout: DefaultTimeout,
+	}
+	chains.MainChain = c.Main
+	for range n {
+		if _, err := c.AddNode(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// AddNode 加入一个新节点并启动，新节点在下一次 NextEpoch 包含它时加入 DKG
+// 新节点和状态同步一样从当前的共用状态开始，不执行之前的区块
+func (c *Cluster) AddNode() (*Node, error) {
+	priv, pub, err := model.GenerateEd25519KeyPair(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &Node{
+		Index:     len(c.Nodes),
+		Priv:      priv,
+		Validator: &model.Validator{NodeID: uint64(len(c.Nodes)), ValidatorId: *pub, P2pId: *pub},
+		cluster:   c,
+		applied:   len(c.blocks),
+	}
+	c.Nodes = append(c.Nodes, node)
+
+	node.Peer = c.Net.NewPeer(priv, c.pubKeys())
+	for _, n := range c.Nodes {
+		n.Peer.SetNodes(c.pubKeys())
+	}
+
+	return node, c.startNode(node)
+}
+
+func (c *Cluster) pubKeys() []*model.PubKey {
+	keys := make([]*model.PubKey, 0, len(c.Nodes))
+	for _, n := range c.Nodes {
+		keys = append(keys, &n.Validator.P2pId)
+	}
+	return keys
+}
+
+// startNode 启动节点的 DKG 和侧链的消息处理，DKG 和未处理的部分签名从数据库恢复重启前的状态
+func (c *Cluster) startNode(node *Node) error {
+	d, err := dkg.NewDKG(node.Priv, node.Peer, dkg.NoLogger{}, dkg.WithoutEpochSign())
+	if err != nil {
+		return fmt.Errorf("node %d: %w", node.Index, err)
+	}
+	side, err := sidechain.NewSideChain(false, fmt.Sprintf("sim_tx_%d", node.Index))
+	if err != nil {
+		return fmt.Errorf("node %d: %w", node.Index, err)
+	}
+	side.SetDKG(d)
+	side.SetPeer(node.Peer)
+	node.DKG = d
+	node.Side = side
+
+	exited := make(chan struct{})
+	node.exited = exited
+	go func() {
+		d.Start()
+		close(exited)
+	}()
+
+	node.running.Store(true)
+	return nil
+}
+
+// Crash 节点崩溃，停止 DKG 和侧链的消息处理，不再收发消息
+// 等待 DKG 的消息循环退出后返回，之后不会再写入数据库
+func (c *Cluster) Crash(i int) {
+	node := c.Nodes[i]
+	if !node.running.Swap(false) {
+		return
+	}
+	c.Net.Crash(&node.Validator.P2pId)
+	node.DKG.Stop()
+	node.Side.Stop()
+	<-node.exited
+}
+
+// Restart 重启崩溃的节点，DKG 从持久化的状态恢复，侧链执行崩溃期间错过的区块
+func (c *Cluster) Restart(i int) error {
+	node := c.Nodes[i]
+	if node.running.Load() {
+		return nil
+	}
+	c.Net.Restart(&node.Validator.P2pId)
+	if err := c.startNode(node); err != nil {
+		return err
+	}
+	return c.catchUp(node)
+}
+
+// Stop 停止所有节点，关闭数据库前调用
+func (c *Cluster) Stop() {
+	for i := range c.Nodes {
+		c.Crash(i)
+	}
+}
+
+// commit 出块，所有运行中的节点的侧链执行区块并提交
+func (c *Cluster) commit(b block) error {
+	c.blocks = append(c.blocks, b)
+	for _, node := range c.Nodes {
+		if !node.Running() {
+			continue
+		}
+		if err := c.catchUp(node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// catchUp 节点的侧链按顺序执行还没有执行的区块
+func (c *Cluster) catchUp(node *Node) error {
+	for node.applied < len(c.blocks) {
+		txn := model.DBINS.NewTransaction()
+		if err := c.blocks[node.applied](node.Side, txn); err != nil {
+			txn.Rollback()
+			return fmt.Errorf("node %d block %d: %w", node.Index, node.applied, err)
+		}
+		if err := txn.Commit(); err != nil {
+			return fmt.Errorf("node %d block %d: %w", node.Index, node.applied, err)
+		}
+		node.applied++
+	}
+	return nil
+}
+
+// NextEpoch 使用 members 作为验证节点开始下一个 epoch，由第一个运行中的成员发起
+// 发起者收到足够的节点完成后主链切换 epoch，侧链打包 EpochEnd，每个节点的侧链切换到新份额
+func (c *Cluster) NextEpoch(members ...int) error {
+	var sponsor *Node
+	validators := make([]*model.Validator, 0, len(members))
+	for _, i := range members {
+		node := c.Nodes[i]
+		validators = append(validators, node.Validator)
+		if sponsor == nil && node.Running() {
+			sponsor = node
+		}
+	}
+	if sponsor == nil {
+		return errors.New("no running member")
+	}
+
+	epoch, _ := c.Main.Epoch()
+	epoch++
+
+	type result struct {
+		pub     *model.PubKey
+		commits []byte
+		err     error
+	}
+	done := make(chan result, 1)
+	finish := func(r result) {
+		select {
+		case done <- r:
+		default:
+		}
+	}
+	err := sponsor.DKG.TryEpochConsensus(model.ConsensusMsg{
+		Validators: validators,
+		Epoch:      epoch,
+		Policy:     model.DefaultThresholdPolicy(),
+	}, func(*dkg.DssSigner, uint64) {
+		// 回调在发起者的消息循环中执行，可以读取新的 DKG 公钥
+		commits, err := json.Marshal(sponsor.DKG.NewDkgKeyShare.CommitsWrap)
+		finish(result{pub: sponsor.DKG.NewDkgPubKey, commits: commits, err: err})
+	}, func(err error) {
+		finish(result{err: err})
+	})
+	if err != nil {
+		return fmt.Errorf("try epoch %d: %w", epoch, err)
+	}
+
+	var r result
+	select {
+	case r = <-done:
+		if r.err != nil {
+			return fmt.Errorf("epoch %d: %w", epoch, r.err)
+		}
+	case <-time.After(c.Timeout):
+		return fmt.Errorf("epoch %d: timeout", epoch)
+	}
+
+	// 主链切换 epoch，侧链打包 EpochEnd
+	c.Main.SetNextValidators(validators)
+	c.Main.ForceEpoch(r.pub.AccountID())
+
+	sideValidators := make([]*model.SideValidator, 0, len(validators))
+	for _, v := range validators {
+		sideValidators = append(sideValidators, &model.SideValidator{
+			Pubkey: v.ValidatorId.PublicKey,
+			Power:  1,
+			P2PId:  v.P2pId.Byte(),
+		})
+	}
+	end := &model.EpochEnd{
+		Epoch:      epoch,
+		Validators: sideValidators,
+		DkgPub:     r.pub.PublicKey,
+		DkgCommits: r.commits,
+	}
+	if err := c.commit(func(side *sidechain.SideChain, txn *model.Txn) error {
+		return side.SetEpoch(end, txn)
+	}); err != nil {
+		return fmt.Errorf("epoch %d: %w", epoch, err)
+	}
+	return nil
+}
+
+// Epoch 主链当前的 epoch、验证节点和侧链保存的 DKG 公钥
+func (c *Cluster) Epoch() (uint32, []*model.Validator, *model.PubKey) {
+	epoch, validators := c.Main.Epoch()
+	pub, err := sidechain.GetDkgPubkey()
+	if err != nil {
+		return epoch, validators, nil
+	}
+	return epoch, validators, model.PubKeyFromByte(pub[:])
+}
+
+// WaitEpoch 等待所有运行中的验证节点的 DKG 切换到主链当前的 epoch，延迟完成 DKG 的节点完成后切换
+func (c *Cluster) WaitEpoch() error {
+	epoch, validators := c.Main.Epoch()
+	deadline := time.Now().Add(c.Timeout)
+	for time.Now().Before(deadline) {
+		synced := true
+		for _, v := range validators {
+			node := c.Nodes[v.NodeID]
+			if node.Running() && !node.switched(epoch) {
+				synced = false
+				break
+			}
+		}
+		if synced {
+			return nil
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return fmt.Errorf("wait epoch %d: timeout", epoch)
+}
+
+// validatorNodes 当前 epoch 的验证节点
+func (c *Cluster) validatorNodes() []*Node {
+	_, validators := c.Main.Epoch()
+	nodes := make([]*Node, 0, len(validators))
+	for _, v := range validators {
+		nodes = append(nodes, c.Nodes[v.NodeID])
+	}
+	return nodes
+}
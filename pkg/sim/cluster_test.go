@@ -0,0 +1,145 @@
+package sim
+
+import (
+	"crypto/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+// newTestCluster n 个节点完成第一个 epoch 的集群，测试结束后停止节点并关闭数据库
+func newTestCluster(t *testing.T, n int) *Cluster {
+	os.RemoveAll("./chain_data")
+	db, err := model.NewDB()
+	require.NoError(t, err)
+
+	c, err := NewCluster(n)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		c.Stop()
+		db.Close()
+		os.RemoveAll("./chain_data")
+	})
+	c.Net.SetSeed(1)
+
+	members := make([]int, n)
+	for i := range members {
+		members[i] = i
+	}
+	require.NoError(t, c.NextEpoch(members...))
+	require.NoError(t, c.WaitEpoch())
+	return c
+}
+
+// testSecret 保存的 secret，收集者提交主链交易后重加密给 reader
+type testSecret struct {
+	owner  types.H160
+	index  uint64
+	data   []byte
+	reader *model.PrivKey
+}
+
+func putTestSecret(t *testing.T, c *Cluster) *testSecret {
+	reader, _, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	s := &testSecret{owner: types.H160{1}, data: []byte("secret"), reader: reader}
+	s.index, err = c.PutSecret(s.owner, s.data)
+	require.NoError(t, err)
+	return s
+}
+
+func (s *testSecret) upload() *model.TeeCall {
+	return &model.TeeCall{
+		Tx: &model.TeeCall_UploadSecret{UploadSecret: &model.UploadSecret{User: s.owner[:], Index: s.index}},
+	}
+}
+
+// check 收集者提交主链交易并重加密 secret
+func (s *testSecret) check(t *testing.T, c *Cluster, collector int) {
+	t.Helper()
+	_, err := c.SyncToHub(collector, s.upload())
+	require.NoError(t, err)
+	require.Contains(t, c.Main.Secrets(s.owner), s.index)
+
+	data, err := c.Reencrypt(collector, s.owner, s.index, s.reader)
+	require.NoError(t, err)
+	require.Equal(t, s.data, data)
+}
+
+func TestClusterEpoch(t *testing.T) {
+	c := newTestCluster(t, 4)
+
+	epoch, validators, pub := c.Epoch()
+	require.Equal(t, uint32(1), epoch)
+	require.Len(t, validators, 4)
+	require.Equal(t, pub.H160(), c.Main.SideChainPub())
+	for _, node := range c.Nodes {
+		require.Equal(t, pub.SS58(), node.DkgPubKey().SS58())
+	}
+	s := putTestSecret(t, c)
+
+	// 加入新节点切换 epoch，DKG 公钥不变，旧 secret 仍可解密
+	_, err := c.AddNode()
+	require.NoError(t, err)
+	require.NoError(t, c.NextEpoch(0, 1, 2, 3, 4))
+	require.NoError(t, c.WaitEpoch())
+	epoch, validators, newPub := c.Epoch()
+	require.Equal(t, uint32(2), epoch)
+	require.Len(t, validators, 5)
+	require.Equal(t, pub.SS58(), newPub.SS58())
+	require.Equal(t, pub.SS58(), c.Nodes[4].DkgPubKey().SS58())
+	s.check(t, c, 4)
+}
+
+func TestClusterLossyNetwork(t *testing.T) {
+	c := newTestCluster(t, 4)
+	s := putTestSecret(t, c)
+	s.check(t, c, 0)
+
+	// 延迟、乱序和丢包
+	c.Net.SetDelay(5*time.Millisecond, 20*time.Millisecond)
+	c.Net.SetDropRate(0.1)
+	for i := range c.Nodes {
+		s.check(t, c, i)
+	}
+	_, dropped := c.Net.Stats()
+	require.Greater(t, dropped, int64(0))
+}
+
+func TestClusterPartition(t *testing.T) {
+	c := newTestCluster(t, 4)
+	s := putTestSecret(t, c)
+
+	// 节点 3 被分区，剩余节点达到门限
+	c.Net.Partition([]*model.PubKey{&c.Nodes[3].Validator.P2pId})
+	s.check(t, c, 0)
+	c.Timeout = time.Second
+	_, err := c.SyncToHub(3, s.upload())
+	require.Error(t, err)
+	c.Timeout = DefaultTimeout
+
+	c.Net.Heal()
+	s.check(t, c, 3)
+}
+
+func TestClusterCrashRestart(t *testing.T) {
+	c := newTestCluster(t, 4)
+	s := putTestSecret(t, c)
+
+	// 节点 2 崩溃期间保存的 secret，重启后执行错过的区块
+	c.Crash(2)
+	s.check(t, c, 1)
+	later := putTestSecret(t, c)
+	require.Equal(t, len(c.blocks)-1, c.Nodes[2].applied)
+
+	// 重启后从数据库恢复份额
+	require.NoError(t, c.Restart(2))
+	require.Equal(t, len(c.blocks), c.Nodes[2].applied)
+	s.check(t, c, 2)
+	later.check(t, c, 2)
+}
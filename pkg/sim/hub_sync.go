@@ -0,0 +1,91 @@
+package sim
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	sidechain "github.com/wetee-dao/tee-dsecret/side-chain"
+)
+
+// SyncToHub 和侧链打包主链调用后一样，运行中的验证节点对 calls 的批量交易部分签名并发送给收集者，
+// 收集者达到门限后合成 DSS 签名提交到模拟的主链，返回批量交易的序号
+// 还没有收到部分签名的节点每隔 RetryInterval 重新发送，网络丢包时重试
+// 正在发送的节点不重复发送，返回前等待所有发送结束，避免之后迟到的部分签名再次触发提交
+func (c *Cluster) SyncToHub(collector int, calls ...*model.TeeCall) (int64, error) {
+	col := c.Nodes[collector]
+	if !col.ready() {
+		return 0, fmt.Errorf("collector %d is not ready", collector)
+	}
+	txIndex := int64(c.nextId.Add(1))
+	hubs := []*model.HubCall{{Call: calls}}
+
+	var (
+		wg      sync.WaitGroup
+		lock    sync.Mutex
+		sending = make(map[int]bool)
+	)
+	defer wg.Wait()
+
+	send := func() {
+		from := make(map[string]bool)
+		if sigs, err := col.Side.SigListOfTx(txIndex); err == nil {
+			for _, sig := range sigs {
+				from[sig.From] = true
+			}
+		}
+		for _, node := range c.validatorNodes() {
+			if !node.Running() || from[node.Validator.P2pId.String()] {
+				continue
+			}
+			lock.Lock()
+			if sending[node.Index] {
+				lock.Unlock()
+				continue
+			}
+			sending[node.Index] = true
+			lock.Unlock()
+
+			wg.Add(1)
+			go func(node *Node) {
+				defer func() {
+					lock.Lock()
+					delete(sending, node.Index)
+					lock.Unlock()
+					wg.Done()
+				}()
+				if err := node.Side.SendPartialSign(0, txIndex, hubs, &col.Validator.P2pId); err != nil {
+					util.LogWithYellow("Sim", "node", node.Index, "SendPartialSign error:", err.Error())
+				}
+			}(node)
+		}
+	}
+	send()
+
+	check := time.NewTicker(10 * time.Millisecond)
+	defer check.Stop()
+	retry := time.NewTicker(RetryInterval)
+	defer retry.Stop()
+	timeout := time.After(c.Timeout)
+	for {
+		select {
+		case <-check.C:
+			status, err := sidechain.LoadTxStatus(txIndex)
+			if err != nil || status == nil {
+				continue
+			}
+			switch status.Status {
+			case sidechain.TxStatusSubmitted, sidechain.TxStatusFinalized:
+				return txIndex, nil
+			case sidechain.TxStatusFailed, sidechain.TxStatusDead:
+				return txIndex, fmt.Errorf("sync tx %d: %s", txIndex, status.LastError)
+			}
+		case <-retry.C:
+			send()
+		case <-timeout:
+			return txIndex, fmt.Errorf("sync tx %d: timeout", txIndex)
+		}
+	}
+}
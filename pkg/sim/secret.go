@@ -0,0 +1,79 @@
+package sim
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	sidechain "github.com/wetee-dao/tee-dsecret/side-chain"
+)
+
+// PutSecret 使用侧链保存的 DKG 公钥加密 data，和打包 UploadSecret 一样保存为 owner 的 secret，返回 secret 的序号
+func (c *Cluster) PutSecret(owner types.H160, data []byte) (uint64, error) {
+	_, _, dkgPub := c.Epoch()
+	if dkgPub == nil {
+		return 0, errors.New("dkg is not ready")
+	}
+	store, err := sidechain.SealSecret(dkgPub, data, nil, "")
+	if err != nil {
+		return 0, err
+	}
+	buf := new(bytes.Buffer)
+	if err := abci.WriteMessage(store, buf); err != nil {
+		return 0, err
+	}
+
+	index := c.nextId.Add(1)
+	err = c.commit(func(side *sidechain.SideChain, txn *model.Txn) error {
+		return side.SaveSecret(owner, index, buf.Bytes(), txn)
+	})
+	return index, err
+}
+
+// Reencrypt 收集者通过侧链的重加密请求把 owner 的 secret 重加密给 reader，用 reader 的私钥解密
+// 请求没有在 RetryInterval 内恢复 secret 时使用新的请求重试，网络丢包时重试
+func (c *Cluster) Reencrypt(collector int, owner types.H160, index uint64, reader *model.PrivKey) ([]byte, error) {
+	col := c.Nodes[collector]
+	if !col.ready() {
+		return nil, fmt.Errorf("collector %d is not ready", collector)
+	}
+
+	var err error
+	deadline := time.Now().Add(c.Timeout)
+	for time.Now().Before(deadline) {
+		ctx, cancel := context.WithTimeout(context.Background(), RetryInterval)
+		var data []byte
+		data, err = c.reencrypt(ctx, col, owner, index, reader)
+		if err == nil {
+			cancel()
+			return data, nil
+		}
+		// 立即返回的错误也等待到重试间隔
+		<-ctx.Done()
+		cancel()
+	}
+	return nil, fmt.Errorf("reencrypt secret %d: %w", index, err)
+}
+
+func (c *Cluster) reencrypt(ctx context.Context, col *Node, owner types.H160, index uint64, reader *model.PrivKey) ([]byte, error) {
+	resp, err := col.Side.BroadcastReencryptReq(ctx, &model.PodStart{
+		Id:        c.nextId.Add(1),
+		NameSpace: owner[:],
+		PubKey:    reader.GetPublic().Byte(),
+		Secrets:   []uint64{index},
+	})
+	if err != nil {
+		return nil, err
+	}
+	secret := resp.Secrets[index]
+	if secret == nil {
+		return nil, errors.New("secret is not recovered")
+	}
+	return sidechain.OpenSecret(secret, model.PubKeyFromByte(resp.DkgKey).Point(), reader.Scalar())
+}
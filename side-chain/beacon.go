@@ -0,0 +1,308 @@
+package sidechain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/model/protoio"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+// 随机数信标：每隔 BeaconInterval 个区块开始新的一轮，节点使用 DKG 份额对 (round, prev) 输出门限 VRF 份额
+// 份额使用链上的 DKG 承诺验证，收集到 threshold 个后恢复出唯一的信标值并保存到状态中
+// 上一轮未完成时不开始新的一轮，只重新提交份额，保证信标链连续
+
+const (
+	BeaconSpace = "beacon"
+	// ABCI 事件类型
+	BeaconEventType = "beacon"
+	// 每隔多少个区块产生一次信标，影响共识，所有节点必须一致
+	BeaconInterval int64 = 10
+)
+
+var (
+	beaconPendingKey = model.ComboNamespaceKey(BeaconSpace, "pending")
+	beaconLatestKey  = model.ComboNamespaceKey(BeaconSpace, "latest")
+)
+
+// beaconKey 已完成信标的 key，补齐为定长使信标按轮次排序
+func beaconKey(round uint64) []byte {
+	return model.ComboNamespaceKey(BeaconSpace, fmt.Sprintf("r_%020d", round))
+}
+
+// StartBeaconRound 到达间隔高度时开始新的一轮信标
+func (s *SideChain) StartBeaconRound(height int64, txn *model.Txn) error {
+	if height%BeaconInterval != 0 {
+		return nil
+	}
+	// DKG 完成之前没有可用的密钥
+	if _, err := GetDkgCommits(); err != nil {
+		return nil
+	}
+
+	pending, err := txnGetBeaconRound(txn)
+	if err != nil {
+		return err
+	}
+	if pending != nil {
+		// 上一轮尚未完成，重新提交份额
+		s.pendingBeacon = pending.Round
+		return nil
+	}
+
+	latest, err := txnGetBeacon(txn, beaconLatestKey)
+	if err != nil {
+		return err
+	}
+	round := &model.BeaconRound{Round: 1, Height: height, Epoch: s.GetEpoch()}
+	if latest != nil {
+		round.Round = latest.Round + 1
+		round.Prev = latest.Value
+	}
+
+	s.pendingBeacon = round.Round
+	return model.TxnSetProtoMessage(txn, beaconPendingKey, round)
+}
+
+// submitBeaconShare 为正在进行的信标轮次提交本节点的份额
+func (s *SideChain) submitBeaconShare(roundNum uint64) {
+	if s.dkg == nil || s.dkg.DkgKeyShare == nil {
+		return
+	}
+
+	round, err := getBeaconRound()
+	if err != nil || round == nil || round.Round != roundNum {
+		return
+	}
+	epoch := s.GetEpoch()
+	priShare := s.dkg.DkgKeyShare.PriShare()
+	if round.Epoch == epoch && hasBeaconShare(round.Shares, priShare.I) {
+		return
+	}
+
+	suite := suites.MustFind("Ed25519")
+	partial, err := dkg.NewBeaconPartial(suite, priShare, dkg.BeaconMessage(suite, round.Round, round.Prev))
+	if err != nil {
+		util.LogWithRed("submitBeaconShare", err.Error())
+		return
+	}
+	value, err := partial.V.MarshalBinary()
+	if err != nil {
+		util.LogWithRed("submitBeaconShare", err.Error())
+		return
+	}
+	proof, err := dkg.MarshalBeaconProof(partial.Proof)
+	if err != nil {
+		util.LogWithRed("submitBeaconShare", err.Error())
+		return
+	}
+
+	_, err = SubmitTx(&model.Tx{
+		Payload: &model.Tx_BeaconShare{BeaconShare: &model.BeaconShare{
+			Round: round.Round,
+			Epoch: epoch,
+			Index: partial.Index,
+			Value: value,
+			Proof: proof,
+		}},
+	})
+	if err != nil {
+		util.LogWithRed("submitBeaconShare", err.Error())
+	}
+}
+
+// SaveBeaconShare 验证并保存节点的份额，收集到 threshold 个份额后完成本轮信标
+func (s *SideChain) SaveBeaconShare(bs *model.BeaconShare, height int64, txn *model.Txn) ([]abci.Event, error) {
+	round, err := txnGetBeaconRound(txn)
+	if err != nil {
+		return nil, err
+	}
+	if round == nil || round.Round != bs.Round {
+		return nil, nil
+	}
+
+	// 只接受当前 epoch 的份额，epoch 变化后重新收集
+	epoch := s.GetEpoch()
+	if bs.Epoch != epoch {
+		return nil, nil
+	}
+	if round.Epoch != epoch {
+		round.Epoch = epoch
+		round.Shares = nil
+	}
+	if hasBeaconShare(round.Shares, bs.Index) {
+		return nil, nil
+	}
+
+	suite := suites.MustFind("Ed25519")
+	commits, err := GetDkgCommits()
+	if err != nil {
+		return nil, err
+	}
+	pubPoly := share.NewPubPoly(suite, nil, commits.Public)
+	msg := dkg.BeaconMessage(suite, round.Round, round.Prev)
+	if _, err := decodeBeaconShare(suite, pubPoly, msg, bs); err != nil {
+		util.LogWithYellow("SaveBeaconShare", err.Error())
+		return nil, nil
+	}
+	round.Shares = append(round.Shares, bs)
+
+	threshold := len(commits.Public)
+	if len(round.Shares) < threshold {
+		return nil, model.TxnSetProtoMessage(txn, beaconPendingKey, round)
+	}
+
+	partials := make([]*dkg.BeaconPartial, 0, len(round.Shares))
+	for _, rs := range round.Shares {
+		p, err := decodeBeaconShare(suite, pubPoly, msg, rs)
+		if err != nil {
+			return nil, err
+		}
+		partials = append(partials, p)
+	}
+	value, err := dkg.RecoverBeacon(suite, partials, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	beacon := &model.Beacon{
+		Round:     round.Round,
+		Height:    round.Height,
+		Finalized: height,
+		Prev:      round.Prev,
+		Value:     value,
+		Epoch:     epoch,
+	}
+	if err := model.TxnSetProtoMessage(txn, beaconKey(beacon.Round), beacon); err != nil {
+		return nil, err
+	}
+	if err := model.TxnSetProtoMessage(txn, beaconLatestKey, beacon); err != nil {
+		return nil, err
+	}
+	if err := txn.Delete(beaconPendingKey); err != nil {
+		return nil, err
+	}
+
+	return []abci.Event{beaconEvent(beacon)}, nil
+}
+
+// decodeBeaconShare 解码并验证节点的份额
+func decodeBeaconShare(suite suites.Suite, pubPoly *share.PubPoly, msg kyber.Point, bs *model.BeaconShare) (*dkg.BeaconPartial, error) {
+	v := suite.Point()
+	if err := v.UnmarshalBinary(bs.Value); err != nil {
+		return nil, fmt.Errorf("beacon share of node %d: %w", bs.Index, err)
+	}
+	proof, err := dkg.UnmarshalBeaconProof(suite, bs.Proof)
+	if err != nil {
+		return nil, fmt.Errorf("beacon share of node %d: %w", bs.Index, err)
+	}
+	partial := &dkg.BeaconPartial{Index: bs.Index, V: v, Proof: proof}
+	if err := dkg.VerifyBeaconPartial(suite, pubPoly, msg, partial); err != nil {
+		return nil, err
+	}
+	return partial, nil
+}
+
+func hasBeaconShare(shares []*model.BeaconShare, index uint32) bool {
+	for _, s := range shares {
+		if s.Index == index {
+			return true
+		}
+	}
+	return false
+}
+
+func beaconEvent(b *model.Beacon) abci.Event {
+	return abci.Event{
+		Type: BeaconEventType,
+		Attributes: []abci.EventAttribute{
+			{Key: "round", Value: fmt.Sprint(b.Round), Index: true},
+			{Key: "value", Value: fmt.Sprintf("0x%x", b.Value)},
+		},
+	}
+}
+
+// GetBeacon 获取已完成的信标，round 为 0 时返回最新的信标
+func GetBeacon(round uint64) (*model.Beacon, error) {
+	key := "latest"
+	if round > 0 {
+		key = fmt.Sprintf("r_%020d", round)
+	}
+	v, err := model.GetKey(BeaconSpace, key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeBeacon(v)
+}
+
+// TxnLatestBeacon 在区块执行中获取最新的信标，供 pallet 使用
+func TxnLatestBeacon(txn *model.Txn) (*model.Beacon, error) {
+	return txnGetBeacon(txn, beaconLatestKey)
+}
+
+func txnGetBeacon(txn *model.Txn, key []byte) (*model.Beacon, error) {
+	v, err := txn.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeBeacon(v)
+}
+
+func decodeBeacon(v []byte) (*model.Beacon, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+	b := new(model.Beacon)
+	if err := protoio.ReadMessage(bytes.NewBuffer(v), b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func getBeaconRound() (*model.BeaconRound, error) {
+	v, err := model.GetKey(BeaconSpace, "pending")
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeBeaconRound(v)
+}
+
+func txnGetBeaconRound(txn *model.Txn) (*model.BeaconRound, error) {
+	v, err := txn.Get(beaconPendingKey)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeBeaconRound(v)
+}
+
+func decodeBeaconRound(v []byte) (*model.BeaconRound, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+	r := new(model.BeaconRound)
+	if err := protoio.ReadMessage(bytes.NewBuffer(v), r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,221 @@
+package sidechain
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/pkg/errors"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/blob"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/model/protoio"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+const (
+	BlobDir = "./chain_data/blob"
+	// payload 超过该大小时保存到 blob store，侧链状态中只保存 CID
+	BlobInlineSize = 4 * 1024
+)
+
+// 从其他节点获取 blob 的超时时间
+var blobFetchTimeout = 30 * time.Second
+
+// Recive blob msg from p2p
+func (s *SideChain) revBlob(m any) error {
+	mbox := m.(*model.BlobBox)
+	switch msg := mbox.Payload.(type) {
+	case *model.BlobBox_Req:
+		return s.handleBlobReq(msg.Req, mbox.From)
+	case *model.BlobBox_Resp:
+		return s.handleBlobResp(msg.Resp)
+	default:
+		return fmt.Errorf("unknown blob message type")
+	}
+}
+
+// handleBlobReq 本地存在 blob 时发送给请求节点
+func (s *SideChain) handleBlobReq(rawCid []byte, from string) error {
+	if P2PKey != nil && from == P2PKey.String() {
+		return nil
+	}
+
+	c, err := cid.Cast(rawCid)
+	if err != nil {
+		return fmt.Errorf("cast cid: %w", err)
+	}
+
+	data, err := s.blobs.Get(c)
+	if err != nil {
+		if errors.Is(err, blob.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("get blob: %w", err)
+	}
+
+	fromPubKey, err := model.PubKeyFromHex(from)
+	if err != nil {
+		return fmt.Errorf("pubkey from hex: %w", err)
+	}
+	err = s.p2p.Send(model.SendToNode(fromPubKey), &model.BlobBox{
+		Payload: &model.BlobBox_Resp{
+			Resp: &model.BlobResp{
+				Cid:  rawCid,
+				Data: data,
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "P2P Send error")
+	}
+	return nil
+}
+
+// handleBlobResp 保存其他节点返回的 blob 并通知等待的请求
+func (s *SideChain) handleBlobResp(resp *model.BlobResp) error {
+	c, err := cid.Cast(resp.Cid)
+	if err != nil {
+		return fmt.Errorf("cast cid: %w", err)
+	}
+
+	s.blobMu.Lock()
+	_, ok := s.blobWait[c.KeyString()]
+	s.blobMu.Unlock()
+	if !ok {
+		return nil
+	}
+
+	err = s.blobs.PutWithCid(c, resp.Data)
+	if err != nil {
+		return fmt.Errorf("put blob: %w", err)
+	}
+
+	// 多个节点返回同一个 blob 时只通知一次
+	s.blobMu.Lock()
+	waiters := s.blobWait[c.KeyString()]
+	delete(s.blobWait, c.KeyString())
+	s.blobMu.Unlock()
+	for _, w := range waiters {
+		w <- resp.Data
+	}
+	return nil
+}
+
+// FetchBlob 获取 blob，本地不存在时向其他节点请求
+func (s *SideChain) FetchBlob(c cid.Cid) ([]byte, error) {
+	data, err := s.blobs.Get(c)
+	if err == nil {
+		return data, nil
+	}
+	if !errors.Is(err, blob.ErrNotFound) {
+		return nil, err
+	}
+
+	if s.p2p == nil {
+		return nil, fmt.Errorf("blob %s not found and p2p is not ready", c)
+	}
+
+	wait := make(chan []byte, 1)
+	s.blobMu.Lock()
+	_, going := s.blobWait[c.KeyString()]
+	s.blobWait[c.KeyString()] = append(s.blobWait[c.KeyString()], wait)
+	s.blobMu.Unlock()
+
+	// 同一个 blob 只发送一次请求
+	if !going {
+		err = s.p2p.Send(model.SendToNodes(s.p2p.AvailableNodes()), &model.BlobBox{
+			Payload: &model.BlobBox_Req{Req: c.Bytes()},
+		})
+		if err != nil {
+			s.removeBlobWaiter(c, wait)
+			return nil, errors.Wrap(err, "P2P Send error")
+		}
+	}
+
+	select {
+	case data := <-wait:
+		return data, nil
+	case <-time.After(blobFetchTimeout):
+		s.removeBlobWaiter(c, wait)
+		return nil, fmt.Errorf("fetch blob %s timeout", c)
+	}
+}
+
+func (s *SideChain) removeBlobWaiter(c cid.Cid, wait chan []byte) {
+	s.blobMu.Lock()
+	defer s.blobMu.Unlock()
+
+	waiters := s.blobWait[c.KeyString()]
+	for i, w := range waiters {
+		if w == wait {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(s.blobWait, c.KeyString())
+	} else {
+		s.blobWait[c.KeyString()] = waiters
+	}
+}
+
+// storePayload 较大的 payload 保存到 blob store，secret 中只保存 CID
+func (s *SideChain) storePayload(store *model.SecretStore) error {
+	if len(store.Payload) <= BlobInlineSize {
+		return nil
+	}
+
+	c, err := s.blobs.Put(store.Payload)
+	if err != nil {
+		return fmt.Errorf("put blob: %w", err)
+	}
+	store.PayloadCid = c.Bytes()
+	store.Payload = nil
+	return nil
+}
+
+// loadPayload 获取 secret 的 payload
+func (s *SideChain) loadPayload(store *model.SecretStore) ([]byte, error) {
+	if len(store.PayloadCid) == 0 {
+		return store.Payload, nil
+	}
+
+	c, err := cid.Cast(store.PayloadCid)
+	if err != nil {
+		return nil, fmt.Errorf("cast cid: %w", err)
+	}
+	return s.FetchBlob(c)
+}
+
+// syncPayload 提交的 secret 的 blob 不在本地时从其他节点获取
+func (s *SideChain) syncPayload(data []byte) {
+	store := new(model.SecretStore)
+	err := protoio.ReadMessage(bytes.NewBuffer(data), store)
+	if err != nil || len(store.PayloadCid) == 0 {
+		return
+	}
+
+	go func() {
+		_, err := s.loadPayload(store)
+		if err != nil {
+			util.LogWithYellow("syncPayload", err.Error())
+		}
+	}()
+}
+
+// deletePayload 删除 secret 在本地 blob 储存中的 payload
+func (s *SideChain) deletePayload(store *model.SecretStore) {
+	if len(store.PayloadCid) == 0 {
+		return
+	}
+
+	c, err := cid.Cast(store.PayloadCid)
+	if err == nil {
+		err = s.blobs.Delete(c)
+	}
+	if err != nil && !errors.Is(err, blob.ErrNotFound) {
+		util.LogWithYellow("deletePayload", err.Error())
+	}
+}
@@ -3,15 +3,18 @@ package sidechain
 import (
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/version"
 
+	"github.com/wetee-dao/tee-dsecret/pkg/blob"
 	"github.com/wetee-dao/tee-dsecret/pkg/chains"
 	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
 	"github.com/wetee-dao/tee-dsecret/pkg/model"
-	bftbrigde "github.com/wetee-dao/tee-dsecret/pkg/network/bft-brigde"
+	p2peer "github.com/wetee-dao/tee-dsecret/pkg/network"
 	"github.com/wetee-dao/tee-dsecret/pkg/util"
 )
 
@@ -20,40 +23,92 @@ const ApplicationVersion = 1
 type SideChain struct {
 	abci.BaseApplication
 	state AppState
+	// 最新区块的高度，在区块处理之外的 goroutine 中读取
+	height atomic.Int64
 
 	dkg *dkg.DKG
-	p2p *bftbrigde.BTFReactor
+	p2p p2peer.Peer
 
 	txCh *model.PersistChan[*model.BlockPartialSign]
+	// 重试提交到主链失败的批量交易
+	retryManager *RetryManager
 
 	onGoingBlock        *model.Txn
 	onGoingValidators   []abci.ValidatorUpdate
 	currProposerAddress []byte
 
 	chains map[uint32]*chains.ChainApi
+
+	// 加密 payload 的本地 blob 储存
+	blobs    *blob.Store
+	blobMu   sync.Mutex
+	blobWait map[string][]chan []byte
+
+	// 进行中的重加密会话
+	reencrypt *reencryptSessions
+	// 应用门限签名会话
+	signs *signSessions
+	// 当前区块中需要提交份额的条件解密
+	readyDisclosures [][]byte
+	// 当前区块中需要提交份额的加密交易
+	pendingEncryptedTxs [][]byte
+	// 需要提交份额的信标轮次
+	pendingBeacon uint64
+	// 当前区块开始的份额刷新
+	pendingRefresh *model.ShareRefresh
+	// 当前区块完成的份额刷新
+	doneRefresh *model.ShareRefresh
+	// 本节点运行的密钥组
+	groups *keyGroups
+	// 当前区块修改的密钥组
+	pendingGroups []*model.KeyGroup
+	// 当前区块完成的密钥组 epoch
+	doneGroups []*model.KeyGroupEpoch
+	// 需要迁移旧 secret 的区块高度
+	pendingMigrate int64
+	// 当前区块清理的 secret，payload 在区块提交后删除
+	sweptPayloads []*model.SecretStore
 }
 
-func NewSideChain(light bool) (*SideChain, error) {
+// NewSideChain 创建侧链实例，queue 为部分签名队列的名称，同一进程中的多个节点使用不同的名称
+func NewSideChain(light bool, queue string) (*SideChain, error) {
 	state, err := loadAppState()
 	if err != nil {
 		return nil, err
 	}
 
+	blobBackend, err := blob.NewFsBackend(BlobDir)
+	if err != nil {
+		return nil, err
+	}
+
 	c := &SideChain{
-		state: state,
+		state:     state,
+		blobs:     blob.NewStore(blobBackend),
+		blobWait:  make(map[string][]chan []byte),
+		reencrypt: newReencryptSessions(),
+		signs:     newSignSessions(),
 	}
+	c.height.Store(state.Height)
 
 	if !light {
-		txCh, err := model.NewPersistChan[*model.BlockPartialSign]("back_tx", 1000)
+		txCh, err := model.NewPersistChan[*model.BlockPartialSign](queue, 1000)
 		if err != nil {
 			return nil, err
 		}
 		c.txCh = txCh
+		// 创建重试管理器，在区块处理时触发检查
+		c.retryManager = NewRetryManager(c)
 	}
 
 	return c, nil
 }
 
+// blockHeight 最新区块的高度，可以在任意 goroutine 中调用
+func (s *SideChain) blockHeight() int64 {
+	return s.height.Load()
+}
+
 func (app *SideChain) Info(_ context.Context, info *abci.InfoRequest) (*abci.InfoResponse, error) {
 	return &abci.InfoResponse{
 		Version:          version.ABCIVersion,
@@ -91,6 +146,11 @@ func (app *SideChain) CheckTx(_ context.Context, req *abci.CheckTxRequest) (*abc
 func (app *SideChain) PrepareProposal(_ context.Context, req *abci.PrepareProposalRequest) (*abci.PrepareProposalResponse, error) {
 	LogWithTime("🎁 PrepareProposal")
 
+	// 检查并重试提交到主链失败的批量交易
+	if app.retryManager != nil {
+		app.retryManager.CheckAndRetryFailedTxs()
+	}
+
 	// Check if the current epoch is valid
 	epochTx := app.CheckEpochFromValidator()
 	finalProposal := make([][]byte, 0, len(req.Txs)+2)
@@ -126,13 +186,78 @@ func (app *SideChain) ProcessProposal(_ context.Context, req *abci.ProcessPropos
 func (app *SideChain) FinalizeBlock(_ context.Context, req *abci.FinalizeBlockRequest) (*abci.FinalizeBlockResponse, error) {
 	// Iterate over Tx in current block
 	app.onGoingBlock = model.DBINS.NewTransaction()
-	respTxs, err := app.FinalizeTx(req.Txs, app.onGoingBlock, req.Height, req.ProposerAddress)
+	app.readyDisclosures = nil
+	app.pendingEncryptedTxs = nil
+	app.pendingBeacon = 0
+	app.pendingRefresh = nil
+	app.doneRefresh = nil
+	app.pendingGroups = nil
+	app.doneGroups = nil
+	app.pendingMigrate = 0
+	app.sweptPayloads = nil
+	respTxs, err := app.FinalizeTx(req.Txs, app.onGoingBlock, req.Height, req.Time.Unix(), req.ProposerAddress)
+	if err != nil {
+		app.onGoingBlock.Rollback()
+		app.onGoingBlock = nil
+		return nil, err
+	}
+
+	// 定期清理过期的 secret
+	if sweep := GetSecretSweepConfig(); req.Height%sweep.Interval == 0 {
+		app.sweptPayloads, err = app.SweepExpiredSecrets(req.Height, req.Time.Unix(), sweep, app.onGoingBlock)
+		if err != nil {
+			app.onGoingBlock.Rollback()
+			app.onGoingBlock = nil
+			return nil, err
+		}
+	}
+
+	// 检查条件解密的条件
+	err = app.CheckDisclosures(req.Height, app.onGoingBlock)
+	if err != nil {
+		app.onGoingBlock.Rollback()
+		app.onGoingBlock = nil
+		return nil, err
+	}
+
+	// 开始新的一轮随机数信标
+	err = app.StartBeaconRound(req.Height, app.onGoingBlock)
+	if err != nil {
+		app.onGoingBlock.Rollback()
+		app.onGoingBlock = nil
+		return nil, err
+	}
+
+	// 定期刷新份额
+	err = app.StartShareRefresh(req.Height, app.onGoingBlock)
+	if err != nil {
+		app.onGoingBlock.Rollback()
+		app.onGoingBlock = nil
+		return nil, err
+	}
+
+	// 按顺序执行已解密的加密交易
+	events, err := app.ExecuteEncryptedTxs(req.Height, app.onGoingBlock)
 	if err != nil {
 		app.onGoingBlock.Rollback()
 		app.onGoingBlock = nil
 		return nil, err
 	}
 
+	// 切换到刷新后的份额
+	err = app.ApplyShareRefresh(app.onGoingBlock)
+	if err != nil {
+		app.onGoingBlock.Rollback()
+		app.onGoingBlock = nil
+		return nil, err
+	}
+
+	// 密钥组切换到新份额
+	app.ApplyKeyGroups()
+
+	// 定期迁移签名密钥加密的旧 secret
+	app.StartSecretMigrate(req.Height)
+
 	// Sync validator updates to consensus
 	var validatorUpdates []abci.ValidatorUpdate
 	if app.onGoingValidators != nil {
@@ -148,8 +273,10 @@ func (app *SideChain) FinalizeBlock(_ context.Context, req *abci.FinalizeBlockRe
 	app.currProposerAddress = req.ProposerAddress
 
 	app.state.Height = req.Height
+	app.height.Store(req.Height)
 	response := &abci.FinalizeBlockResponse{
 		TxResults:        respTxs,
+		Events:           events,
 		AppHash:          app.state.Hash(),
 		ValidatorUpdates: validatorUpdates,
 	}
@@ -173,6 +300,36 @@ func (app *SideChain) Commit(_ context.Context, _ *abci.CommitRequest) (*abci.Co
 		return nil, err
 	}
 
+	// 区块提交后为条件满足的条目提交本节点的份额
+	if len(app.readyDisclosures) > 0 {
+		go app.submitDisclosureShares(app.readyDisclosures)
+		app.readyDisclosures = nil
+	}
+	if len(app.pendingEncryptedTxs) > 0 {
+		go app.submitEncryptedTxShares(app.pendingEncryptedTxs)
+		app.pendingEncryptedTxs = nil
+	}
+	if app.pendingBeacon > 0 {
+		go app.submitBeaconShare(app.pendingBeacon)
+		app.pendingBeacon = 0
+	}
+	if app.pendingRefresh != nil {
+		go app.sponsorShareRefresh(app.pendingRefresh)
+		app.pendingRefresh = nil
+	}
+	for _, g := range app.pendingGroups {
+		go app.sponsorKeyGroup(g)
+	}
+	app.pendingGroups = nil
+	if app.pendingMigrate > 0 {
+		go app.migrateSecrets(app.pendingMigrate)
+		app.pendingMigrate = 0
+	}
+	if len(app.sweptPayloads) > 0 {
+		go app.deletePayloads(app.sweptPayloads)
+		app.sweptPayloads = nil
+	}
+
 	LogWithTime("💤 Commit")
 	util.LogWithGreen("END BLOCK  ", "--------------------------------------------------------------")
 
@@ -0,0 +1,97 @@
+package sidechain
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+// 作恶的 dealer：DKG 证明阶段结束后，每个验证节点把无法证明 deal 合法的 dealer 作为交易提交
+// 交易使用节点的 p2p key 签名，只接受当前验证节点提交，惩罚使用状态中的报告而不是节点本地的记录
+
+const (
+	DealerFaultSpace = "dealer_fault"
+	// ABCI 事件类型，可通过 tx_search 查询 dealer_fault.epoch='<epoch>'
+	DealerFaultEventType = "dealer_fault"
+)
+
+// dealerFaultPrefix 同一个 DKG epoch 的报告的前缀: <group>_<epoch>_
+// epoch 补齐为定长，使报告按 epoch 排序
+func dealerFaultPrefix(group string, epoch uint32) string {
+	return fmt.Sprintf("%s_%010d_", group, epoch)
+}
+
+// reportDealerFaults 提交本节点发现的作恶 dealer
+func reportDealerFaults(fault *dkg.DealerFaultError) {
+	report := &model.DealerFaultReport{
+		Epoch:  fault.Epoch,
+		Group:  fault.Group,
+		Faults: make([]*model.DealerFault, 0, len(fault.Faults)),
+	}
+	for _, f := range fault.Faults {
+		df := &model.DealerFault{DealerIndex: f.DealerIndex, Reason: f.Reason}
+		if f.Validator != nil {
+			df.Validator = f.Validator.ValidatorId.Byte()
+		}
+		report.Faults = append(report.Faults, df)
+	}
+
+	_, err := SubmitTx(&model.Tx{
+		Payload: &model.Tx_DealerFault{DealerFault: report},
+	})
+	if err != nil {
+		util.LogWithRed("reportDealerFaults", err.Error())
+	}
+}
+
+// SaveDealerFaults 保存验证节点的报告，每个节点在同一个 DKG epoch 只能报告一次
+func (s *SideChain) SaveDealerFaults(report *model.DealerFaultReport, reporter []byte, height int64, txn *model.Txn) ([]abci.Event, error) {
+	if len(report.Faults) == 0 {
+		util.LogWithYellow("SaveDealerFaults", "empty dealer fault report, skip")
+		return nil, nil
+	}
+
+	key := model.ComboNamespaceKey(DealerFaultSpace, dealerFaultPrefix(report.Group, report.Epoch)+hex.EncodeToString(reporter))
+	old, err := model.TxnGetJson[model.DealerFaultReport](txn, key)
+	if err != nil {
+		return nil, err
+	}
+	if old != nil {
+		util.LogWithYellow("SaveDealerFaults", "dealer faults already reported, skip", string(key))
+		return nil, nil
+	}
+
+	report.Reporter = reporter
+	report.Height = height
+	if err := model.TxnSetJson(txn, key, report); err != nil {
+		return nil, err
+	}
+	return []abci.Event{dealerFaultEvent(report)}, nil
+}
+
+func dealerFaultEvent(report *model.DealerFaultReport) abci.Event {
+	dealers := make([]string, 0, len(report.Faults))
+	for _, f := range report.Faults {
+		dealers = append(dealers, fmt.Sprint(f.DealerIndex))
+	}
+	return abci.Event{
+		Type: DealerFaultEventType,
+		Attributes: []abci.EventAttribute{
+			{Key: "group", Value: report.Group, Index: true},
+			{Key: "epoch", Value: fmt.Sprint(report.Epoch), Index: true},
+			{Key: "reporter", Value: hex.EncodeToString(report.Reporter)},
+			{Key: "dealers", Value: fmt.Sprint(dealers)},
+		},
+	}
+}
+
+// GetDealerFaults 获取 DKG epoch 中验证节点报告的作恶 dealer，group 为空时是全网的 DKG
+func GetDealerFaults(group string, epoch uint32) ([]*model.DealerFaultReport, error) {
+	list, _, err := model.GetJsonList[model.DealerFaultReport](DealerFaultSpace, dealerFaultPrefix(group, epoch))
+	return list, err
+}
@@ -0,0 +1,278 @@
+package sidechain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/cockroachdb/pebble"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/model/protoio"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	"github.com/wetee-dao/tee-dsecret/side-chain/pallets/dao"
+)
+
+// 条件解密：owner 使用 DKG 公钥加密的密文在条件满足后由网络解密并公开到侧链状态
+// 1. owner 签名提交 SealDisclosure，条件为 DAO 提案通过、到达区块高度或 owner 签名发布
+// 2. 每个区块检查等待中的条件，满足后状态变为 ready
+// 3. 每个节点把自己的份额重加密到公开的读者公钥，作为 DisclosureShare 交易提交
+// 4. 所有节点验证份额，收集到 threshold 个后恢复明文并保存（见 public_decrypt.go）
+
+const (
+	DisclosureSpace = "disclosure"
+	// ABCI 事件类型，可通过 tx_search 查询 disclosure.owner='<hex>'
+	DisclosureEventType = "disclosure"
+	// 条件满足但还未公开的条目，每隔多少个区块重新提交份额
+	// 所有节点必须一致，不能通过环境变量配置
+	DisclosureRetryInterval int64 = 20
+)
+
+// disclosureKey 条件解密的 key: <owner>_<index>
+func disclosureKey(owner types.H160, index uint64) []byte {
+	return model.ComboNamespaceKey(DisclosureSpace, owner.Hex()+"_"+fmt.Sprint(index))
+}
+
+// SealDisclosure 保存条件解密的密文，同一个 owner 和 index 只能保存一次
+func (s *SideChain) SealDisclosure(seal *model.SealDisclosure, txn *model.Txn) error {
+	key := disclosureKey(seal.OwnerH160(), seal.Index)
+	old, err := txnGetDisclosure(txn, key)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		util.LogWithYellow("SealDisclosure", "disclosure already exists, skip", string(key))
+		return nil
+	}
+
+	// 密文必须证明绑定 owner 和 index，否则任何人都可以提交别人的 secret 让网络公开
+	err = proxy_reenc.VerifyLabel(suites.MustFind("Ed25519"), seal.Secret, model.DisclosureLabel(seal.OwnerH160(), seal.Index))
+	if err != nil {
+		util.LogWithYellow("SealDisclosure", "invalid secret, skip", string(key), err.Error())
+		return nil
+	}
+
+	return model.TxnSetProtoMessage(txn, key, &model.Disclosure{Seal: seal, Status: model.DisclosurePending})
+}
+
+// ReleaseDisclosure owner 发布条件解密
+func (s *SideChain) ReleaseDisclosure(release *model.ReleaseDisclosure, height int64, txn *model.Txn) error {
+	key := disclosureKey(release.OwnerH160(), release.Index)
+	d, err := txnGetDisclosure(txn, key)
+	if err != nil {
+		return err
+	}
+	if d == nil || d.Status != model.DisclosurePending || !d.Seal.Condition.GetOwnerRelease() {
+		util.LogWithYellow("ReleaseDisclosure", "disclosure is not waiting for owner release, skip", string(key))
+		return nil
+	}
+	if release.Time < d.Seal.Time {
+		util.LogWithYellow("ReleaseDisclosure", "release is older than seal, skip", string(key))
+		return nil
+	}
+
+	d.Status = model.DisclosureReady
+	d.ReadyHeight = height
+	s.readyDisclosures = append(s.readyDisclosures, key)
+	return model.TxnSetProtoMessage(txn, key, d)
+}
+
+// CheckDisclosures 检查等待中的条件，height 使用区块高度，保证所有节点结果一致
+// 条件满足和需要重新提交份额的条目会在区块提交后由本节点提交份额
+func (s *SideChain) CheckDisclosures(height int64, txn *model.Txn) error {
+	list, keys, err := model.GetProtoMessageList[model.Disclosure](DisclosureSpace, "0x")
+	if err != nil {
+		return err
+	}
+
+	retry := height%DisclosureRetryInterval == 0
+	for i, item := range list {
+		switch item.Status {
+		case model.DisclosureReady:
+			if retry {
+				s.readyDisclosures = append(s.readyDisclosures, keys[i])
+			}
+			continue
+		case model.DisclosurePending:
+		default:
+			continue
+		}
+		if !disclosureConditionMet(item.Seal.Condition, height) {
+			continue
+		}
+
+		// 本区块的交易可能已经修改了状态
+		d, err := txnGetDisclosure(txn, keys[i])
+		if err != nil {
+			return err
+		}
+		if d == nil || d.Status != model.DisclosurePending {
+			continue
+		}
+		d.Status = model.DisclosureReady
+		d.ReadyHeight = height
+		if err := model.TxnSetProtoMessage(txn, keys[i], d); err != nil {
+			return err
+		}
+		s.readyDisclosures = append(s.readyDisclosures, keys[i])
+		util.LogWithGray("CheckDisclosures", "ready", string(keys[i]))
+	}
+	return nil
+}
+
+// disclosureConditionMet owner 发布的条件由 ReleaseDisclosure 处理
+func disclosureConditionMet(cond *model.DisclosureCondition, height int64) bool {
+	switch c := cond.GetKind().(type) {
+	case *model.DisclosureCondition_Height:
+		return height >= int64(c.Height)
+	case *model.DisclosureCondition_DaoProposal:
+		return dao.ProposalStatus(c.DaoProposal) == "approved"
+	}
+	return false
+}
+
+// submitDisclosureShares 为条件满足的条目提交本节点的重加密份额
+func (s *SideChain) submitDisclosureShares(keys [][]byte) {
+	if s.dkg == nil || s.dkg.DkgKeyShare == nil {
+		return
+	}
+
+	epoch := s.GetEpoch()
+	index := int32(s.dkg.DkgKeyShare.PriShare().I)
+	for _, key := range keys {
+		d, err := getDisclosure(key)
+		if err != nil || d == nil || d.Status != model.DisclosureReady {
+			continue
+		}
+		if d.Epoch == epoch && hasDecryptShare(d.Shares, index) {
+			continue
+		}
+
+		eshare, err := s.reencryptPublic(d.Seal.Secret, d.Seal.Index)
+		if err != nil {
+			util.LogWithRed("submitDisclosureShares", err.Error())
+			continue
+		}
+
+		owner := d.Seal.OwnerH160()
+		_, err = SubmitTx(&model.Tx{
+			Payload: &model.Tx_DisclosureShare{DisclosureShare: &model.DisclosureShare{
+				Owner: owner[:],
+				Index: d.Seal.Index,
+				Epoch: epoch,
+				Share: eshare,
+			}},
+		})
+		if err != nil {
+			util.LogWithRed("submitDisclosureShares", err.Error())
+		}
+	}
+}
+
+// SaveDisclosureShare 验证并保存节点的份额，收集到 threshold 个份额后恢复并公开明文
+// 无效的份额只记录日志，不影响区块中的其他交易
+func (s *SideChain) SaveDisclosureShare(ds *model.DisclosureShare, height int64, txn *model.Txn) ([]abci.Event, error) {
+	if len(ds.Owner) != 20 || ds.Share == nil {
+		util.LogWithYellow("SaveDisclosureShare", "invalid disclosure share, skip")
+		return nil, nil
+	}
+	key := disclosureKey(types.H160(ds.Owner), ds.Index)
+	d, err := txnGetDisclosure(txn, key)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil || d.Status != model.DisclosureReady {
+		return nil, nil
+	}
+
+	// 只接受当前 epoch 的份额，epoch 变化后重新收集
+	epoch := s.GetEpoch()
+	if ds.Epoch != epoch {
+		return nil, nil
+	}
+	if d.Epoch != epoch {
+		d.Epoch = epoch
+		d.Shares = nil
+	}
+	if hasDecryptShare(d.Shares, ds.Share.ShareIndex) {
+		return nil, nil
+	}
+
+	threshold, err := verifyPublicShare(d.Seal.Secret, ds.Share)
+	if err != nil {
+		util.LogWithYellow("SaveDisclosureShare", string(key), err.Error())
+		return nil, nil
+	}
+	d.Shares = append(d.Shares, ds.Share)
+
+	var events []abci.Event
+	if len(d.Shares) >= threshold {
+		plain, err := recoverPublic(d.Seal.Secret, d.Shares, threshold)
+		if err != nil {
+			d.Error = err.Error()
+		}
+		d.Plaintext = plain
+		d.Status = model.DisclosureDisclosed
+		d.DisclosedHeight = height
+		d.Shares = nil
+		events = append(events, disclosureEvent(d))
+		util.LogWithGray("SaveDisclosureShare", "disclosed", string(key))
+	}
+
+	return events, model.TxnSetProtoMessage(txn, key, d)
+}
+
+func disclosureEvent(d *model.Disclosure) abci.Event {
+	owner := d.Seal.OwnerH160()
+	return abci.Event{
+		Type: DisclosureEventType,
+		Attributes: []abci.EventAttribute{
+			{Key: "owner", Value: owner.Hex(), Index: true},
+			{Key: "index", Value: fmt.Sprint(d.Seal.Index), Index: true},
+			{Key: "failed", Value: fmt.Sprint(d.Error != "")},
+		},
+	}
+}
+
+// GetDisclosure 获取条件解密，不存在返回 nil
+func GetDisclosure(owner types.H160, index uint64) (*model.Disclosure, error) {
+	return getDisclosure(disclosureKey(owner, index))
+}
+
+func getDisclosure(key []byte) (*model.Disclosure, error) {
+	v, err := model.GetKey(DisclosureSpace, strings.TrimPrefix(string(key), DisclosureSpace+"_"))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeDisclosure(v)
+}
+
+func txnGetDisclosure(txn *model.Txn, key []byte) (*model.Disclosure, error) {
+	v, err := txn.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeDisclosure(v)
+}
+
+func decodeDisclosure(v []byte) (*model.Disclosure, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+	d := new(model.Disclosure)
+	if err := protoio.ReadMessage(bytes.NewBuffer(v), d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
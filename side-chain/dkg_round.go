@@ -0,0 +1,53 @@
+package sidechain
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/side-chain/pallets/dao"
+)
+
+// DKG 轮次只保存在本节点，终止、重启只影响本节点
+// 由本节点的验证人账户或 DAO gov/sudo 账户操作
+
+// DkgRound 本节点当前的 DKG 轮次状态
+func (s *SideChain) DkgRound() (*dkg.RoundInfo, error) {
+	if s.dkg == nil {
+		return nil, errors.New("dkg is not ready")
+	}
+	return s.dkg.Round(), nil
+}
+
+// AbortDkgRound 终止本节点的 DKG 轮次
+func (s *SideChain) AbortDkgRound(caller []byte, sessionId string) error {
+	if err := s.checkDkgOperator(caller); err != nil {
+		return err
+	}
+	return s.dkg.AbortRound(sessionId)
+}
+
+// RestartDkgRound 终止本节点的 DKG 轮次，本节点是发起者时重新发起共识
+func (s *SideChain) RestartDkgRound(caller []byte, sessionId string) error {
+	if err := s.checkDkgOperator(caller); err != nil {
+		return err
+	}
+	return s.dkg.RestartRound(sessionId)
+}
+
+func (s *SideChain) checkDkgOperator(caller []byte) error {
+	if s.dkg == nil {
+		return errors.New("dkg is not ready")
+	}
+	if bytes.Equal(caller, s.dkg.Signer.GetPublic().Byte()) {
+		return nil
+	}
+
+	txn := model.DBINS.NewTransaction()
+	defer txn.Rollback()
+	if !dao.IsSudo(caller, txn) {
+		return errors.New("dkg round: must call by node validator or gov/sudo")
+	}
+	return nil
+}
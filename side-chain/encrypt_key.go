@@ -0,0 +1,52 @@
+package sidechain
+
+import (
+	"go.dedis.ch/kyber/v4"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+// 加密密钥：secret 使用单独的 DKG 密钥加密，和签名主链交易的 DKG 密钥分开
+// 加密密钥是保留的密钥组，成员为当前 epoch 的验证节点，每个 epoch 切换后独立 reshare，公钥不变
+// 加密密钥生成之前不接受新的 secret，之前使用签名密钥加密的 secret（SecretStore.Group 为空）由 SecretMigrate 迁移
+// 条件解密和加密交易也使用加密密钥，签名密钥只用于签名
+
+// EncryptKeyGroup 加密密钥使用的密钥组
+const EncryptKeyGroup = "enc"
+
+// updateEncryptKey epoch 切换后加密密钥的成员改为新的验证节点，使用新 epoch 的门限策略
+func (s *SideChain) updateEncryptKey(epoch *model.EpochEnd, txn *model.Txn) error {
+	members := make([][]byte, 0, len(epoch.Validators))
+	for _, v := range epoch.Validators {
+		members = append(members, v.Pubkey)
+	}
+	if len(members) < 2 {
+		return nil
+	}
+
+	policy, err := model.TxnGetJson[model.ThresholdPolicy](txn, model.ComboNamespaceKey(GLOABL_STATE, thresholdPolicyKey))
+	if err != nil {
+		return err
+	}
+	if policy == nil {
+		p := model.DefaultThresholdPolicy()
+		policy = &p
+	}
+
+	util.LogWithYellow("EncryptKey", "reshare at epoch", epoch.Epoch)
+	return s.setKeyGroup(&model.KeyGroup{
+		Id:      EncryptKeyGroup,
+		Members: members,
+		Policy:  policy,
+	}, txn)
+}
+
+// encryptKeyPub 加密密钥的公钥，还未生成时返回 nil
+func encryptKeyPub() kyber.Point {
+	g, err := GetKeyGroup(EncryptKeyGroup)
+	if err != nil || g == nil || len(g.DkgPub) == 0 {
+		return nil
+	}
+	return model.PubKeyFromByte(g.DkgPub).Point()
+}
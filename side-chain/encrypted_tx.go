@@ -0,0 +1,261 @@
+package sidechain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/pebble"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/model/protoio"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	"github.com/wetee-dao/tee-dsecret/side-chain/pallets/dao"
+)
+
+// 加密交易：客户端使用 DKG 公钥加密 Tx，proposer 只能看到密文并按顺序打包
+// 区块提交后节点提交公开解密份额，收集到 threshold 个后在执行时解密，已解密的交易按打包顺序执行
+// 未解密的交易不阻塞后面的交易，超时后丢弃
+// 密文必须带有绑定 EncryptedTxLabel 的证明，保存的 secret 和条件解密的密文不能作为加密交易提交
+// 明文只在执行时恢复，不写入状态
+
+const (
+	EncryptedTxSpace = "encrypted_tx"
+	// ABCI 事件类型
+	EncryptedTxEventType = "encrypted_tx"
+
+	// 以下参数影响共识，所有节点必须一致，不能通过环境变量配置
+	// 加密交易在打包后多少个区块内未解密则丢弃，避免阻塞后续交易
+	EncryptedTxTimeout int64 = 100
+	// 未解密的加密交易每隔多少个区块重新提交份额
+	EncryptedTxRetryInterval int64 = 10
+)
+
+// ErrUnsupportedEncryptedTx 内部交易的类型不能加密提交
+var ErrUnsupportedEncryptedTx = errors.New("unsupported encrypted tx type")
+
+// encryptedTxKey 加密交易的 key: <height>_<position>
+// 补齐为定长，使加密交易按打包顺序排序
+func encryptedTxKey(height int64, position uint32) []byte {
+	return model.ComboNamespaceKey(EncryptedTxSpace, fmt.Sprintf("%020d_%06d", height, position))
+}
+
+// SaveEncryptedTx 保存区块中打包的加密交易，position 为交易在区块中的位置
+func (s *SideChain) SaveEncryptedTx(envelope *model.SecretStore, height int64, position uint32, txn *model.Txn) error {
+	if err := model.ValidateInlineSecret(envelope, model.MaxEncryptedTxSize); err != nil {
+		util.LogWithYellow("SaveEncryptedTx", "invalid envelope, skip:", err.Error())
+		return nil
+	}
+	if err := proxy_reenc.VerifyLabel(suites.MustFind("Ed25519"), envelope, model.EncryptedTxLabel); err != nil {
+		util.LogWithYellow("SaveEncryptedTx", "invalid envelope, skip:", err.Error())
+		return nil
+	}
+
+	key := encryptedTxKey(height, position)
+	s.pendingEncryptedTxs = append(s.pendingEncryptedTxs, key)
+	return model.TxnSetProtoMessage(txn, key, &model.EncryptedTx{
+		Envelope: envelope,
+		Height:   height,
+		Position: position,
+	})
+}
+
+// submitEncryptedTxShares 为加密交易提交本节点的公开解密份额
+func (s *SideChain) submitEncryptedTxShares(keys [][]byte) {
+	if s.dkg == nil || s.dkg.DkgKeyShare == nil {
+		return
+	}
+
+	epoch := s.GetEpoch()
+	index := int32(s.dkg.DkgKeyShare.PriShare().I)
+	for _, key := range keys {
+		etx, err := getEncryptedTx(key)
+		if err != nil || etx == nil || etx.Decrypted {
+			continue
+		}
+		if etx.Epoch == epoch && hasDecryptShare(etx.Shares, index) {
+			continue
+		}
+
+		eshare, err := s.reencryptPublic(etx.Envelope, uint64(etx.Height))
+		if err != nil {
+			util.LogWithRed("submitEncryptedTxShares", err.Error())
+			continue
+		}
+
+		_, err = SubmitTx(&model.Tx{
+			Payload: &model.Tx_EncryptedTxShare{EncryptedTxShare: &model.EncryptedTxShare{
+				Height:   etx.Height,
+				Position: etx.Position,
+				Epoch:    epoch,
+				Share:    eshare,
+			}},
+		})
+		if err != nil {
+			util.LogWithRed("submitEncryptedTxShares", err.Error())
+		}
+	}
+}
+
+// SaveEncryptedTxShare 验证并保存节点的份额，收集到 threshold 个份额后标记为可以解密
+// 交易由 ExecuteEncryptedTxs 按顺序解密并执行
+func (s *SideChain) SaveEncryptedTxShare(es *model.EncryptedTxShare, txn *model.Txn) error {
+	if es.Share == nil {
+		return nil
+	}
+	key := encryptedTxKey(es.Height, es.Position)
+	etx, err := txnGetEncryptedTx(txn, key)
+	if err != nil {
+		return err
+	}
+	if etx == nil || etx.Decrypted {
+		return nil
+	}
+
+	// 只接受当前 epoch 的份额，epoch 变化后重新收集
+	epoch := s.GetEpoch()
+	if es.Epoch != epoch {
+		return nil
+	}
+	if etx.Epoch != epoch {
+		etx.Epoch = epoch
+		etx.Shares = nil
+	}
+	if hasDecryptShare(etx.Shares, es.Share.ShareIndex) {
+		return nil
+	}
+
+	threshold, err := verifyPublicShare(etx.Envelope, es.Share)
+	if err != nil {
+		util.LogWithYellow("SaveEncryptedTxShare", string(key), err.Error())
+		return nil
+	}
+	etx.Shares = append(etx.Shares, es.Share)
+
+	if len(etx.Shares) >= threshold {
+		etx.Decrypted = true
+	}
+
+	return model.TxnSetProtoMessage(txn, key, etx)
+}
+
+// ExecuteEncryptedTxs 按打包顺序执行已解密的加密交易
+// 未解密的交易等待份额，不阻塞后面已解密的交易，超时未解密的交易被丢弃
+func (s *SideChain) ExecuteEncryptedTxs(height int64, txn *model.Txn) ([]abci.Event, error) {
+	_, keys, err := model.GetProtoMessageList[model.EncryptedTx](EncryptedTxSpace, "")
+	if err != nil {
+		return nil, err
+	}
+
+	retry := height%EncryptedTxRetryInterval == 0
+	var events []abci.Event
+	for _, key := range keys {
+		// 本区块的交易可能已经修改了状态
+		etx, err := txnGetEncryptedTx(txn, key)
+		if err != nil {
+			return nil, err
+		}
+		if etx == nil {
+			continue
+		}
+
+		if !etx.Decrypted {
+			if height-etx.Height <= EncryptedTxTimeout {
+				if retry {
+					s.pendingEncryptedTxs = append(s.pendingEncryptedTxs, key)
+				}
+				continue
+			}
+			etx.Error = "decrypt timeout"
+		} else if err := s.executeEncryptedTx(etx, height, txn); err != nil {
+			etx.Error = err.Error()
+		}
+
+		if err := txn.Delete(key); err != nil {
+			return nil, err
+		}
+		events = append(events, encryptedTxEvent(etx))
+	}
+	return events, nil
+}
+
+// executeEncryptedTx 使用收集的份额解密并执行交易
+// 支持由用户签名的 DAO 调用和治理交易，其他类型返回 ErrUnsupportedEncryptedTx
+func (s *SideChain) executeEncryptedTx(etx *model.EncryptedTx, height int64, txn *model.Txn) error {
+	plain, err := recoverPublic(etx.Envelope, etx.Shares, len(etx.Shares))
+	if err != nil {
+		return fmt.Errorf("decrypt tx: %w", err)
+	}
+
+	tx := new(model.Tx)
+	if err := tx.Unmarshal(plain); err != nil {
+		return fmt.Errorf("unmarshal tx: %w", err)
+	}
+	if err := model.VerifyTxSigner(tx); err != nil {
+		return err
+	}
+
+	caller := tx.GetCaller()
+	switch p := tx.Payload.(type) {
+	case *model.Tx_DaoCall:
+		return dao.ApplyDaoCall(caller, p.DaoCall, height, txn)
+	case *model.Tx_ThresholdPolicy:
+		return s.SetThresholdPolicy(caller, p.ThresholdPolicy, txn)
+	case *model.Tx_SecretSweep:
+		return s.SetSecretSweepConfig(caller, p.SecretSweep, txn)
+	case *model.Tx_ShareRefreshStart:
+		return s.GovStartShareRefresh(caller, height, txn)
+	case *model.Tx_KeyGroup:
+		return s.SetKeyGroup(caller, p.KeyGroup, txn)
+	default:
+		return fmt.Errorf("%w: %T", ErrUnsupportedEncryptedTx, tx.Payload)
+	}
+}
+
+func encryptedTxEvent(etx *model.EncryptedTx) abci.Event {
+	return abci.Event{
+		Type: EncryptedTxEventType,
+		Attributes: []abci.EventAttribute{
+			{Key: "height", Value: fmt.Sprint(etx.Height), Index: true},
+			{Key: "position", Value: fmt.Sprint(etx.Position), Index: true},
+			{Key: "error", Value: etx.Error},
+		},
+	}
+}
+
+func getEncryptedTx(key []byte) (*model.EncryptedTx, error) {
+	v, err := model.GetKey(EncryptedTxSpace, strings.TrimPrefix(string(key), EncryptedTxSpace+"_"))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeEncryptedTx(v)
+}
+
+func txnGetEncryptedTx(txn *model.Txn, key []byte) (*model.EncryptedTx, error) {
+	v, err := txn.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeEncryptedTx(v)
+}
+
+func decodeEncryptedTx(v []byte) (*model.EncryptedTx, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+	etx := new(model.EncryptedTx)
+	if err := protoio.ReadMessage(bytes.NewBuffer(v), etx); err != nil {
+		return nil, err
+	}
+	return etx, nil
+}
@@ -0,0 +1,136 @@
+package sidechain
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+)
+
+// sealEncryptedTx 使用 priv 签名交易，加密为加密交易的信封
+func sealEncryptedTx(t *testing.T, d *testDkg, priv *model.PrivKey, pub *model.PubKey, tx *model.Tx) *model.SecretStore {
+	tx.Caller = pub.Byte()
+	msg, err := model.TxBytesForSigning(tx)
+	require.NoError(t, err)
+	tx.Signature, err = priv.ToSigner().Sign(msg)
+	require.NoError(t, err)
+
+	plain, err := tx.Marshal()
+	require.NoError(t, err)
+	envelope, err := proxy_reenc.SealLabeledEnvelope(suites.MustFind("Ed25519"), d.key.Point(), plain, model.EncryptedTxLabel)
+	require.NoError(t, err)
+	return envelope
+}
+
+// saveEncryptedTxShares 节点 0..th-1 为加密交易提交公开解密份额
+func saveEncryptedTxShares(t *testing.T, s *SideChain, d *testDkg, height int64, position uint32) {
+	_, reader, err := publicReader()
+	require.NoError(t, err)
+	etx, err := getEncryptedTx(encryptedTxKey(height, position))
+	require.NoError(t, err)
+
+	txn := model.DBINS.NewTransaction()
+	for i := range d.th {
+		reply, err := proxy_reenc.Reencrypt(d.distKeyShare(i), etx.Envelope, *reader)
+		require.NoError(t, err)
+		eshare, err := EncodeDecryptShare(reply, uint64(height))
+		require.NoError(t, err)
+		es := &model.EncryptedTxShare{Height: height, Position: position, Share: eshare}
+		require.NoError(t, s.SaveEncryptedTxShare(es, txn))
+	}
+	require.NoError(t, txn.Commit())
+}
+
+func executeEncryptedTxs(t *testing.T, s *SideChain, height int64) map[string]string {
+	txn := model.DBINS.NewTransaction()
+	events, err := s.ExecuteEncryptedTxs(height, txn)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+
+	// 按执行顺序记录 position 和错误
+	res := map[string]string{}
+	order := ""
+	for _, ev := range events {
+		attrs := map[string]string{}
+		for _, a := range ev.Attributes {
+			attrs[a.Key] = a.Value
+		}
+		order += attrs["position"]
+		res[attrs["position"]] = attrs["error"]
+	}
+	res["order"] = order
+	return res
+}
+
+func TestExecuteEncryptedTxs(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	d := newTestDkg(t, 4, 3)
+
+	priv, pub, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	initCall, err := (&model.DaoCall{Call: &model.DaoCall_Init{Init: &model.DaoInit{
+		InitialMembers: []*model.DaoMember{{Account: pub.Byte()}},
+		SudoAccount:    pub.Byte(),
+	}}}).Marshal()
+	require.NoError(t, err)
+	policy := &model.ThresholdPolicy{Num: 1, Den: 2, QuorumNum: 1, QuorumDen: 2}
+
+	// 0: 初始化 DAO，1: 需要 DAO sudo 权限的治理交易，2: 不会解密，3: 不支持的类型
+	txs := []*model.Tx{
+		{Payload: &model.Tx_DaoCall{DaoCall: initCall}},
+		{Payload: &model.Tx_ThresholdPolicy{ThresholdPolicy: policy}},
+		{Payload: &model.Tx_DaoCall{DaoCall: initCall}},
+		{Payload: &model.Tx_EpochStart{EpochStart: 1}},
+	}
+	txn := model.DBINS.NewTransaction()
+	for i, tx := range txs {
+		require.NoError(t, s.SaveEncryptedTx(sealEncryptedTx(t, d, priv, pub, tx), 1, uint32(i), txn))
+	}
+	require.NoError(t, txn.Commit())
+
+	// 份额到达的顺序不影响执行顺序
+	for _, position := range []uint32{3, 1, 0} {
+		saveEncryptedTxShares(t, s, d, 1, position)
+	}
+
+	// 交易 2 未解密，不阻塞后面的交易
+	res := executeEncryptedTxs(t, s, 2)
+	require.Equal(t, "013", res["order"])
+	require.Empty(t, res["0"])
+	require.Empty(t, res["1"])
+	require.Contains(t, res["3"], ErrUnsupportedEncryptedTx.Error())
+	require.Equal(t, *policy, GetThresholdPolicy())
+
+	etx, err := getEncryptedTx(encryptedTxKey(1, 2))
+	require.NoError(t, err)
+	require.NotNil(t, etx)
+	require.False(t, etx.Decrypted)
+
+	// 超时后丢弃
+	require.Equal(t, "", executeEncryptedTxs(t, s, 1+EncryptedTxTimeout)["order"])
+	res = executeEncryptedTxs(t, s, 2+EncryptedTxTimeout)
+	require.Equal(t, "2", res["order"])
+	require.Equal(t, "decrypt timeout", res["2"])
+}
+
+func TestSaveEncryptedTxRejectsUnlabeled(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	d := newTestDkg(t, 4, 3)
+
+	// 保存的 secret 没有加密交易的 label 证明，不能作为加密交易提交
+	store, err := SealSecret(d.key, []byte("secret"), nil, "")
+	require.NoError(t, err)
+	txn := model.DBINS.NewTransaction()
+	require.NoError(t, s.SaveEncryptedTx(store, 1, 0, txn))
+	require.NoError(t, txn.Commit())
+
+	etx, err := getEncryptedTx(encryptedTxKey(1, 0))
+	require.NoError(t, err)
+	require.Nil(t, etx)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -44,6 +45,7 @@ func (app *SideChain) CheckEpochFromValidator() []byte {
 			sideValidators = append(sideValidators, &model.SideValidator{
 				Pubkey: v.ValidatorId.PublicKey,
 				Power:  1,
+				P2PId:  v.P2pId.Byte(),
 			})
 		}
 
@@ -82,6 +84,7 @@ func (app *SideChain) CheckEpochFromValidator() []byte {
 			err = app.dkg.TryEpochConsensus(model.ConsensusMsg{
 				Validators: validators,
 				Epoch:      epoch + 1,
+				Policy:     GetThresholdPolicy(),
 			}, app.newEpochSucceded, app.newEpochFail)
 			if err == nil {
 				return GetTxBytes(&model.Tx{
@@ -108,7 +111,7 @@ func (app *SideChain) newEpochSucceded(signer *dkg.DssSigner, nodeId uint64) {
 	// submit new epoch to main chain
 	call, _ := chains.MainChain.TxCallOfSetNextEpoch(nodeId, signer.AccountID())
 
-	client := chains.MainChain.GetClient()
+	client := chains.MainChain
 	err := client.SignAndSubmit(signer, *call, false, 0)
 	if err != nil {
 		util.LogWithRed("NewEpoch client.SignAndSubmit", err.Error())
@@ -117,6 +120,19 @@ func (app *SideChain) newEpochSucceded(signer *dkg.DssSigner, nodeId uint64) {
 
 // Callback when DKG consensus failed
 func (app *SideChain) newEpochFail(err error) {
+	// DKG 使用合格的 dealer 完成，作恶的 dealer 提交到侧链用于惩罚
+	var fault *dkg.DealerFaultError
+	if errors.As(err, &fault) {
+		for _, f := range fault.Faults {
+			validator := ""
+			if f.Validator != nil {
+				validator = f.Validator.ValidatorId.SS58()
+			}
+			util.LogWithRed("NewEpoch", "dealer fault at epoch", f.Epoch, "dealer", f.DealerIndex, validator, f.Reason)
+		}
+		reportDealerFaults(fault)
+		return
+	}
 	util.LogWithYellow("NewEpoch", "P2 Error", err.Error())
 }
 
@@ -198,6 +214,24 @@ func (app *SideChain) GetValidators() ([]*model.SideValidator, map[string]*model
 	return list, validatorMap, nil
 }
 
+// isValidatorNode caller 是否是当前 epoch 验证节点的 p2p key
+func (app *SideChain) isValidatorNode(caller []byte) bool {
+	if len(caller) == 0 {
+		return false
+	}
+
+	validators, _, err := app.GetValidators()
+	if err != nil {
+		return false
+	}
+	for _, v := range validators {
+		if v.Power > 0 && bytes.Equal(v.P2PId, caller) {
+			return true
+		}
+	}
+	return false
+}
+
 // Init validator to db From init chain
 func (app *SideChain) initValidators(vs []abci.ValidatorUpdate) error {
 	tx := model.DBINS.NewTransaction()
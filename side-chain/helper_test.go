@@ -0,0 +1,163 @@
+package sidechain
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/chains"
+	"github.com/wetee-dao/tee-dsecret/pkg/chains/mock"
+	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/network/local"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+)
+
+// openTestDB 打开空的测试数据库，测试结束后关闭并删除
+func openTestDB(t *testing.T) {
+	os.RemoveAll("./chain_data")
+	db, err := model.NewDB()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+		os.RemoveAll("./chain_data")
+	})
+}
+
+// newTestSideChain 使用内存网络的轻节点
+func newTestSideChain(t *testing.T) *SideChain {
+	s, err := NewSideChain(true, "")
+	require.NoError(t, err)
+
+	priv, _, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	s.SetPeer(local.NewMemNetwork().NewPeer(priv, nil))
+	return s
+}
+
+// testDkg n 个节点、门限 th 的 DKG 密钥，公钥和承诺保存为侧链的签名密钥和加密密钥
+type testDkg struct {
+	n, th int
+	pri   *share.PriPoly
+	pub   *share.PubPoly
+	key   *model.PubKey
+	// 节点的 p2p 公钥，和份额的序号一致
+	nodes []*model.Validator
+}
+
+func newTestDkg(t *testing.T, n, th int) *testDkg {
+	d := newTestKey(t, n, th)
+	d.saveSignKey(t)
+	// 同一个密钥也作为加密密钥
+	d.saveEncryptKey(t)
+	return d
+}
+
+// newTestKey 只生成密钥，不保存到状态中
+func newTestKey(t *testing.T, n, th int) *testDkg {
+	suite := suites.MustFind("Ed25519")
+	pri := share.NewPriPoly(suite, th, nil, suite.RandomStream())
+	pub := pri.Commit(nil)
+	key, err := model.PubKeyFromPoint(pub.Commit())
+	require.NoError(t, err)
+
+	d := &testDkg{n: n, th: th, pri: pri, pub: pub, key: key}
+	for i := range n {
+		_, p2p, err := model.GenerateEd25519KeyPair(rand.Reader)
+		require.NoError(t, err)
+		d.nodes = append(d.nodes, &model.Validator{NodeID: uint64(i), P2pId: *p2p})
+	}
+	return d
+}
+
+func (d *testDkg) rawCommits(t *testing.T) []byte {
+	_, commits := d.pub.Info()
+	raw, err := json.Marshal(model.KyberPoints{Public: commits})
+	require.NoError(t, err)
+	return raw
+}
+
+// saveSignKey 保存为侧链的签名密钥
+func (d *testDkg) saveSignKey(t *testing.T) {
+	txn := model.DBINS.NewTransaction()
+	require.NoError(t, txn.SetKey(GLOABL_STATE, "dkg_pub_key", d.key.Byte()))
+	require.NoError(t, txn.SetKey(GLOABL_STATE, "dkg_pub_commits", d.rawCommits(t)))
+	require.NoError(t, txn.Commit())
+}
+
+// saveEncryptKey 保存为侧链的加密密钥
+func (d *testDkg) saveEncryptKey(t *testing.T) {
+	require.NoError(t, model.SetJson(KeyGroupSpace, EncryptKeyGroup, &model.KeyGroup{
+		Id: EncryptKeyGroup, DkgPub: d.key.Byte(), DkgCommits: d.rawCommits(t),
+	}))
+}
+
+// distKeyShare 节点 i 的份额
+func (d *testDkg) distKeyShare(i int) model.DistKeyShare {
+	_, commits := d.pub.Info()
+	return model.DistKeyShare{
+		CommitsWrap:  model.KyberPoints{Public: commits},
+		PriShareWrap: model.PriShare{PriShare: d.pri.Eval(uint32(i))},
+	}
+}
+
+// useTestMainChain 使用 DKG 节点作为验证节点的模拟主链，侧链使用默认的门限策略
+func (d *testDkg) useTestMainChain(t *testing.T, s *SideChain) *mock.Chain {
+	priv, _, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	main := mock.NewChain(priv, mock.DefaultConfig(d.nodes...))
+
+	old := chains.MainChain
+	chains.MainChain = main
+	t.Cleanup(func() { chains.MainChain = old })
+
+	s.dkg = &dkg.DKG{Policy: model.DefaultThresholdPolicy()}
+	require.Equal(t, d.th, s.dkg.Policy.Threshold(d.n))
+	return main
+}
+
+// reencryptResp 节点 i 使用份额为请求的所有读者重加密 secret 的响应
+func (d *testDkg) reencryptResp(t *testing.T, i int, req *model.PodStart, stores map[uint64]*model.SecretStore) *model.DecryptSharesResp {
+	resp := &model.DecryptSharesResp{
+		Req:          req,
+		SecretShares: make(map[uint64]*model.DecryptShare),
+		Replicas:     make([]*model.ReplicaShares, len(req.ReplicaKeys)),
+	}
+	for r := range resp.Replicas {
+		resp.Replicas[r] = &model.ReplicaShares{SecretShares: make(map[uint64]*model.DecryptShare)}
+	}
+	for r, reader := range readerKeys(req) {
+		secretShares, _, _ := replicaShares(resp, uint32(r))
+		for _, index := range req.Secrets {
+			reply, err := proxy_reenc.Reencrypt(d.distKeyShare(i), stores[index], *reader)
+			require.NoError(t, err)
+			secretShares[index], err = EncodeDecryptShare(reply, req.Id)
+			require.NoError(t, err)
+		}
+	}
+	return resp
+}
+
+// saveTestSecret 使用 DKG 公钥加密 data，保存为 owner 的 secret
+func saveTestSecret(t *testing.T, s *SideChain, d *testDkg, owner types.H160, index uint64, data []byte) *model.SecretStore {
+	store, err := SealSecret(d.key, data, nil, "")
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	require.NoError(t, abci.WriteMessage(store, buf))
+
+	txn := model.DBINS.NewTransaction()
+	require.NoError(t, s.SaveSecret(owner, index, buf.Bytes(), txn))
+	require.NoError(t, txn.Commit())
+
+	stores, err := s.GetSecrets(owner, []uint64{index})
+	require.NoError(t, err)
+	return stores[index]
+}
@@ -1,5 +1,6 @@
 // tx_sync.go 用于记录提交到主链的交易：SyncToHub、tx_index_ 存储（call/hubCalls）、
 // SyncTxStart/End 状态（HubSyncIndexKey、HubSyncStep1/2/End、IsHubSyncRuning）及清理逻辑。
+// 每个批量交易的提交状态和失败重试见 tx_status.go、tx_retry.go。
 package sidechain
 
 import (
@@ -29,24 +30,20 @@ func (s *SideChain) SyncToHub(txIndex int64, sigs [][]byte) error {
 	signer.SetSigs(sigs)
 
 	// submit sync tx to polkadot hub
-	client := chains.MainChain.GetClient()
+	client := chains.MainChain
 	err = client.SignAndSubmit(signer, *call, false, 0)
 	if err != nil {
 		util.LogWithRed("Sync to polkadot hub", "error => ", err.Error())
 		fmt.Println("                    ", " SS58 => ", s.dkg.DkgPubKey.SS58())
 		fmt.Println("                    ", " SYNC at batch tx id", txIndex)
-		// 提交失败时，将 SyncTxRetry 提交到 mempool，由下一轮 proposer 打包进块
-		// （只有本节点会执行 SyncToHub，故只提交一次；mempool 会广播给其他节点）
-		util.LogWithYellow("Sync to polkadot hub", "submitting SyncTxRetry to mempool", "txIndex:", txIndex)
-		SubmitTx(&model.Tx{
-			Payload: &model.Tx_SyncTxRetry{
-				SyncTxRetry: txIndex,
-			},
-		})
+		// 提交失败时记录状态，可重试的错误由 RetryManager 按指数退避重试
+		// （只有本节点会执行 SyncToHub，重试也由本节点发起）
+		onSyncFailed(txIndex, err)
 		return err
 	}
 
 	util.LogWithGreen("Sync to polkadot hub", "success at batch tx id", fmt.Sprint(txIndex))
+	onSyncSubmitted(txIndex)
 	// 仅成功后提交 SyncHubEnd，使所有节点在 FinalizeTx 处理时统一清理 tx_index_ 储存
 	SubmitTx(&model.Tx{
 		Payload: &model.Tx_SyncTxEnd{
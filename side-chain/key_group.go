@@ -0,0 +1,450 @@
+package sidechain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/chains"
+	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	"github.com/wetee-dao/tee-dsecret/side-chain/pallets/dao"
+)
+
+// 密钥组：按区域、租户或用途划分的独立 DKG 密钥
+// 1. 治理交易设置密钥组的成员和门限策略，成员必须是当前的验证节点
+// 2. 区块提交后成员节点启动密钥组的 DKG 实例，由确定的发起节点开始密钥组的下一个 epoch
+// 3. 发起者收到 quorum 个成员完成后提交 KeyGroupEpoch 交易，交易带上成员对结果的签名，交易提交后成员切换到新份额
+// 4. 使用密钥组加密的 secret 记录密钥组，重加密请求只发送给密钥组的成员
+
+const (
+	KeyGroupSpace = "key_group"
+	// ABCI 事件类型
+	KeyGroupEventType = "key_group"
+)
+
+var keyGroupIdRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// keyGroups 本节点运行的密钥组 DKG 实例
+type keyGroups struct {
+	mu   sync.Mutex
+	mux  *dkg.GroupMux
+	dkgs map[string]*dkg.DKG
+}
+
+func keyGroupKey(id string) []byte {
+	return model.ComboNamespaceKey(KeyGroupSpace, id)
+}
+
+// SetKeyGroups 使用 DKG 的 P2P 通道运行密钥组，并启动本节点所在的密钥组
+func (s *SideChain) SetKeyGroups(mux *dkg.GroupMux) error {
+	s.groups = &keyGroups{
+		mux:  mux,
+		dkgs: make(map[string]*dkg.DKG),
+	}
+
+	groups, err := GetKeyGroups()
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		if _, err := s.keyGroupDKG(g); err != nil {
+			util.LogWithRed("KeyGroup", "start group", g.Id, "error:", err.Error())
+		}
+	}
+	return nil
+}
+
+// keyGroupDKG 获取密钥组的 DKG 实例，本节点是成员且实例未启动时启动
+func (s *SideChain) keyGroupDKG(g *model.KeyGroup) (*dkg.DKG, error) {
+	if s.groups == nil || s.dkg == nil {
+		return nil, errors.New("key groups are not ready")
+	}
+
+	s.groups.mu.Lock()
+	defer s.groups.mu.Unlock()
+	if d, ok := s.groups.dkgs[g.Id]; ok {
+		return d, nil
+	}
+	if !isKeyGroupMember(g, s.dkg.Signer.GetPublic()) {
+		return nil, nil
+	}
+
+	d, err := dkg.NewGroupDKG(g.Id, s.dkg.Signer, s.groups.mux, dkg.Logger{NodeTag: "DKG " + g.Id})
+	if err != nil {
+		return nil, err
+	}
+	d.SetConsensusCallback(func(*dkg.DssSigner, uint64) {}, func(err error) {
+		util.LogWithYellow("KeyGroup", g.Id, "DKG error:", err.Error())
+		var fault *dkg.DealerFaultError
+		if errors.As(err, &fault) {
+			reportDealerFaults(fault)
+		}
+	})
+	d.SetGroupCallback(s.keyGroupDone)
+	go d.Start()
+
+	s.groups.dkgs[g.Id] = d
+	util.LogWithYellow("KeyGroup", "start group", g.Id)
+	return d, nil
+}
+
+// localKeyGroup 本节点已启动的密钥组 DKG 实例，空 id 为全网的 DKG
+func (s *SideChain) localKeyGroup(id string) (*dkg.DKG, error) {
+	if id == "" {
+		if s.dkg == nil {
+			return nil, errors.New("dkg is not ready")
+		}
+		return s.dkg, nil
+	}
+	if s.groups == nil {
+		return nil, errors.New("key groups are not ready")
+	}
+
+	s.groups.mu.Lock()
+	defer s.groups.mu.Unlock()
+	d, ok := s.groups.dkgs[id]
+	if !ok || d.DkgKeyShare == nil {
+		return nil, fmt.Errorf("node is not a member of key group %s", id)
+	}
+	return d, nil
+}
+
+func isSideValidator(validators []*model.SideValidator, pub []byte) bool {
+	for _, v := range validators {
+		if v.Power > 0 && bytes.Equal(v.Pubkey, pub) {
+			return true
+		}
+	}
+	return false
+}
+
+func isKeyGroupMember(g *model.KeyGroup, pub *model.PubKey) bool {
+	for _, m := range g.Members {
+		if bytes.Equal(m, pub.Byte()) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetKeyGroup 由 DAO gov/sudo 账户创建或修改密钥组，成员或策略变更后开始密钥组的下一个 epoch
+// 上一个 epoch 还未完成时由下一个成员重新发起
+func (s *SideChain) SetKeyGroup(caller []byte, g *model.KeyGroup, txn *model.Txn) error {
+	if !dao.IsSudo(caller, txn) {
+		return errors.New("key group: must call by gov/sudo")
+	}
+	if err := s.validateKeyGroup(g); err != nil {
+		return err
+	}
+	return s.setKeyGroup(g, txn)
+}
+
+// setKeyGroup 保存密钥组的新成员和策略，保留当前的密钥，等待下一个 epoch 完成
+func (s *SideChain) setKeyGroup(g *model.KeyGroup, txn *model.Txn) error {
+	old, err := model.TxnGetJson[model.KeyGroup](txn, keyGroupKey(g.Id))
+	if err != nil {
+		return err
+	}
+	group := &model.KeyGroup{
+		Id:       g.Id,
+		Members:  g.Members,
+		Policy:   g.Policy,
+		RegionId: g.RegionId,
+		Pending:  1,
+	}
+	if old != nil {
+		group.Epoch = old.Epoch
+		group.DkgPub = old.DkgPub
+		group.DkgCommits = old.DkgCommits
+		group.Pending = old.Epoch + 1
+		if old.Pending != 0 {
+			group.Attempt = old.Attempt + 1
+		}
+	}
+
+	util.LogWithYellow("KeyGroup", "set", group.Id, "members", len(group.Members), "epoch", group.Pending, "attempt", group.Attempt)
+	s.pendingGroups = append(s.pendingGroups, group)
+	return model.TxnSetJson(txn, keyGroupKey(group.Id), group)
+}
+
+// validateKeyGroup 检查密钥组的 id、策略和成员
+func (s *SideChain) validateKeyGroup(g *model.KeyGroup) error {
+	if !keyGroupIdRegexp.MatchString(g.Id) {
+		return errors.New("key group: invalid id " + g.Id)
+	}
+	if g.Id == EncryptKeyGroup {
+		return errors.New("key group: " + g.Id + " is reserved")
+	}
+	if g.Policy != nil {
+		if err := g.Policy.Validate(); err != nil {
+			return err
+		}
+	}
+	if len(g.Members) < 2 {
+		return errors.New("key group: needs at least 2 members")
+	}
+
+	validators, _, err := s.GetValidators()
+	if err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	for _, m := range g.Members {
+		pub := model.PubKeyFromByte(m)
+		if !isSideValidator(validators, m) {
+			return errors.New("key group: member " + pub.SS58() + " is not validator")
+		}
+		if seen[pub.SS58()] {
+			return errors.New("key group: duplicate member " + pub.SS58())
+		}
+		seen[pub.SS58()] = true
+	}
+	return nil
+}
+
+// sponsorKeyGroup 区块提交后成员启动密钥组，本节点是发起节点时开始密钥组的下一个 epoch
+func (s *SideChain) sponsorKeyGroup(g *model.KeyGroup) {
+	d, err := s.keyGroupDKG(g)
+	if err != nil {
+		util.LogWithRed("KeyGroup", "start group", g.Id, "error:", err.Error())
+		return
+	}
+	if d == nil {
+		return
+	}
+
+	if !bytes.Equal(keyGroupSponsor(g), s.dkg.Signer.GetPublic().Byte()) {
+		return
+	}
+
+	members, err := keyGroupValidators(g)
+	if err != nil {
+		util.LogWithRed("KeyGroup", g.Id, err.Error())
+		return
+	}
+	if err := d.TryGroupEpoch(members, g.Pending, keyGroupPolicy(g)); err != nil {
+		util.LogWithRed("KeyGroup", g.Id, "TryGroupEpoch error:", err.Error())
+	}
+}
+
+// keyGroupSponsor 密钥组 pending epoch 的发起节点，重新发起时轮换到下一个成员
+func keyGroupSponsor(g *model.KeyGroup) []byte {
+	return g.Members[(g.Pending+g.Attempt)%uint32(len(g.Members))]
+}
+
+// keyGroupValidators 从主链的验证节点中获取成员的 P2P id
+func keyGroupValidators(g *model.KeyGroup) ([]*model.Validator, error) {
+	if chains.MainChain == nil {
+		return nil, errors.New("main chain is not connected")
+	}
+	validators, err := chains.MainChain.GetValidatorList()
+	if err != nil {
+		return nil, fmt.Errorf("get validator list: %w", err)
+	}
+
+	members := make([]*model.Validator, 0, len(g.Members))
+	for _, m := range g.Members {
+		var found *model.Validator
+		for _, v := range validators {
+			if bytes.Equal(v.ValidatorId.Byte(), m) {
+				found = v
+			}
+		}
+		if found == nil {
+			return nil, errors.New("member " + model.PubKeyFromByte(m).SS58() + " is not main chain validator")
+		}
+		members = append(members, found)
+	}
+	return members, nil
+}
+
+// keyGroupDone 密钥组 DKG 完成后由发起者提交交易
+func (s *SideChain) keyGroupDone(r *model.KeyGroupEpoch) {
+	_, err := SubmitTx(&model.Tx{
+		Payload: &model.Tx_KeyGroupEpoch{KeyGroupEpoch: r},
+	})
+	if err != nil {
+		util.LogWithRed("KeyGroup", "SubmitTx error:", err.Error())
+	}
+}
+
+// SaveKeyGroupEpoch 验证密钥组的 DKG 结果并保存，已有密钥的密钥组公钥不能改变
+// 交易必须由发起节点提交，并带上 quorum 个成员对结果的签名
+// 无效的交易只记录日志，不影响区块中的其他交易
+func (s *SideChain) SaveKeyGroupEpoch(r *model.KeyGroupEpoch, caller []byte, txn *model.Txn) ([]abci.Event, error) {
+	g, err := model.TxnGetJson[model.KeyGroup](txn, keyGroupKey(r.Id))
+	if err != nil {
+		return nil, err
+	}
+	if g == nil || g.Pending == 0 || g.Pending != r.Epoch {
+		util.LogWithYellow("SaveKeyGroupEpoch", "key group epoch is not pending, skip", r.Id, r.Epoch)
+		return nil, nil
+	}
+
+	validators, _, err := s.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	sponsor := keyGroupSponsor(g)
+	if !slices.ContainsFunc(validators, func(v *model.SideValidator) bool {
+		return bytes.Equal(v.Pubkey, sponsor) && bytes.Equal(v.P2PId, caller)
+	}) {
+		util.LogWithYellow("SaveKeyGroupEpoch", "key group epoch is not submitted by sponsor, skip", r.Id, r.Epoch)
+		return nil, nil
+	}
+
+	if err := verifyKeyGroupCommits(g, r); err != nil {
+		util.LogWithYellow("SaveKeyGroupEpoch", r.Id, err.Error())
+		return nil, nil
+	}
+	quorum := keyGroupPolicy(g).Quorum(len(g.Members))
+	acks := model.CountRoundAcks(r.Acks, r.AckBytes(), func(v []byte) bool {
+		return isKeyGroupMember(g, model.PubKeyFromByte(v))
+	})
+	if acks < quorum {
+		util.LogWithYellow("SaveKeyGroupEpoch", "not enough acks, skip", r.Id, r.Epoch, acks, "<", quorum)
+		return nil, nil
+	}
+
+	g.Epoch = r.Epoch
+	g.DkgPub = r.DkgPub
+	g.DkgCommits = r.DkgCommits
+	g.Pending = 0
+	g.Attempt = 0
+	if err := model.TxnSetJson(txn, keyGroupKey(g.Id), g); err != nil {
+		return nil, err
+	}
+
+	s.doneGroups = append(s.doneGroups, r)
+	return []abci.Event{keyGroupEvent(g)}, nil
+}
+
+// keyGroupPolicy 密钥组的门限策略，未设置时使用默认策略
+func keyGroupPolicy(g *model.KeyGroup) model.ThresholdPolicy {
+	if g.Policy != nil {
+		return *g.Policy
+	}
+	return model.DefaultThresholdPolicy()
+}
+
+// verifyKeyGroupCommits 承诺的第一个点为密钥组公钥，门限和密钥组的策略一致
+func verifyKeyGroupCommits(g *model.KeyGroup, r *model.KeyGroupEpoch) error {
+	points := &model.KyberPoints{}
+	if err := json.Unmarshal(r.DkgCommits, points); err != nil {
+		return fmt.Errorf("key group commits: %w", err)
+	}
+
+	if len(points.Public) != keyGroupPolicy(g).Threshold(len(g.Members)) {
+		return errors.New("key group commits mismatch threshold")
+	}
+
+	pub, err := points.Public[0].MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pub, r.DkgPub) {
+		return errors.New("key group commits mismatch dkg pub key")
+	}
+	if len(g.DkgPub) > 0 && !bytes.Equal(g.DkgPub, r.DkgPub) {
+		return errors.New("key group changed dkg pub key")
+	}
+	return nil
+}
+
+// ApplyKeyGroups 区块中的交易执行完成后，成员切换到密钥组的新份额
+func (s *SideChain) ApplyKeyGroups() {
+	for _, r := range s.doneGroups {
+		util.LogWithGreen("KeyGroup", "finalized", r.Id, "epoch", r.Epoch)
+		if s.groups == nil {
+			continue
+		}
+
+		s.groups.mu.Lock()
+		d := s.groups.dkgs[r.Id]
+		s.groups.mu.Unlock()
+		if d == nil {
+			continue
+		}
+		if err := d.ApplyGroupEpoch(r.Epoch, r.DkgCommits); err != nil {
+			util.LogWithRed("ApplyKeyGroups", err.Error())
+		}
+	}
+}
+
+func keyGroupEvent(g *model.KeyGroup) abci.Event {
+	return abci.Event{
+		Type: KeyGroupEventType,
+		Attributes: []abci.EventAttribute{
+			{Key: "id", Value: g.Id, Index: true},
+			{Key: "epoch", Value: fmt.Sprint(g.Epoch)},
+		},
+	}
+}
+
+// GetKeyGroup 获取密钥组
+func GetKeyGroup(id string) (*model.KeyGroup, error) {
+	return model.GetJson[model.KeyGroup](KeyGroupSpace, id)
+}
+
+// GetKeyGroups 获取所有密钥组
+func GetKeyGroups() ([]*model.KeyGroup, error) {
+	groups, _, err := model.GetJsonList[model.KeyGroup](KeyGroupSpace, "")
+	return groups, err
+}
+
+// keyGroupKeys 密钥组的公钥和份额承诺，空 id 为全网的 DKG 密钥
+func keyGroupKeys(id string) ([]byte, *model.KyberPoints, error) {
+	if id == "" {
+		pub, err := GetDkgPubkey()
+		if err != nil {
+			return nil, nil, err
+		}
+		commits, err := GetDkgCommits()
+		if err != nil {
+			return nil, nil, err
+		}
+		return pub.ToBytes(), commits, nil
+	}
+
+	g, err := GetKeyGroup(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if g == nil || len(g.DkgPub) == 0 {
+		return nil, nil, fmt.Errorf("key group %s has no dkg key", id)
+	}
+	commits := &model.KyberPoints{}
+	if err := json.Unmarshal(g.DkgCommits, commits); err != nil {
+		return nil, nil, fmt.Errorf("key group %s commits: %w", id, err)
+	}
+	return g.DkgPub, commits, nil
+}
+
+// VerifyKeyGroupTx 提交前检查治理交易，避免无效交易进入区块
+func (s *SideChain) VerifyKeyGroupTx(tx *model.Tx) error {
+	if err := model.VerifyTxSigner(tx); err != nil {
+		return err
+	}
+	g := tx.GetKeyGroup()
+	if g == nil {
+		return errors.New("key group: invalid tx type")
+	}
+	if err := s.validateKeyGroup(g); err != nil {
+		return err
+	}
+
+	txn := model.DBINS.NewTransaction()
+	defer txn.Rollback()
+	if !dao.IsSudo(tx.GetCaller(), txn) {
+		return errors.New("key group: must call by gov/sudo")
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	cfg "github.com/cometbft/cometbft/config"
 	cmtflags "github.com/cometbft/cometbft/libs/cli/flags"
@@ -15,9 +16,11 @@ import (
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/version"
+	chain "github.com/wetee-dao/ink.go"
 	"github.com/wetee-dao/tee-dsecret/pkg/chains"
 	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
 	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	p2peer "github.com/wetee-dao/tee-dsecret/pkg/network"
 	bftbrigde "github.com/wetee-dao/tee-dsecret/pkg/network/bft-brigde"
 	"github.com/wetee-dao/tee-dsecret/pkg/util"
 )
@@ -25,6 +28,9 @@ import (
 var SideChainNode *nm.Node
 var P2PKey *model.PubKey
 
+// 节点自己提交的交易使用 p2p key 签名
+var p2pSigner *chain.Signer
+
 // init side chain
 func InitSideChain(
 	chainPort int,
@@ -38,7 +44,7 @@ func InitSideChain(
 	}
 
 	// 创建侧链实例
-	sideChain, err := NewSideChain(light)
+	sideChain, err := NewSideChain(light, "back_tx")
 	if err != nil {
 		return nil, nil, nil, errors.New("NewSideChain error: " + err.Error())
 	}
@@ -51,6 +57,11 @@ func InitSideChain(
 
 	consensusConf := cfg.DefaultConsensusConfig()
 	consensusConf.CreateEmptyBlocks = false
+	// 没有交易时按间隔出空块，本地开发时用于推进 epoch 检查，0 为不出空块
+	if interval := util.GetEnvInt("SIDE_CHAIN_EMPTY_BLOCK_INTERVAL", 0); interval > 0 {
+		consensusConf.CreateEmptyBlocks = true
+		consensusConf.CreateEmptyBlocksInterval = time.Duration(interval) * time.Second
+	}
 
 	rpcConf := cfg.DefaultRPCConfig()
 	rpcConf.CORSAllowedOrigins = []string{"*"}
@@ -95,8 +106,9 @@ func InitSideChain(
 		return nil, nil, nil, errors.New("failed to load node key: " + err.Error())
 	}
 
-	_, p2pKey, _ := model.GetP2PKey()
+	signer, p2pKey, _ := model.GetP2PKey()
 	P2PKey = p2pKey.GetPublic()
+	p2pSigner = signer
 
 	// add boot nodes
 	seeds := []string{}
@@ -122,7 +134,7 @@ func InitSideChain(
 	}
 
 	// add DKG to chain node
-	p2pReactor := bftbrigde.NewBTFReactor("DKG")
+	p2pReactor := bftbrigde.NewBTFReactor("DKG", p2pKey)
 
 	// init BFT node
 	SideChainNode, err = nm.NewNode(
@@ -143,23 +155,43 @@ func InitSideChain(
 		return nil, nil, nil, errors.New("init BFT node error: " + err.Error())
 	}
 
+	// 用户签名的授权和撤销包含侧链的 chain id
+	model.SignChainId = SideChainNode.GenesisDoc().ChainID
+
 	// call callback function
 	callback()
 
-	sideChain.p2p = p2pReactor
-	if !light {
+	sideChain.SetPeer(p2pReactor)
+
+	return SideChainNode, sideChain, p2pReactor, err
+}
+
+// SetPeer 使用 p 收发节点之间的消息，订阅侧链的消息，非轻节点处理主链交易的部分签名
+func (s *SideChain) SetPeer(p p2peer.Peer) {
+	s.p2p = p
+	if s.txCh != nil {
 		// add hook for partial sign
-		p2pReactor.Sub("block-partial-sign", sideChain.revPartialSign)
-		go sideChain.txCh.Start(sideChain.handlePartialSign)
+		p.Sub("block-partial-sign", s.revPartialSign)
+		go s.txCh.Start(s.handlePartialSign)
 	}
 
-	p2pReactor.Sub("secret", sideChain.revSecret)
+	p.Sub("secret", s.revSecret)
+	p.Sub("blob", s.revBlob)
+	p.Sub("sign", s.revSign)
+}
 
-	return SideChainNode, sideChain, p2pReactor, err
+// Stop 停止处理部分签名，未处理的部分签名保存在队列中，重启后继续处理
+func (s *SideChain) Stop() {
+	if s.txCh != nil {
+		s.txCh.Stop()
+	}
 }
 
 func (s *SideChain) SetDKG(dkg *dkg.DKG) {
 	s.dkg = dkg
+	// 重启后恢复的 DKG 轮次使用
+	dkg.SetConsensusCallback(s.newEpochSucceded, s.newEpochFail)
+	dkg.SetRefreshCallback(s.shareRefreshDone)
 }
 
 func (s *SideChain) GetDKG() *dkg.DKG {
@@ -6,6 +6,11 @@ import (
 	"github.com/wetee-dao/tee-dsecret/pkg/model"
 )
 
+// IsSudo 调用方是否为 DAO 的 gov/sudo 账户，供其他 pallet 校验治理调用
+func IsSudo(caller []byte, txn *model.Txn) bool {
+	return isSudo(caller, newDaoStateState(txn))
+}
+
 func daoSetPublicJoin(caller []byte, m *model.DaoSetPublicJoin, txn *model.Txn) error {
 	state := newDaoStateState(txn)
 	if len(state.Members()) == 0 {
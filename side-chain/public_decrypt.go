@@ -0,0 +1,123 @@
+package sidechain
+
+import (
+	"crypto/sha512"
+	"errors"
+	"fmt"
+
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+)
+
+// 公开解密：节点把份额重加密到公开的读者公钥并作为交易提交，份额使用链上的加密密钥承诺验证
+// 收集到 threshold 个份额后所有节点得到相同的明文，用于条件解密和加密交易
+
+// errInvalidPublicShare 份额无效，只跳过该份额
+var errInvalidPublicShare = errors.New("invalid public decrypt share")
+
+// publicReader 公开的读者密钥，结果本来就要公开，所以任何人都可以使用这个私钥
+func publicReader() (kyber.Scalar, *model.PubKey, error) {
+	suite := suites.MustFind("Ed25519")
+	h := sha512.Sum512([]byte("wetee/disclosure-reader"))
+	sk := suite.Scalar().SetBytes(h[:])
+	pub, err := model.PubKeyFromPoint(suite.Point().Mul(sk, nil))
+	if err != nil {
+		return nil, nil, err
+	}
+	return sk, pub, nil
+}
+
+// reencryptPublic 使用本节点的份额把密文重加密到公开读者公钥
+func (s *SideChain) reencryptPublic(store *model.SecretStore, id uint64) (*model.DecryptShare, error) {
+	_, reader, err := publicReader()
+	if err != nil {
+		return nil, err
+	}
+	d, err := s.localKeyGroup(EncryptKeyGroup)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := proxy_reenc.Reencrypt(d.Share(), store, *reader)
+	if err != nil {
+		return nil, fmt.Errorf("reencrypt: %w", err)
+	}
+	return EncodeDecryptShare(reply, id)
+}
+
+// verifyPublicShare 使用链上加密密钥的承诺验证份额，返回恢复需要的份额数量
+func verifyPublicShare(store *model.SecretStore, eshare *model.DecryptShare) (int, error) {
+	suite := suites.MustFind("Ed25519")
+	_, commits, err := keyGroupKeys(EncryptKeyGroup)
+	if err != nil {
+		return 0, err
+	}
+	_, reader, err := publicReader()
+	if err != nil {
+		return 0, err
+	}
+
+	reply, err := DecodeDecryptShare(eshare, suite)
+	if err == nil {
+		err = proxy_reenc.Verify(share.NewPubPoly(suite, nil, commits.Public), store, *reader, reply)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w %d: %s", errInvalidPublicShare, eshare.ShareIndex, err)
+	}
+	return len(commits.Public), nil
+}
+
+// recoverPublic 从份额恢复重加密承诺并使用公开读者密钥解密
+func recoverPublic(store *model.SecretStore, eshares []*model.DecryptShare, threshold int) ([]byte, error) {
+	// payload 为空时解密结果是 data key 本身，不能公开
+	if len(store.Payload) == 0 {
+		return nil, errors.New("empty payload")
+	}
+	suite := suites.MustFind("Ed25519")
+	shares := make([]*share.PubShare, 0, len(eshares))
+	for _, s := range eshares {
+		reply, err := DecodeDecryptShare(s, suite)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, &reply.Share)
+	}
+	xncCmt, err := proxy_reenc.Recover(suite, shares, threshold, len(shares))
+	if err != nil {
+		return nil, fmt.Errorf("recover reencrypt reply: %w", err)
+	}
+	if xncCmt == nil {
+		return nil, fmt.Errorf("valid shares %d, need %d", len(shares), threshold)
+	}
+
+	dkgPubKey, _, err := keyGroupKeys(EncryptKeyGroup)
+	if err != nil {
+		return nil, err
+	}
+	dkgPub := model.PubKeyFromByte(dkgPubKey)
+
+	encScrt := make([]kyber.Point, len(store.RawEncScrt))
+	for i, raw := range store.RawEncScrt {
+		encScrt[i] = suite.Point()
+		if err := encScrt[i].UnmarshalBinary(raw); err != nil {
+			return nil, fmt.Errorf("unmarshal enc scrt: %w", err)
+		}
+	}
+	readerSk, _, err := publicReader()
+	if err != nil {
+		return nil, err
+	}
+	return proxy_reenc.DecryptEnvelope(suite, encScrt, dkgPub.Point(), xncCmt, readerSk, store.Payload)
+}
+
+func hasDecryptShare(shares []*model.DecryptShare, index int32) bool {
+	for _, s := range shares {
+		if s.ShareIndex == index {
+			return true
+		}
+	}
+	return false
+}
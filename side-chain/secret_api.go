@@ -1,7 +1,10 @@
 package sidechain
 
 import (
+	"context"
 	"fmt"
+	"runtime"
+	"sync"
 	"time"
 
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
@@ -15,290 +18,636 @@ import (
 	"github.com/wetee-dao/tee-dsecret/pkg/util"
 )
 
-// PreRecerve is the channel to receive DecryptShares
-var preRecerve map[uint64]chan *model.DecryptSharesResp = make(map[uint64]chan *model.DecryptSharesResp)
+// 重加密的 secret 类型
+const (
+	ShareKindSecret = "secret"
+	ShareKindDisk   = "disk"
+	ShareKindShared = "shared"
+)
+
+// shareKey 请求中一个读者的一个 secret
+// replica 0 为 pub_key，i 为 replica_keys[i-1]；shared 的 index 为 req.Shared 中的位置
+type shareKey struct {
+	replica uint32
+	kind    string
+	index   uint64
+}
+
+// verifiedShares 一个节点的重加密响应中通过验证的份额
+type verifiedShares struct {
+	from    string
+	err     string
+	shares  map[shareKey]*share.PubShare
+	invalid []*model.ShareFault
+}
+
+// requestStores 请求中 secret 的密文，所有读者相同
+type requestStores struct {
+	secrets map[uint64]*model.SecretStore
+	disks   map[uint64]*model.SecretStore
+	shared  []*model.SecretStore
+}
+
+func (rs *requestStores) get(k shareKey) *model.SecretStore {
+	switch k.kind {
+	case ShareKindSecret:
+		return rs.secrets[k.index]
+	case ShareKindDisk:
+		return rs.disks[k.index]
+	default:
+		return rs.shared[k.index]
+	}
+}
+
+// group 请求中 secret 使用的密钥组，一个请求不能混用多个密钥组，不存在的 secret 不影响
+func (rs *requestStores) group() (string, error) {
+	group := ""
+	first := true
+	check := func(store *model.SecretStore) error {
+		if store == nil || len(store.RawEncCmt) == 0 {
+			return nil
+		}
+		if first {
+			group, first = store.Group, false
+			return nil
+		}
+		if store.Group != group {
+			return errors.New("secrets of different key groups in one request")
+		}
+		return nil
+	}
+
+	for _, store := range rs.secrets {
+		if err := check(store); err != nil {
+			return "", err
+		}
+	}
+	for _, store := range rs.disks {
+		if err := check(store); err != nil {
+			return "", err
+		}
+	}
+	for _, store := range rs.shared {
+		if err := check(store); err != nil {
+			return "", err
+		}
+	}
+	return group, nil
+}
 
 // Recive msg from p2p
 func (s *SideChain) revSecret(m any) error {
 	mbox := m.(*model.SecretBox)
 	switch msg := mbox.Payload.(type) {
 	case *model.SecretBox_Req:
-		return s.HandleReencryptReq(msg.Req, mbox.From)
+		return s.HandleReencryptReq(msg.Req, mbox.ReqId, mbox.From)
 	case *model.SecretBox_SharesResp:
-		return s.VerifyReencryptResp(msg.SharesResp)
+		return s.VerifyReencryptResp(msg.SharesResp, mbox.ReqId, mbox.From)
+	case *model.SecretBox_MigrateReq:
+		return s.HandleSecretMigrateReq(msg.MigrateReq, mbox.ReqId, mbox.From)
+	case *model.SecretBox_MigrateShares:
+		return s.receiveMigrateShares(msg.MigrateShares, mbox.ReqId, mbox.From)
 	default:
 		return fmt.Errorf("unknown secret message type")
 	}
 }
 
 // BroadcastDecryptSecret broadcast decrypt secret request to all nodes
-func (s *SideChain) BroadcastReencryptReq(req *model.PodStart) (*model.DecryptResp, error) {
-	suite := suites.MustFind("Ed25519")
-	validators, err := chains.MainChain.GetValidatorList()
+// 返回 req.PubKey 的结果，多副本请求使用 BroadcastReencryptBatch
+func (s *SideChain) BroadcastReencryptReq(ctx context.Context, req *model.PodStart) (*model.DecryptResp, error) {
+	resps, err := s.BroadcastReencryptBatch(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resps[0], nil
+}
+
+// BroadcastReencryptBatch 一轮请求为 req.PubKey 和 req.ReplicaKeys 的所有副本重加密，按读者顺序返回结果
+// 相同的请求进行中时等待该请求的结果，ctx 取消或会话超时后返回错误
+func (s *SideChain) BroadcastReencryptBatch(ctx context.Context, req *model.PodStart) ([]*model.DecryptResp, error) {
+	stores, err := s.loadRequestStores(req)
+	if err != nil {
+		return nil, err
+	}
+	group, err := stores.group()
+	if err != nil {
+		return nil, err
+	}
+
+	dkgPubKey, commits, err := keyGroupKeys(group)
+	if err != nil {
+		return nil, fmt.Errorf("get dkg pubkey: %w", err)
+	}
+
+	// 使用密钥组加密的 secret 只发送给密钥组的成员，门限为密钥组的门限
+	var validators []*model.Validator
+	threshold := len(commits.Public)
+	if group == "" {
+		validators, err = chains.MainChain.GetValidatorList()
+		if err != nil {
+			return nil, fmt.Errorf("get validator list: %w", err)
+		}
+		threshold = s.dkg.Policy.Threshold(len(validators))
+	} else {
+		g, err := GetKeyGroup(group)
+		if err != nil {
+			return nil, fmt.Errorf("get key group: %w", err)
+		}
+		validators, err = keyGroupValidators(g)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 初始化重加密会话，相同的请求共用一个会话
+	sess, leader, err := s.reencrypt.start(req, len(validators))
 	if err != nil {
-		return nil, fmt.Errorf("get validator list: %w", err)
+		return nil, fmt.Errorf("start reencrypt session: %w", err)
+	}
+	if leader {
+		height := s.blockHeight()
+		go func() {
+			resp, audit, err := s.reencryptWithSession(sess, req, stores, validators, dkgPubKey, threshold, height)
+			s.reencrypt.finish(sess, resp, err)
+			// 提交重加密审计记录
+			if audit != nil {
+				submitAuditLog(audit)
+			}
+		}()
 	}
-	threshold := len(validators)*2/3 + 1
-	validatorP2Pkeys := make([]*model.PubKey, 0, len(validators))
+
+	select {
+	case <-sess.done:
+		return sess.resp, sess.err
+	case <-ctx.Done():
+		s.reencrypt.leave(sess)
+		return nil, ctx.Err()
+	}
+}
+
+// reencryptWithSession 收集节点的重加密份额，直到每个 secret 都有 threshold 个有效份额，
+// 所有节点都已响应，或者会话超时。每个 secret 独立恢复，无法恢复的 secret 记录在 Unrecovered 中
+// height 为发起请求时的区块高度，用于检查 secret 的有效期。成功时同时返回需要提交的审计记录
+func (s *SideChain) reencryptWithSession(sess *reencryptSession, req *model.PodStart, stores *requestStores, validators []*model.Validator, dkgPubKey []byte, threshold int, height int64) ([]*model.DecryptResp, *model.AuditLog, error) {
+	suite := suites.MustFind("Ed25519")
+	n := len(validators)
+	validatorP2Pkeys := make([]*model.PubKey, 0, n)
 	for _, v := range validators {
 		validatorP2Pkeys = append(validatorP2Pkeys, &v.P2pId)
 	}
 
-	// 初始化重新加密回复
-	preRecerve[req.Id] = make(chan *model.DecryptSharesResp, len(validators))
+	// 请求的所有 secret
+	keys := requestShareKeys(req)
+	collected := make(map[shareKey][]*share.PubShare, len(keys))
+	// 每个读者使用了份额的节点，记录在审计中
+	contributors := make([]map[string]bool, len(req.ReplicaKeys)+1)
+	for i := range contributors {
+		contributors[i] = make(map[string]bool, n)
+	}
 
 	// send decrypt secret request to all nodes
-	dshares := make([]*model.DecryptSharesResp, 0, threshold)
-	err = s.p2p.Send(model.SendToNodes(validatorP2Pkeys), &model.SecretBox{
+	err := s.p2p.Send(model.SendToNodes(validatorP2Pkeys), &model.SecretBox{
+		ReqId: sess.id,
 		Payload: &model.SecretBox_Req{
 			Req: req,
 		},
 	})
 	if err != nil {
-		return nil, fmt.Errorf("send decrypt secret: %w", err)
+		return nil, nil, fmt.Errorf("send decrypt secret: %w", err)
 	}
 
-	// 收集至少达到阈值数量的节点响应
-	for range threshold {
+	// 收集节点响应，无效的份额丢弃并记录
+	responded := make(map[string]bool, n)
+	invalid := make([]*model.ShareFault, 0)
+	timeout := false
+	for !timeout && len(responded) < n && !sharesEnough(keys, collected, threshold, n-len(responded)) {
 		select {
-		case d := <-preRecerve[req.Id]:
-			if d.Error != nil {
-				return nil, fmt.Errorf("HandleDecryptSecret error: " + string(d.Error))
+		case d := <-sess.shares:
+			if responded[d.from] {
+				continue
 			}
-			dshares = append(dshares, d)
-		case <-time.After(30 * time.Second):
-			util.LogError("BroadcastDecryptSecret", "Timeout receiving from channel")
-			return nil, fmt.Errorf("timeout receiving from channel")
-		}
-	}
-
-	nameSpace := types.H160(req.NameSpace)
-	dkgPubKey, err := GetDkgPubkey()
-	if err != nil {
-		return nil, fmt.Errorf("get dkg pubkey: %w", err)
-	}
+			responded[d.from] = true
 
-	// shares 收集解密后的份额
-	secretShares := make(map[uint64][]*share.PubShare)
-	diskShares := make(map[uint64][]*share.PubShare)
-	for _, d := range dshares {
-		for index, s := range d.SecretShares {
-			if _, ok := secretShares[uint64(index)]; !ok {
-				secretShares[uint64(index)] = make([]*share.PubShare, 0, threshold)
+			if d.err != "" {
+				util.LogWithYellow("BroadcastReencryptReq", "node", d.from, "error:", d.err)
+				invalid = append(invalid, &model.ShareFault{Node: d.from, Reason: d.err})
+				continue
 			}
-
-			reply, err := DecodeDecryptShare(s, suite)
-			if err != nil {
-				return nil, fmt.Errorf("decode decrypt share: %w", err)
+			for k, sh := range d.shares {
+				if hasShareIndex(collected[k], sh.I) {
+					invalid = append(invalid, &model.ShareFault{Node: d.from, Kind: k.kind, Index: k.index, Replica: k.replica, Reason: "duplicate share index"})
+					continue
+				}
+				collected[k] = append(collected[k], sh)
+				contributors[k.replica][d.from] = true
 			}
-
-			secretShares[uint64(index)] = append(secretShares[uint64(index)], &reply.Share)
-		}
-		for index, s := range d.DiskShares {
-			if _, ok := diskShares[uint64(index)]; !ok {
-				diskShares[uint64(index)] = make([]*share.PubShare, 0, threshold)
+			for _, f := range d.invalid {
+				util.LogWithYellow("BroadcastReencryptReq", "invalid share from", f.Node, f.Kind, f.Index, f.Reason)
 			}
-
-			reply, err := DecodeDecryptShare(s, suite)
-			if err != nil {
-				return nil, fmt.Errorf("decode decrypt share: %w", err)
+			invalid = append(invalid, d.invalid...)
+		case <-sess.ctx.Done():
+			if errors.Is(sess.ctx.Err(), context.Canceled) {
+				return nil, nil, fmt.Errorf("reencrypt session %s: %w", sess.id, sess.ctx.Err())
 			}
-
-			diskShares[uint64(index)] = append(diskShares[uint64(index)], &reply.Share)
+			util.LogWithYellow("BroadcastReencryptReq", "session", sess.id, "timeout, responded", len(responded), "/", n)
+			timeout = true
 		}
 	}
 
-	secrets, err := s.GetSecrets(nameSpace, req.Secrets)
-	if err != nil {
-		return nil, fmt.Errorf("get secret: %w", err)
+	// 每个副本一个结果，节点级错误属于所有副本
+	resps := make([]*model.DecryptResp, len(req.ReplicaKeys)+1)
+	for i := range resps {
+		resps[i] = &model.DecryptResp{
+			DkgKey:   dkgPubKey,
+			Secrets:  make(map[uint64]*model.Secret),
+			DiskKeys: make(map[uint64]*model.Secret),
+			Shared:   make([]*model.Secret, len(stores.shared)),
+		}
 	}
-	encodeSecret := make(map[uint64]*model.Secret)
-	for index, shares := range secretShares {
-		// 从收集的响应中恢复重加密承诺
-		xncCmt, err := proxy_reenc.Recover(suite, shares, threshold, len(validators))
-		if err != nil {
-			return nil, fmt.Errorf("recover reencrypt reply: %s", err)
+	for _, f := range invalid {
+		if f.Kind == "" {
+			for _, resp := range resps {
+				resp.InvalidShares = append(resp.InvalidShares, f)
+			}
+			continue
 		}
+		resps[f.Replica].InvalidShares = append(resps[f.Replica].InvalidShares, f)
+	}
 
-		bt, err := xncCmt.MarshalBinary()
+	// 每个 secret 独立恢复
+	recovered := 0
+	for _, k := range keys {
+		resp := resps[k.replica]
+		secret, err := s.recoverSecret(suite, stores.get(k), collected[k], threshold, n, height)
 		if err != nil {
-			return nil, fmt.Errorf("marshal xnc cmt: %s", err)
+			resp.Unrecovered = append(resp.Unrecovered, &model.ShareFault{
+				Kind:    k.kind,
+				Index:   k.index,
+				Replica: k.replica,
+				Reason:  err.Error(),
+			})
+			continue
 		}
 
-		encodeSecret[index] = &model.Secret{
-			EncScrt: secrets[index].RawEncScrt,
-			XncCmt:  bt,
+		recovered++
+		switch k.kind {
+		case ShareKindSecret:
+			resp.Secrets[k.index] = secret
+		case ShareKindDisk:
+			resp.DiskKeys[k.index] = secret
+		case ShareKindShared:
+			resp.Shared[k.index] = secret
 		}
 	}
 
-	diskKeys, err := s.GetDiskKeys(nameSpace, req.Disks)
+	if len(keys) > 0 && recovered == 0 {
+		if timeout {
+			return nil, nil, fmt.Errorf("reencrypt session %s: %w", sess.id, sess.ctx.Err())
+		}
+		return nil, nil, fmt.Errorf("reencrypt session %s: no secret recovered, %d invalid shares", sess.id, len(invalid))
+	}
+
+	return resps, s.newSecretAudits(sess.id, req, resps, contributors), nil
+}
+
+// recoverSecret 从有效份额中恢复重加密承诺
+func (s *SideChain) recoverSecret(suite suites.Suite, store *model.SecretStore, shares []*share.PubShare, threshold, n int, height int64) (*model.Secret, error) {
+	if err := store.Window.Check(height, time.Now().Unix()); err != nil {
+		return nil, err
+	}
+	if len(shares) < threshold {
+		return nil, fmt.Errorf("valid shares %d, need %d", len(shares), threshold)
+	}
+
+	// 从收集的响应中恢复重加密承诺
+	xncCmt, err := proxy_reenc.Recover(suite, shares, threshold, n)
 	if err != nil {
-		return nil, fmt.Errorf("get diskKeys: %w", err)
+		return nil, fmt.Errorf("recover reencrypt reply: %s", err)
 	}
-	encodeDiskKey := make(map[uint64]*model.Secret)
-	for index, shares := range diskShares {
-		// 从收集的响应中恢复重加密承诺
-		xncCmt, err := proxy_reenc.Recover(suite, shares, threshold, len(validators))
-		if err != nil {
-			return nil, fmt.Errorf("recover reencrypt reply: %s", err)
-		}
 
-		bt, err := xncCmt.MarshalBinary()
-		if err != nil {
-			return nil, fmt.Errorf("marshal xnc cmt: %s", err)
-		}
+	bt, err := xncCmt.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal xnc cmt: %s", err)
+	}
 
-		encodeDiskKey[index] = &model.Secret{
-			EncScrt: diskKeys[index].RawEncScrt,
-			XncCmt:  bt,
-		}
+	payload, err := s.loadPayload(store)
+	if err != nil {
+		return nil, fmt.Errorf("load payload: %w", err)
 	}
 
-	return &model.DecryptResp{
-		DkgKey:   dkgPubKey.ToBytes(),
-		Secrets:  encodeSecret,
-		DiskKeys: encodeDiskKey,
+	return &model.Secret{
+		EncScrt: store.RawEncScrt,
+		XncCmt:  bt,
+		Payload: payload,
 	}, nil
-	// scrtHat, err := proxy_reenc.DecryptSecret(suite, encScrt, dkg.DkgPubKey.Point(), xncCmt, rdrSk.Scalar())
-	// if err != nil {
-	// 	return fmt.Errorf("decrypt secret: %s", err)
-	// }
 }
 
-// HandleDecryptSecret 处理解密请求
-func (s *SideChain) HandleReencryptReq(req *model.PodStart, from string) error {
-	dkg := s.dkg
-
-	// 获取重新加密所需的公钥和密文
-	clientPubKey := model.PubKeyFromByte(req.PubKey)
-	// 获取命名空间
+// loadRequestStores 获取请求中所有 secret 的密文
+func (s *SideChain) loadRequestStores(req *model.PodStart) (*requestStores, error) {
 	nameSpace := types.H160(req.NameSpace)
-	// 获取本节点的份额，并进行重新加密操作
-	dkgShare := dkg.Share()
-
-	// 重加密所有 secret
 	secrets, err := s.GetSecrets(nameSpace, req.Secrets)
 	if err != nil {
-		return fmt.Errorf("get secret: %w", err)
+		return nil, fmt.Errorf("get secret: %w", err)
+	}
+	diskKeys, err := s.GetDiskKeys(nameSpace, req.Disks)
+	if err != nil {
+		return nil, fmt.Errorf("get diskKeys: %w", err)
+	}
+	sharedSecrets, err := s.GetSharedSecrets(nameSpace, req.Shared)
+	if err != nil {
+		return nil, fmt.Errorf("get shared secrets: %w", err)
 	}
-	secretShares := make(map[uint64]*model.DecryptShare)
-	for index, secret := range secrets {
-		reply, err := proxy_reenc.Reencrypt(dkgShare, secret, *clientPubKey)
-		if err != nil {
-			return fmt.Errorf("reencrypt: %w", err)
-		}
 
-		// 编码重新加密的密文份额响应
-		eshare, err := EncodeDecryptShare(reply, req.Id)
-		if err != nil {
-			return fmt.Errorf("encode decrypt share: %w", err)
-		}
+	return &requestStores{
+		secrets: secrets,
+		disks:   diskKeys,
+		shared:  sharedSecrets,
+	}, nil
+}
 
-		// 构建重新加密的密文份额响应
-		secretShares[index] = eshare
+// readerKeys 请求的所有读者公钥，0 为 pub_key
+func readerKeys(req *model.PodStart) []*model.PubKey {
+	keys := make([]*model.PubKey, 0, len(req.ReplicaKeys)+1)
+	keys = append(keys, model.PubKeyFromByte(req.PubKey))
+	for _, k := range req.ReplicaKeys {
+		keys = append(keys, model.PubKeyFromByte(k))
 	}
+	return keys
+}
 
-	// 重加密所有的 disk key
-	disKeys, err := s.GetDiskKeys(nameSpace, req.Disks)
-	if err != nil {
-		return fmt.Errorf("get diskKeys: %w", err)
+// requestShareKeys 请求中所有读者需要重加密的所有 secret
+func requestShareKeys(req *model.PodStart) []shareKey {
+	readers := uint32(len(req.ReplicaKeys) + 1)
+	keys := make([]shareKey, 0, int(readers)*(len(req.Secrets)+len(req.Disks)+len(req.Shared)))
+	for r := range readers {
+		for _, index := range req.Secrets {
+			keys = append(keys, shareKey{r, ShareKindSecret, index})
+		}
+		for _, index := range req.Disks {
+			keys = append(keys, shareKey{r, ShareKindDisk, index})
+		}
+		for i := range req.Shared {
+			keys = append(keys, shareKey{r, ShareKindShared, uint64(i)})
+		}
 	}
-	diskShares := make(map[uint64]*model.DecryptShare)
-	for index, disKey := range disKeys {
-		reply, err := proxy_reenc.Reencrypt(dkgShare, disKey, *clientPubKey)
-		if err != nil {
-			return fmt.Errorf("reencrypt: %w", err)
+	return keys
+}
+
+// replicaShares 获取响应中某个读者的份额
+func replicaShares(resp *model.DecryptSharesResp, replica uint32) (map[uint64]*model.DecryptShare, map[uint64]*model.DecryptShare, []*model.DecryptShare) {
+	if replica == 0 {
+		return resp.SecretShares, resp.DiskShares, resp.SharedShares
+	}
+	if int(replica) > len(resp.Replicas) || resp.Replicas[replica-1] == nil {
+		return nil, nil, nil
+	}
+	r := resp.Replicas[replica-1]
+	return r.SecretShares, r.DiskShares, r.SharedShares
+}
+
+// lookupShare 获取响应中 key 对应的份额
+func lookupShare(resp *model.DecryptSharesResp, k shareKey) (*model.DecryptShare, bool) {
+	secretShares, diskShares, sharedShares := replicaShares(resp, k.replica)
+	switch k.kind {
+	case ShareKindSecret:
+		sh, ok := secretShares[k.index]
+		return sh, ok
+	case ShareKindDisk:
+		sh, ok := diskShares[k.index]
+		return sh, ok
+	default:
+		if k.index < uint64(len(sharedShares)) {
+			return sharedShares[k.index], true
 		}
+		return nil, false
+	}
+}
 
-		// 编码重新加密的密文份额响应
-		eshare, err := EncodeDecryptShare(reply, req.Id)
-		if err != nil {
-			return fmt.Errorf("encode decrypt share: %w", err)
+// runParallel 使用所有 CPU 并行执行 fn(0..n-1)
+func runParallel(n int, fn func(i int)) {
+	workers := min(runtime.NumCPU(), n)
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+	for i := range n {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// hasShareIndex 检查是否已收集相同节点序号的份额
+func hasShareIndex(shares []*share.PubShare, i uint32) bool {
+	for _, sh := range shares {
+		if sh.I == i {
+			return true
 		}
+	}
+	return false
+}
 
-		// 构建重新加密的密文份额响应
-		diskShares[index] = eshare
+// sharesEnough 所有 secret 都已有足够的份额，或者剩余的节点已不能使任何 secret 达到阈值
+func sharesEnough(keys []shareKey, collected map[shareKey][]*share.PubShare, threshold, remaining int) bool {
+	for _, k := range keys {
+		got := len(collected[k])
+		if got < threshold && got+remaining >= threshold {
+			return false
+		}
 	}
+	return true
+}
 
-	// 发送重新加密的密文份额响应
+// HandleDecryptSecret 处理解密请求
+func (s *SideChain) HandleReencryptReq(req *model.PodStart, reqId string, from string) error {
 	formPubKey, err := model.PubKeyFromHex(from)
 	if err != nil {
 		return fmt.Errorf("pubkey from hex: %w", err)
 	}
+
+	// 处理失败时返回错误，请求方不需要等待超时
+	resp, rerr := s.reencryptShares(req, s.blockHeight())
+	if rerr != nil {
+		resp = &model.DecryptSharesResp{
+			Req:   req,
+			Error: []byte(rerr.Error()),
+		}
+	}
+
+	// 发送重新加密的密文份额响应
 	err = s.p2p.Send(model.SendToNode(formPubKey), &model.SecretBox{
+		ReqId: reqId,
 		Payload: &model.SecretBox_SharesResp{
-			SharesResp: &model.DecryptSharesResp{
-				Req:          req,
-				SecretShares: secretShares,
-				DiskShares:   diskShares,
-			},
+			SharesResp: resp,
 		},
 	})
 	if err != nil {
 		return errors.Wrap(err, "P2P Send error")
 	}
 
-	return nil
+	return rerr
 }
 
-// RevAndVerifyDecryptSecret 验证解密后的秘密
-func (s *SideChain) VerifyReencryptResp(shares *model.DecryptSharesResp) error {
-	suite := suites.MustFind("Ed25519")
-	req := shares.Req
-
-	// 获取分布式密钥的份额和多项式承诺
-	commits, err := GetDkgCommits()
+// reencryptShares 使用本节点的份额为所有读者重加密请求的所有 secret，在所有 CPU 上并行计算
+// height 为收到请求时的区块高度，用于检查 secret 的有效期
+func (s *SideChain) reencryptShares(req *model.PodStart, height int64) (*model.DecryptSharesResp, error) {
+	// 获取重新加密所需的公钥和密文
+	readers := readerKeys(req)
+	stores, err := s.loadRequestStores(req)
 	if err != nil {
-		return fmt.Errorf("get dkg commits: %w", err)
+		return nil, err
 	}
-	poly := share.NewPubPoly(suite, nil, commits.Public)
-
-	// 解析程序的空间
-	nameSpace := types.H160(req.NameSpace)
-	// 解析客户端的公钥
-	clientPubKey := model.PubKeyFromByte(req.PubKey)
 
-	// 验证所有的重新加密回复
-	secrets, err := s.GetSecrets(nameSpace, req.Secrets)
+	// 获取本节点在 secret 所属密钥组的份额，并进行重新加密操作
+	group, err := stores.group()
 	if err != nil {
-		return fmt.Errorf("get secret: %w", err)
+		return nil, err
 	}
-	for index, share := range shares.SecretShares {
-		reply, err := DecodeDecryptShare(share, suite)
-		if err != nil {
-			return fmt.Errorf("decode decrypt share: %w", err)
+	d, err := s.localKeyGroup(group)
+	if err != nil {
+		return nil, err
+	}
+	dkgShare := d.Share()
+
+	keys := requestShareKeys(req)
+	eshares := make([]*model.DecryptShare, len(keys))
+	errs := make([]error, len(keys))
+	now := time.Now().Unix()
+	runParallel(len(keys), func(i int) {
+		k := keys[i]
+		// 不在有效期内的 secret 不重加密，留空的份额由请求方记录为无法恢复
+		if err := stores.get(k).Window.Check(height, now); err != nil {
+			eshares[i] = &model.DecryptShare{}
+			return
 		}
 
-		// 验证重新加密的回复
-		secret := secrets[index]
-		err = proxy_reenc.Verify(poly, secret, *clientPubKey, reply)
+		reply, err := proxy_reenc.Reencrypt(dkgShare, stores.get(k), *readers[k.replica])
 		if err != nil {
-			shares.Error = []byte("Verify error")
-			preRecerve[req.Id] <- shares
-			return fmt.Errorf("VerifyDecryptSecret secret proxy_reenc.Verify: %s", err)
+			errs[i] = fmt.Errorf("reencrypt: %w", err)
+			return
+		}
+
+		// 编码重新加密的密文份额响应
+		eshares[i], errs[i] = EncodeDecryptShare(reply, req.Id)
+	})
+
+	// 构建重新加密的密文份额响应
+	resp := &model.DecryptSharesResp{
+		Req:          req,
+		SecretShares: make(map[uint64]*model.DecryptShare),
+		DiskShares:   make(map[uint64]*model.DecryptShare),
+		SharedShares: make([]*model.DecryptShare, len(stores.shared)),
+		Replicas:     make([]*model.ReplicaShares, len(req.ReplicaKeys)),
+	}
+	for i := range resp.Replicas {
+		resp.Replicas[i] = &model.ReplicaShares{
+			SecretShares: make(map[uint64]*model.DecryptShare),
+			DiskShares:   make(map[uint64]*model.DecryptShare),
+			SharedShares: make([]*model.DecryptShare, len(stores.shared)),
 		}
 	}
+	for i, k := range keys {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
 
-	diskKeys, err := s.GetDiskKeys(nameSpace, req.Disks)
+		secretShares, diskShares, sharedShares := replicaShares(resp, k.replica)
+		switch k.kind {
+		case ShareKindSecret:
+			secretShares[k.index] = eshares[i]
+		case ShareKindDisk:
+			diskShares[k.index] = eshares[i]
+		case ShareKindShared:
+			sharedShares[k.index] = eshares[i]
+		}
+	}
+
+	return resp, nil
+}
+
+// RevAndVerifyDecryptSecret 验证节点返回的重加密份额
+// 份额按会话的请求验证，通过验证的份额交给会话，无效的份额记录节点和原因
+func (s *SideChain) VerifyReencryptResp(shares *model.DecryptSharesResp, reqId string, from string) error {
+	suite := suites.MustFind("Ed25519")
+
+	// 未知或已结束的会话直接丢弃
+	req, ok := s.reencrypt.request(reqId)
+	if !ok {
+		return nil
+	}
+
+	result := &verifiedShares{
+		from:   from,
+		shares: make(map[shareKey]*share.PubShare),
+	}
+	if shares.Error != nil {
+		result.err = string(shares.Error)
+		s.reencrypt.deliver(reqId, result)
+		return nil
+	}
+
+	// 解析客户端的公钥
+	readers := readerKeys(req)
+	stores, err := s.loadRequestStores(req)
 	if err != nil {
-		return fmt.Errorf("get diskKey: %w", err)
+		return err
 	}
-	for index, diskKeyShare := range shares.DiskShares {
-		reply, err := DecodeDecryptShare(diskKeyShare, suite)
-		if err != nil {
-			return fmt.Errorf("decode decrypt share: %w", err)
+
+	// 获取 secret 所属密钥组的多项式承诺
+	group, err := stores.group()
+	if err != nil {
+		return err
+	}
+	_, commits, err := keyGroupKeys(group)
+	if err != nil {
+		return fmt.Errorf("get dkg commits: %w", err)
+	}
+	poly := share.NewPubPoly(suite, nil, commits.Public)
+
+	// 并行验证所有的重新加密回复
+	keys := requestShareKeys(req)
+	replies := make([]*share.PubShare, len(keys))
+	faults := make([]*model.ShareFault, len(keys))
+	runParallel(len(keys), func(i int) {
+		k := keys[i]
+		eshare, ok := lookupShare(shares, k)
+		if !ok || eshare == nil || len(eshare.XncSki) == 0 {
+			return
 		}
 
-		// 验证重新加密的回复
-		secret := diskKeys[index]
-		err = proxy_reenc.Verify(poly, secret, *clientPubKey, reply)
+		reply, err := DecodeDecryptShare(eshare, suite)
+		if err == nil {
+			err = proxy_reenc.Verify(poly, stores.get(k), *readers[k.replica], reply)
+		}
 		if err != nil {
-			shares.Error = []byte("Verify error")
-			preRecerve[req.Id] <- shares
-			return fmt.Errorf("VerifyDecryptSecret disk proxy_reenc.Verify: %s", err)
+			faults[i] = &model.ShareFault{
+				Node:    from,
+				Kind:    k.kind,
+				Index:   k.index,
+				Replica: k.replica,
+				Reason:  err.Error(),
+			}
+			return
+		}
+		replies[i] = &reply.Share
+	})
+	for i, k := range keys {
+		if faults[i] != nil {
+			result.invalid = append(result.invalid, faults[i])
+		}
+		if replies[i] != nil {
+			result.shares[k] = replies[i]
 		}
 	}
 
-	preRecerve[req.Id] <- shares
+	s.reencrypt.deliver(reqId, result)
 	return nil
 }
@@ -0,0 +1,149 @@
+package sidechain
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+)
+
+func TestReencryptDropsInvalidShare(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	d := newTestDkg(t, 4, 3)
+	suite := suites.MustFind("Ed25519")
+
+	owner := types.H160{1}
+	store := saveTestSecret(t, s, d, owner, 1, []byte("secret"))
+
+	reader, readerPub, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	req := &model.PodStart{Id: 1, NameSpace: owner[:], PubKey: readerPub.Byte(), Secrets: []uint64{1}}
+	stores, err := s.loadRequestStores(req)
+	require.NoError(t, err)
+	sess, leader, err := s.reencrypt.start(req, d.n)
+	require.NoError(t, err)
+	require.True(t, leader)
+
+	// 节点 0 返回篡改的份额，节点 1-3 的份额有效
+	for i := range d.n {
+		reply, err := proxy_reenc.Reencrypt(d.distKeyShare(i), store, *readerPub)
+		require.NoError(t, err)
+		if i == 0 {
+			reply.Share.V = suite.Point().Pick(suite.RandomStream())
+		}
+		eshare, err := EncodeDecryptShare(reply, req.Id)
+		require.NoError(t, err)
+		resp := &model.DecryptSharesResp{Req: req, SecretShares: map[uint64]*model.DecryptShare{1: eshare}}
+		require.NoError(t, s.VerifyReencryptResp(resp, sess.id, d.nodes[i].P2pId.String()))
+	}
+
+	resps, _, err := s.reencryptWithSession(sess, req, stores, d.nodes, d.key.Byte(), d.th, 0)
+	require.NoError(t, err)
+	require.Len(t, resps, 1)
+	resp := resps[0]
+	require.Empty(t, resp.Unrecovered)
+	require.Len(t, resp.InvalidShares, 1)
+	require.Equal(t, d.nodes[0].P2pId.String(), resp.InvalidShares[0].Node)
+
+	// 使用 t 个有效份额恢复
+	data, err := OpenSecret(resp.Secrets[1], d.key.Point(), reader.Scalar())
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret"), data)
+}
+
+func TestBroadcastReencryptBatch(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	d := newTestDkg(t, 4, 3)
+	d.useTestMainChain(t, s)
+
+	owner := types.H160{1}
+	want := map[uint64]string{1: "secret 1", 2: "secret 2"}
+	for index, data := range want {
+		saveTestSecret(t, s, d, owner, index, []byte(data))
+	}
+
+	// 一轮请求为 3 个副本的读者重加密
+	req := &model.PodStart{Id: 1, NameSpace: owner[:], Secrets: []uint64{1, 2}}
+	readers := make([]*model.PrivKey, 3)
+	for i := range readers {
+		priv, pub, err := model.GenerateEd25519KeyPair(rand.Reader)
+		require.NoError(t, err)
+		readers[i] = priv
+		if i == 0 {
+			req.PubKey = pub.Byte()
+		} else {
+			req.ReplicaKeys = append(req.ReplicaKeys, pub.Byte())
+		}
+	}
+	stores, err := s.GetSecrets(owner, req.Secrets)
+	require.NoError(t, err)
+
+	type result struct {
+		resps []*model.DecryptResp
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		resps, err := s.BroadcastReencryptBatch(context.Background(), req)
+		done <- result{resps, err}
+	}()
+
+	// 等待会话开始
+	key, err := sessionKey(req)
+	require.NoError(t, err)
+	var id string
+	require.Eventually(t, func() bool {
+		s.reencrypt.mu.Lock()
+		defer s.reencrypt.mu.Unlock()
+		if sess, ok := s.reencrypt.byKey[key]; ok {
+			id = sess.id
+			return true
+		}
+		return false
+	}, time.Second, time.Millisecond)
+
+	// 节点 0 不响应，节点 1 重放响应只计一次
+	for _, i := range []int{1, 1, 2, 3} {
+		resp := d.reencryptResp(t, i, req, stores)
+		require.NoError(t, s.VerifyReencryptResp(resp, id, d.nodes[i].P2pId.String()))
+	}
+
+	res := <-done
+	require.NoError(t, res.err)
+	require.Len(t, res.resps, len(readers))
+	for r, resp := range res.resps {
+		require.Empty(t, resp.Unrecovered)
+		for index, data := range want {
+			got, err := OpenSecret(resp.Secrets[index], d.key.Point(), readers[r].Scalar())
+			require.NoError(t, err)
+			require.Equal(t, data, string(got))
+		}
+	}
+	require.Equal(t, uint64(1), s.ReencryptMetrics().Dropped)
+}
+
+func TestSharesEnough(t *testing.T) {
+	keys := []shareKey{{kind: ShareKindSecret, index: 1}, {kind: ShareKindSecret, index: 2}}
+	collected := map[shareKey][]*share.PubShare{
+		keys[0]: {{I: 0}, {I: 1}},
+		keys[1]: {{I: 0}},
+	}
+
+	// secret 2 还可以达到门限
+	require.False(t, sharesEnough(keys, collected, 2, 1))
+	// 剩余的节点不能使 secret 2 达到门限
+	require.True(t, sharesEnough(keys, collected, 3, 0))
+
+	collected[keys[1]] = append(collected[keys[1]], &share.PubShare{I: 2})
+	require.True(t, sharesEnough(keys, collected, 2, 2))
+}
@@ -0,0 +1,144 @@
+package sidechain
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+const (
+	AuditSpace = "secret_audit"
+	// ABCI 事件类型，可通过 tx_search 查询 secret_audit.name_space='<hex>'
+	AuditEventType = "secret_audit"
+)
+
+// auditKey 审计记录的 key: <namespace>_<height>_<req_id>_<reader>
+// height 补齐为定长，使同一 namespace 的记录按高度排序
+func auditKey(ns types.H160, height int64, audit *model.SecretAudit) string {
+	return auditPrefix(ns) + fmt.Sprintf("%020d", height) + "_" + audit.ReqId + "_" + hex.EncodeToString(audit.Reader)
+}
+
+func auditPrefix(ns types.H160) string {
+	return ns.Hex() + "_"
+}
+
+// auditOwners 可以查看审计记录的 namespace，包括请求方和被读取的共享 secret 的 owner
+func auditOwners(audit *model.SecretAudit) []types.H160 {
+	owners := []types.H160{types.H160(audit.NameSpace)}
+	for _, sh := range audit.Shared {
+		owner := types.H160(sh.Owner)
+		if !slices.Contains(owners, owner) {
+			owners = append(owners, owner)
+		}
+	}
+	return owners
+}
+
+// newSecretAudits 为每个读者构建重加密审计记录
+func (s *SideChain) newSecretAudits(reqId string, req *model.PodStart, resps []*model.DecryptResp, contributors []map[string]bool) *model.AuditLog {
+	epoch := s.GetEpoch()
+	readers := readerKeys(req)
+
+	log := &model.AuditLog{Records: make([]*model.SecretAudit, 0, len(resps))}
+	for i, resp := range resps {
+		validators := make([]string, 0, len(contributors[i]))
+		for v := range contributors[i] {
+			validators = append(validators, v)
+		}
+		slices.Sort(validators)
+
+		log.Records = append(log.Records, &model.SecretAudit{
+			ReqId:       reqId,
+			PodId:       req.Id,
+			Reader:      readers[i].Byte(),
+			NameSpace:   req.NameSpace,
+			Secrets:     req.Secrets,
+			Disks:       req.Disks,
+			Shared:      req.Shared,
+			Epoch:       epoch,
+			Validators:  validators,
+			Unrecovered: resp.Unrecovered,
+		})
+	}
+	return log
+}
+
+// submitAuditLog 将审计记录提交到侧链
+func submitAuditLog(log *model.AuditLog) {
+	_, err := SubmitTx(&model.Tx{
+		Payload: &model.Tx_AuditLog{
+			AuditLog: log,
+		},
+	})
+	if err != nil {
+		util.LogWithRed("submitAuditLog", err.Error())
+	}
+}
+
+// SaveAuditLog 保存审计记录，返回对应的 ABCI 事件
+func (s *SideChain) SaveAuditLog(log *model.AuditLog, height int64, txn *model.Txn) ([]abci.Event, error) {
+	events := make([]abci.Event, 0, len(log.Records))
+	for _, audit := range log.Records {
+		if len(audit.NameSpace) != 20 || audit.ReqId == "" {
+			util.LogWithYellow("SaveAuditLog", "invalid audit record, skip", audit.ReqId)
+			continue
+		}
+		audit.Height = height
+
+		for _, owner := range auditOwners(audit) {
+			err := model.TxnSetJson(txn, model.ComboNamespaceKey(AuditSpace, auditKey(owner, height, audit)), audit)
+			if err != nil {
+				return nil, err
+			}
+		}
+		events = append(events, auditEvent(audit))
+	}
+	return events, nil
+}
+
+// auditEvent 审计记录的 ABCI 事件
+func auditEvent(audit *model.SecretAudit) abci.Event {
+	owners := auditOwners(audit)
+	ownerHex := make([]string, 0, len(owners))
+	for _, o := range owners {
+		ownerHex = append(ownerHex, o.Hex())
+	}
+
+	return abci.Event{
+		Type: AuditEventType,
+		Attributes: []abci.EventAttribute{
+			{Key: "name_space", Value: types.H160(audit.NameSpace).Hex(), Index: true},
+			{Key: "owners", Value: strings.Join(ownerHex, ","), Index: true},
+			{Key: "req_id", Value: audit.ReqId, Index: true},
+			{Key: "pod_id", Value: fmt.Sprint(audit.PodId), Index: true},
+			{Key: "reader", Value: hex.EncodeToString(audit.Reader)},
+			{Key: "epoch", Value: fmt.Sprint(audit.Epoch)},
+			{Key: "validators", Value: strings.Join(audit.Validators, ",")},
+		},
+	}
+}
+
+// GetAuditLogs 分页获取 namespace 的审计记录，按高度升序
+func GetAuditLogs(ns types.H160, cursor []byte, size int) ([]*model.SecretAudit, []byte, error) {
+	list, next, err := model.GetList(AuditSpace, auditPrefix(ns), cursor, size)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	audits := make([]*model.SecretAudit, 0, len(list))
+	for _, bt := range list {
+		audit := new(model.SecretAudit)
+		if err := json.Unmarshal(bt, audit); err != nil {
+			return nil, nil, err
+		}
+		audits = append(audits, audit)
+	}
+	return audits, next, nil
+}
@@ -0,0 +1,98 @@
+package sidechain
+
+import (
+	"crypto/rand"
+	"slices"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+func TestReencryptAuditLog(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	d := newTestDkg(t, 4, 3)
+
+	owner := types.H160{1}
+	saveTestSecret(t, s, d, owner, 1, []byte("secret"))
+
+	_, readerPub, err := model.GenerateEd25519KeyPair(rand.Reader)
+	require.NoError(t, err)
+	req := &model.PodStart{Id: 9, NameSpace: owner[:], PubKey: readerPub.Byte(), Secrets: []uint64{1}}
+	stores, err := s.loadRequestStores(req)
+	require.NoError(t, err)
+	secrets, err := s.GetSecrets(owner, req.Secrets)
+	require.NoError(t, err)
+	sess, _, err := s.reencrypt.start(req, d.n)
+	require.NoError(t, err)
+
+	// 节点 1-3 响应
+	for i := 1; i < d.n; i++ {
+		require.NoError(t, s.VerifyReencryptResp(d.reencryptResp(t, i, req, secrets), sess.id, d.nodes[i].P2pId.String()))
+	}
+	_, audit, err := s.reencryptWithSession(sess, req, stores, d.nodes, d.key.Byte(), d.th, 0)
+	require.NoError(t, err)
+
+	// 每个读者一条记录，记录使用了份额的节点
+	require.Len(t, audit.Records, 1)
+	record := audit.Records[0]
+	require.Equal(t, sess.id, record.ReqId)
+	require.Equal(t, req.Id, record.PodId)
+	require.Equal(t, readerPub.Byte(), record.Reader)
+	require.Equal(t, owner[:], record.NameSpace)
+	require.Equal(t, []uint64{1}, record.Secrets)
+	validators := []string{d.nodes[1].P2pId.String(), d.nodes[2].P2pId.String(), d.nodes[3].P2pId.String()}
+	slices.Sort(validators)
+	require.Equal(t, validators, record.Validators)
+	require.Empty(t, record.Unrecovered)
+
+	// 打包后 owner 可以查询
+	txn := model.DBINS.NewTransaction()
+	events, err := s.SaveAuditLog(audit, 7, txn)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+	require.Len(t, events, 1)
+	require.Equal(t, AuditEventType, events[0].Type)
+
+	list, _, err := GetAuditLogs(owner, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, sess.id, list[0].ReqId)
+	require.Equal(t, int64(7), list[0].Height)
+}
+
+func TestAuditLogsOnlyForOwners(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+
+	a, b, c, other := types.H160{1}, types.H160{2}, types.H160{3}, types.H160{4}
+	log := &model.AuditLog{Records: []*model.SecretAudit{
+		// a 读取 b 共享的 secret
+		{ReqId: "r1", NameSpace: a[:], Reader: []byte{1}, Shared: []*model.SharedSecret{{Owner: b[:], Index: 1}}},
+		{ReqId: "r2", NameSpace: c[:], Reader: []byte{2}},
+		// 无效的记录不保存
+		{ReqId: "r3", NameSpace: []byte{1}},
+	}}
+	txn := model.DBINS.NewTransaction()
+	events, err := s.SaveAuditLog(log, 3, txn)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+	require.Len(t, events, 2)
+
+	reqIds := func(ns types.H160) []string {
+		list, _, err := GetAuditLogs(ns, nil, 10)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(list))
+		for _, audit := range list {
+			ids = append(ids, audit.ReqId)
+		}
+		return ids
+	}
+	require.Equal(t, []string{"r1"}, reqIds(a))
+	require.Equal(t, []string{"r1"}, reqIds(b))
+	require.Equal(t, []string{"r2"}, reqIds(c))
+	require.Empty(t, reqIds(other))
+}
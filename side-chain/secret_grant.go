@@ -0,0 +1,123 @@
+package sidechain
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+const (
+	GrantSpace  = "secret_grant"
+	RevokeSpace = "secret_revoke"
+)
+
+// grantKey 授权储存的 key: <secret|disk>_<owner>_<index>_<grantee>
+func grantKey(owner types.H160, index uint64, disk bool, grantee types.H160) string {
+	space := SecretSpace
+	if disk {
+		space = DiskSpace
+	}
+	return space + "_" + owner.Hex() + "_" + fmt.Sprint(index) + "_" + grantee.Hex()
+}
+
+// SaveGrant 保存 secret 授权，早于最近一次撤销或已有授权的调用会被忽略
+func (s *SideChain) SaveGrant(grant *model.GrantSecret, txn *model.Txn) error {
+	key := grantKey(grant.OwnerH160(), grant.Index, grant.Disk, types.H160(grant.Grantee))
+
+	revoked, err := model.TxnGetJson[model.RevokeSecret](txn, model.ComboNamespaceKey(RevokeSpace, key))
+	if err != nil {
+		return err
+	}
+	if revoked != nil && revoked.Time >= grant.Time {
+		util.LogWithYellow("SaveGrant", "grant is older than revoke, skip", key)
+		return nil
+	}
+
+	old, err := model.TxnGetJson[model.GrantSecret](txn, model.ComboNamespaceKey(GrantSpace, key))
+	if err != nil {
+		return err
+	}
+	if old != nil && old.Time >= grant.Time {
+		util.LogWithYellow("SaveGrant", "grant is older than saved grant, skip", key)
+		return nil
+	}
+
+	return model.TxnSetJson(txn, model.ComboNamespaceKey(GrantSpace, key), grant)
+}
+
+// RevokeGrant 撤销 secret 授权，并记录撤销时间防止旧授权被重放
+func (s *SideChain) RevokeGrant(revoke *model.RevokeSecret, txn *model.Txn) error {
+	key := grantKey(revoke.OwnerH160(), revoke.Index, revoke.Disk, types.H160(revoke.Grantee))
+
+	old, err := model.TxnGetJson[model.RevokeSecret](txn, model.ComboNamespaceKey(RevokeSpace, key))
+	if err != nil {
+		return err
+	}
+	if old != nil && old.Time >= revoke.Time {
+		util.LogWithYellow("RevokeGrant", "revoke is older than saved revoke, skip", key)
+		return nil
+	}
+
+	grant, err := model.TxnGetJson[model.GrantSecret](txn, model.ComboNamespaceKey(GrantSpace, key))
+	if err != nil {
+		return err
+	}
+	if grant != nil && grant.Time <= revoke.Time {
+		err = txn.Delete(model.ComboNamespaceKey(GrantSpace, key))
+		if err != nil {
+			return err
+		}
+	}
+
+	return model.TxnSetJson(txn, model.ComboNamespaceKey(RevokeSpace, key), revoke)
+}
+
+// GetGrant 获取 owner 授权给 grantee 的 secret，不存在返回 nil
+func GetGrant(owner types.H160, index uint64, disk bool, grantee types.H160) (*model.GrantSecret, error) {
+	return model.GetJson[model.GrantSecret](GrantSpace, grantKey(owner, index, disk, grantee))
+}
+
+// GetSharedSecrets 获取其他账户授权给 grantee 的 secret，返回顺序与 shared 一致
+// 未授权或授权已过期时返回错误
+func (s *SideChain) GetSharedSecrets(grantee types.H160, shared []*model.SharedSecret) ([]*model.SecretStore, error) {
+	now := uint64(time.Now().Unix())
+	list := make([]*model.SecretStore, 0, len(shared))
+	for _, sh := range shared {
+		if len(sh.Owner) != 20 {
+			return nil, fmt.Errorf("invalid shared secret owner")
+		}
+		owner := types.H160(sh.Owner)
+
+		// owner 访问自己的 secret 无需授权
+		if !bytes.Equal(owner[:], grantee[:]) {
+			grant, err := GetGrant(owner, sh.Index, sh.Disk, grantee)
+			if err != nil {
+				return nil, fmt.Errorf("get grant: %w", err)
+			}
+			if grant == nil {
+				return nil, fmt.Errorf("secret %s_%d is not granted to %s", owner.Hex(), sh.Index, grantee.Hex())
+			}
+			if grant.Expire != 0 && grant.Expire < now {
+				return nil, fmt.Errorf("grant of secret %s_%d to %s is expired", owner.Hex(), sh.Index, grantee.Hex())
+			}
+		}
+
+		var stores map[uint64]*model.SecretStore
+		var err error
+		if sh.Disk {
+			stores, err = s.GetDiskKeys(owner, []uint64{sh.Index})
+		} else {
+			stores, err = s.GetSecrets(owner, []uint64{sh.Index})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get shared secret: %w", err)
+		}
+		list = append(list, stores[sh.Index])
+	}
+
+	return list, nil
+}
@@ -0,0 +1,539 @@
+package sidechain
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/pkg/errors"
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+// 旧 secret 迁移：加密密钥生成之前的 secret 使用签名密钥加密（SecretStore.Group 为空）
+// 加密密钥生成后，每隔 SecretMigrateInterval 个区块由轮换的发起节点迁移一批旧 secret：
+// 1. 发起节点选择同时持有签名密钥和加密密钥份额的成员，成员数量达到两个密钥的门限
+// 2. 成员计算每个 secret 的密钥切换份额和证明（proxy_reenc.SwitchKeyShare），不解密 data key
+// 3. 发起节点提交成员的份额，区块中验证所有份额并计算新的密文，payload 不变
+// 4. 份额无效、secret 在迁移期间被修改时跳过
+// 任何节点都无法解密或替换 data key，所有旧 secret 迁移完成后，签名密钥不再用于解密 secret
+
+const (
+	// 每隔多少个区块迁移一批旧 secret
+	SecretMigrateInterval int64 = 50
+	// 每批迁移的 secret 数量
+	SecretMigrateBatch = 16
+	// 迁移一批 secret 的超时时间
+	SecretMigrateTimeout = 30 * time.Second
+)
+
+var (
+	// 同一时间只迁移一批
+	secretMigrating sync.Mutex
+
+	// 发起节点正在收集份额的迁移会话
+	migrateMu   sync.Mutex
+	migrateSess *migrateSession
+)
+
+// legacySecret 使用签名密钥加密的 secret
+type legacySecret struct {
+	disk  bool
+	owner types.H160
+	index uint64
+	store *model.SecretStore
+}
+
+// migrateSession 发起节点等待成员的密钥切换份额
+type migrateSession struct {
+	id     string
+	nodes  []*model.PubKey // 成员的 p2p key，和 members 的顺序一致
+	shares chan *migrateReply
+}
+
+type migrateReply struct {
+	from   string
+	shares *model.SecretMigrateShares
+}
+
+// StartSecretMigrate 到达迁移间隔且加密密钥已生成时，区块提交后开始迁移
+func (s *SideChain) StartSecretMigrate(height int64) {
+	if height%SecretMigrateInterval != 0 || encryptKeyPub() == nil {
+		return
+	}
+	s.pendingMigrate = height
+}
+
+// migrateSponsor 迁移的发起节点，每个迁移间隔轮换
+func migrateSponsor(validators []*model.SideValidator, height int64) *model.SideValidator {
+	return rotateSponsor(validators, uint64(height/SecretMigrateInterval))
+}
+
+// migrateSecrets 本节点是本轮的发起节点时迁移一批旧 secret
+func (s *SideChain) migrateSecrets(height int64) {
+	if s.dkg == nil || s.dkg.DkgKeyShare == nil {
+		return
+	}
+	validators, _, err := s.GetValidators()
+	if err != nil {
+		util.LogWithRed("SecretMigrate", "GetValidators error:", err.Error())
+		return
+	}
+	sponsor := migrateSponsor(validators, height)
+	if sponsor == nil || !bytes.Equal(sponsor.Pubkey, s.dkg.Signer.GetPublic().Byte()) {
+		return
+	}
+	if !secretMigrating.TryLock() {
+		return
+	}
+	defer secretMigrating.Unlock()
+
+	legacy, err := legacySecrets(height, SecretMigrateBatch)
+	if err != nil {
+		util.LogWithRed("SecretMigrate", "load legacy secrets error:", err.Error())
+		return
+	}
+	if len(legacy) == 0 {
+		return
+	}
+
+	members, nodes, err := s.migrateMembers(uint64(height / SecretMigrateInterval))
+	if err != nil {
+		util.LogWithYellow("SecretMigrate", err.Error())
+		return
+	}
+	req := &model.SecretMigrateReq{Members: members}
+	for _, l := range legacy {
+		req.Secrets = append(req.Secrets, &model.MigratedSecret{
+			Disk:      l.disk,
+			Owner:     l.owner[:],
+			Index:     l.index,
+			OldEncCmt: l.store.RawEncCmt,
+		})
+	}
+
+	m, err := s.collectMigrateShares(fmt.Sprint("migrate-", height), req, nodes)
+	if err != nil {
+		util.LogWithYellow("SecretMigrate", err.Error())
+		return
+	}
+
+	util.LogWithYellow("SecretMigrate", "migrate", len(m.Secrets), "legacy secrets to encryption key")
+	_, err = SubmitTx(&model.Tx{
+		Payload: &model.Tx_SecretMigrate{SecretMigrate: m},
+	})
+	if err != nil {
+		util.LogWithRed("SecretMigrate", "SubmitTx error:", err.Error())
+	}
+}
+
+// migrateMembers 选择同时持有签名密钥和加密密钥份额的在线节点，数量为两个密钥门限中较大的一个
+// 每一轮从不同的节点开始选择，节点离线导致迁移失败时下一轮使用其他节点
+func (s *SideChain) migrateMembers(round uint64) ([]*model.KeySwitchMember, []*model.PubKey, error) {
+	_, signCommits, err := keyGroupKeys("")
+	if err != nil {
+		return nil, nil, err
+	}
+	_, encCommits, err := keyGroupKeys(EncryptKeyGroup)
+	if err != nil {
+		return nil, nil, err
+	}
+	g, err := GetKeyGroup(EncryptKeyGroup)
+	if err != nil {
+		return nil, nil, err
+	}
+	need := max(len(signCommits.Public), len(encCommits.Public))
+
+	online := map[string]bool{s.dkg.P2PId().String(): true}
+	for _, n := range s.p2p.AvailableNodes() {
+		online[n.String()] = true
+	}
+
+	// 签名密钥的份额序号为 DKG 节点的顺序，加密密钥的份额序号为密钥组成员的顺序
+	members := make([]*model.KeySwitchMember, 0, len(s.dkg.Nodes))
+	nodes := make([]*model.PubKey, 0, len(s.dkg.Nodes))
+	for i, v := range s.dkg.Nodes {
+		j := slices.IndexFunc(g.Members, func(m []byte) bool {
+			return bytes.Equal(m, v.ValidatorId.Byte())
+		})
+		if j < 0 || !online[v.P2pId.String()] {
+			continue
+		}
+		members = append(members, &model.KeySwitchMember{From: uint32(i), To: uint32(j)})
+		nodes = append(nodes, &v.P2pId)
+	}
+	if len(members) < need {
+		return nil, nil, fmt.Errorf("key switching members %d, need %d", len(members), need)
+	}
+
+	start := int(round % uint64(len(members)))
+	members = append(members[start:], members[:start]...)[:need]
+	nodes = append(nodes[start:], nodes[:start]...)[:need]
+	return members, nodes, nil
+}
+
+// collectMigrateShares 发送请求并收集所有成员的份额，只保留所有成员的份额都有效的 secret
+func (s *SideChain) collectMigrateShares(id string, req *model.SecretMigrateReq, nodes []*model.PubKey) (*model.SecretMigrate, error) {
+	sess := &migrateSession{id: id, nodes: nodes, shares: make(chan *migrateReply, len(nodes))}
+	migrateMu.Lock()
+	migrateSess = sess
+	migrateMu.Unlock()
+	defer func() {
+		migrateMu.Lock()
+		migrateSess = nil
+		migrateMu.Unlock()
+	}()
+
+	err := s.p2p.Send(model.SendToNodes(nodes), &model.SecretBox{
+		ReqId:   id,
+		Payload: &model.SecretBox_MigrateReq{MigrateReq: req},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("send migrate request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), SecretMigrateTimeout)
+	defer cancel()
+	replies := make([]*model.SecretMigrateShares, len(nodes))
+	for got := 0; got < len(nodes); {
+		select {
+		case r := <-sess.shares:
+			j := slices.IndexFunc(nodes, func(n *model.PubKey) bool { return n.String() == r.from })
+			if j < 0 || replies[j] != nil {
+				continue
+			}
+			if len(r.shares.Error) > 0 {
+				return nil, fmt.Errorf("node %s: %s", r.from, r.shares.Error)
+			}
+			if r.shares.Member == nil || r.shares.Member.From != req.Members[j].From || r.shares.Member.To != req.Members[j].To || len(r.shares.Shares) != len(req.Secrets) {
+				return nil, fmt.Errorf("node %s: invalid shares", r.from)
+			}
+			replies[j] = r.shares
+			got++
+		case <-ctx.Done():
+			return nil, fmt.Errorf("migrate session %s timeout", id)
+		}
+	}
+
+	// 提交前使用和区块中相同的验证，避免提交无效的份额
+	fromCmt, toCmt, err := migrateCommits(model.DBINS.NewTransaction())
+	if err != nil {
+		return nil, err
+	}
+	m := &model.SecretMigrate{Members: req.Members}
+	for i, sec := range req.Secrets {
+		ms := &model.MigratedSecret{
+			Disk:      sec.Disk,
+			Owner:     sec.Owner,
+			Index:     sec.Index,
+			OldEncCmt: sec.OldEncCmt,
+		}
+		for _, r := range replies {
+			ms.Shares = append(ms.Shares, r.Shares[i])
+		}
+		if _, err := switchSecret(fromCmt, toCmt, m.Members, sec.OldEncCmt, nil, ms.Shares); err != nil {
+			util.LogWithYellow("SecretMigrate", types.H160(sec.Owner).Hex(), sec.Index, err.Error())
+			continue
+		}
+		m.Secrets = append(m.Secrets, ms)
+	}
+	if len(m.Secrets) == 0 {
+		return nil, errors.New("no secret to migrate")
+	}
+	return m, nil
+}
+
+// receiveMigrateShares 发起节点收到成员的份额
+func (s *SideChain) receiveMigrateShares(resp *model.SecretMigrateShares, reqId string, from string) error {
+	migrateMu.Lock()
+	sess := migrateSess
+	migrateMu.Unlock()
+	if sess == nil || sess.id != reqId {
+		return nil
+	}
+
+	select {
+	case sess.shares <- &migrateReply{from: from, shares: resp}:
+	default:
+	}
+	return nil
+}
+
+// HandleSecretMigrateReq 成员使用本节点的签名密钥和加密密钥份额计算密钥切换份额
+func (s *SideChain) HandleSecretMigrateReq(req *model.SecretMigrateReq, reqId string, from string) error {
+	fromPubKey, err := model.PubKeyFromHex(from)
+	if err != nil {
+		return fmt.Errorf("pubkey from hex: %w", err)
+	}
+
+	resp, rerr := s.localMigrateShares(req)
+	if rerr != nil {
+		resp = &model.SecretMigrateShares{Error: []byte(rerr.Error())}
+	}
+	err = s.p2p.Send(model.SendToNode(fromPubKey), &model.SecretBox{
+		ReqId:   reqId,
+		Payload: &model.SecretBox_MigrateShares{MigrateShares: resp},
+	})
+	if err != nil {
+		return errors.Wrap(err, "P2P Send error")
+	}
+	return rerr
+}
+
+func (s *SideChain) localMigrateShares(req *model.SecretMigrateReq) (*model.SecretMigrateShares, error) {
+	if s.dkg == nil || s.dkg.DkgKeyShare == nil {
+		return nil, errors.New("dkg is not ready")
+	}
+	enc, err := s.localKeyGroup(EncryptKeyGroup)
+	if err != nil {
+		return nil, err
+	}
+	return migrateShares(req, s.dkg.Share().PriShare(), enc.Share().PriShare())
+}
+
+// migrateShares 计算请求中每个 secret 的密钥切换份额，from 和 to 为本节点签名密钥和加密密钥的份额
+// 只处理状态中未被修改的旧 secret，其他 secret 返回空的份额
+func migrateShares(req *model.SecretMigrateReq, from, to *share.PriShare) (*model.SecretMigrateShares, error) {
+	suite := suites.MustFind("Ed25519")
+	members := switchMembers(req.Members)
+	j := slices.IndexFunc(members, func(m proxy_reenc.SwitchMember) bool {
+		return m.From == from.I && m.To == to.I
+	})
+	if j < 0 {
+		return nil, errors.New("node is not a key switching member")
+	}
+
+	txn := model.DBINS.NewTransaction()
+	defer txn.Rollback()
+
+	resp := &model.SecretMigrateShares{Member: req.Members[j]}
+	for _, sec := range req.Secrets {
+		key := secretStoreKey(sec.Disk, types.H160(sec.Owner), sec.Index)
+		store, err := model.TxnGetProtoMessage[model.SecretStore](txn, key)
+		if err != nil {
+			return nil, err
+		}
+		if len(sec.Owner) != len(types.H160{}) || store == nil || store.Group != "" || !bytes.Equal(store.RawEncCmt, sec.OldEncCmt) {
+			resp.Shares = append(resp.Shares, &model.KeySwitchShare{})
+			continue
+		}
+
+		encCmt := suite.Point()
+		if err := encCmt.UnmarshalBinary(store.RawEncCmt); err != nil {
+			return nil, fmt.Errorf("unmarshal encCmt: %w", err)
+		}
+		sh, err := proxy_reenc.SwitchKeyShare(suite, members, j, from, to, encCmt)
+		if err != nil {
+			return nil, err
+		}
+		esh, err := encodeSwitchShare(sh)
+		if err != nil {
+			return nil, err
+		}
+		resp.Shares = append(resp.Shares, esh)
+	}
+	return resp, nil
+}
+
+// SaveSecretMigrate 验证成员的密钥切换份额，使用加密密钥的密文替换旧 secret 的 data key
+// secret 已被修改、已迁移或份额无效时跳过
+func (s *SideChain) SaveSecretMigrate(m *model.SecretMigrate, txn *model.Txn) error {
+	g, err := model.TxnGetJson[model.KeyGroup](txn, keyGroupKey(EncryptKeyGroup))
+	if err != nil {
+		return err
+	}
+	if g == nil || len(g.DkgPub) == 0 {
+		util.LogWithYellow("SaveSecretMigrate", "encryption key is not ready, skip")
+		return nil
+	}
+	fromCmt, toCmt, err := migrateCommits(txn)
+	if err != nil {
+		return err
+	}
+
+	for _, ms := range m.Secrets {
+		if len(ms.Owner) != len(types.H160{}) {
+			continue
+		}
+		key := secretStoreKey(ms.Disk, types.H160(ms.Owner), ms.Index)
+		store, err := model.TxnGetProtoMessage[model.SecretStore](txn, key)
+		if err != nil {
+			return err
+		}
+		if store == nil || store.Group != "" || !bytes.Equal(store.RawEncCmt, ms.OldEncCmt) {
+			util.LogWithYellow("SaveSecretMigrate", "secret changed, skip", string(key))
+			continue
+		}
+
+		rawEncScrt, err := switchSecret(fromCmt, toCmt, m.Members, store.RawEncCmt, store.RawEncScrt, ms.Shares)
+		if err != nil {
+			util.LogWithYellow("SaveSecretMigrate", "invalid key switching, skip", string(key), err.Error())
+			continue
+		}
+
+		store.RawEncScrt = rawEncScrt
+		store.Group = EncryptKeyGroup
+		if err := model.TxnSetProtoMessage(txn, key, store); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateCommits 签名密钥和加密密钥的份额承诺
+func migrateCommits(txn *model.Txn) (*share.PubPoly, *share.PubPoly, error) {
+	suite := suites.MustFind("Ed25519")
+	signCommits, err := model.TxnGetJson[model.KyberPoints](txn, model.ComboNamespaceKey(GLOABL_STATE, "dkg_pub_commits"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("get dkg commits: %w", err)
+	}
+	g, err := model.TxnGetJson[model.KeyGroup](txn, keyGroupKey(EncryptKeyGroup))
+	if err != nil {
+		return nil, nil, err
+	}
+	if signCommits == nil || len(signCommits.Public) == 0 || g == nil || len(g.DkgCommits) == 0 {
+		return nil, nil, errors.New("dkg commits are not ready")
+	}
+	encCommits := &model.KyberPoints{}
+	if err := json.Unmarshal(g.DkgCommits, encCommits); err != nil {
+		return nil, nil, fmt.Errorf("key group %s commits: %w", EncryptKeyGroup, err)
+	}
+	return share.NewPubPoly(suite, nil, signCommits.Public), share.NewPubPoly(suite, nil, encCommits.Public), nil
+}
+
+// switchSecret 验证所有成员的份额并计算加密密钥的密文，rawEncScrt 为空时只验证份额
+func switchSecret(fromCmt, toCmt *share.PubPoly, members []*model.KeySwitchMember, rawEncCmt []byte, rawEncScrt [][]byte, eshares []*model.KeySwitchShare) ([][]byte, error) {
+	suite := suites.MustFind("Ed25519")
+	encCmt := suite.Point()
+	if err := encCmt.UnmarshalBinary(rawEncCmt); err != nil {
+		return nil, fmt.Errorf("unmarshal encCmt: %w", err)
+	}
+	encScrt := make([]kyber.Point, len(rawEncScrt))
+	for i, raw := range rawEncScrt {
+		encScrt[i] = suite.Point()
+		if err := encScrt[i].UnmarshalBinary(raw); err != nil {
+			return nil, fmt.Errorf("unmarshal encScrt: %w", err)
+		}
+	}
+	shares := make([]*proxy_reenc.SwitchShare, len(eshares))
+	for i, esh := range eshares {
+		sh, err := decodeSwitchShare(suite, esh)
+		if err != nil {
+			return nil, fmt.Errorf("share of member %d: %w", i, err)
+		}
+		shares[i] = sh
+	}
+
+	switched, err := proxy_reenc.SwitchKey(suite, fromCmt, toCmt, switchMembers(members), encCmt, encScrt, shares)
+	if err != nil {
+		return nil, err
+	}
+	raw := make([][]byte, len(switched))
+	for i, p := range switched {
+		if raw[i], err = p.MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+	return raw, nil
+}
+
+func switchMembers(members []*model.KeySwitchMember) []proxy_reenc.SwitchMember {
+	list := make([]proxy_reenc.SwitchMember, len(members))
+	for i, m := range members {
+		list[i] = proxy_reenc.SwitchMember{From: m.From, To: m.To}
+	}
+	return list
+}
+
+func encodeSwitchShare(sh *proxy_reenc.SwitchShare) (*model.KeySwitchShare, error) {
+	v, err := sh.V.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	c, err := sh.Challenge.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	p, err := sh.Proof.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &model.KeySwitchShare{V: v, Challenge: c, Proof: p}, nil
+}
+
+func decodeSwitchShare(suite suites.Suite, esh *model.KeySwitchShare) (*proxy_reenc.SwitchShare, error) {
+	if esh == nil || len(esh.V) == 0 {
+		return nil, errors.New("missing share")
+	}
+	sh := &proxy_reenc.SwitchShare{V: suite.Point(), Challenge: suite.Scalar(), Proof: suite.Scalar()}
+	if err := sh.V.UnmarshalBinary(esh.V); err != nil {
+		return nil, err
+	}
+	if err := sh.Challenge.UnmarshalBinary(esh.Challenge); err != nil {
+		return nil, err
+	}
+	if err := sh.Proof.UnmarshalBinary(esh.Proof); err != nil {
+		return nil, err
+	}
+	return sh, nil
+}
+
+func secretStoreKey(disk bool, owner types.H160, index uint64) []byte {
+	space := SecretSpace
+	if disk {
+		space = DiskSpace
+	}
+	return model.ComboNamespaceKey(space, owner.Hex()+"_"+fmt.Sprint(index))
+}
+
+// legacySecrets 最多 limit 个还未迁移的 secret，不在有效期内的 secret 无法重加密，之后再迁移
+func legacySecrets(height int64, limit int) ([]*legacySecret, error) {
+	now := time.Now().Unix()
+	legacy := make([]*legacySecret, 0, limit)
+	for _, space := range []string{SecretSpace, DiskSpace} {
+		list, keys, err := model.GetProtoMessageList[model.SecretStore](space, "0x")
+		if err != nil {
+			return nil, err
+		}
+		for i, store := range list {
+			if len(legacy) >= limit {
+				return legacy, nil
+			}
+			if store.Group != "" || len(store.RawEncCmt) == 0 {
+				continue
+			}
+			if store.Window.Check(height, now) != nil {
+				continue
+			}
+
+			// <space>_0x<owner>_<index>
+			parts := strings.Split(string(keys[i]), "_")
+			if len(parts) != 3 {
+				continue
+			}
+			owner, err := hex.DecodeString(strings.TrimPrefix(parts[1], "0x"))
+			if err != nil || len(owner) != len(types.H160{}) {
+				continue
+			}
+			index, err := strconv.ParseUint(parts[2], 10, 64)
+			if err != nil {
+				continue
+			}
+			legacy = append(legacy, &legacySecret{disk: space == DiskSpace, owner: types.H160(owner), index: index, store: store})
+		}
+	}
+	return legacy, nil
+}
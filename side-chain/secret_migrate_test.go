@@ -0,0 +1,174 @@
+package sidechain
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+// migrateReq 迁移 owner 的 secret 的请求
+func migrateReq(members []*model.KeySwitchMember, owner types.H160, stores map[uint64]*model.SecretStore) *model.SecretMigrateReq {
+	req := &model.SecretMigrateReq{Members: members}
+	for index, store := range stores {
+		req.Secrets = append(req.Secrets, &model.MigratedSecret{
+			Owner:     owner[:],
+			Index:     index,
+			OldEncCmt: store.RawEncCmt,
+		})
+	}
+	return req
+}
+
+// collectShares 所有成员使用 from 和 to 密钥的份额计算密钥切换份额
+func collectShares(t *testing.T, req *model.SecretMigrateReq, from, to *testDkg) *model.SecretMigrate {
+	m := &model.SecretMigrate{Members: req.Members}
+	for _, sec := range req.Secrets {
+		m.Secrets = append(m.Secrets, &model.MigratedSecret{
+			Owner:     sec.Owner,
+			Index:     sec.Index,
+			OldEncCmt: sec.OldEncCmt,
+		})
+	}
+	for _, member := range req.Members {
+		resp, err := migrateShares(req, from.pri.Eval(member.From), to.pri.Eval(member.To))
+		require.NoError(t, err)
+		require.Len(t, resp.Shares, len(req.Secrets))
+		for i, sh := range resp.Shares {
+			m.Secrets[i].Shares = append(m.Secrets[i].Shares, sh)
+		}
+	}
+	return m
+}
+
+func saveMigrate(t *testing.T, s *SideChain, m *model.SecretMigrate) {
+	txn := model.DBINS.NewTransaction()
+	require.NoError(t, s.SaveSecretMigrate(m, txn))
+	require.NoError(t, txn.Commit())
+}
+
+// openStore 使用 d 的私钥直接解密 secret
+func openStore(t *testing.T, d *testDkg, store *model.SecretStore) ([]byte, error) {
+	suite := suites.MustFind("Ed25519")
+	encCmt := suite.Point()
+	require.NoError(t, encCmt.UnmarshalBinary(store.RawEncCmt))
+	xncCmt, err := suite.Point().Mul(d.pri.Secret(), encCmt).MarshalBinary()
+	require.NoError(t, err)
+
+	secret := &model.Secret{XncCmt: xncCmt, EncScrt: store.RawEncScrt, Payload: store.Payload}
+	return OpenSecret(secret, d.pub.Commit(), suite.Scalar().Zero())
+}
+
+func TestSecretMigrate(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	sign := newTestDkg(t, 4, 3)
+	enc := newTestKey(t, 4, 3)
+	enc.saveEncryptKey(t)
+
+	owner := types.H160{1}
+	data := []byte("legacy secret")
+	old := saveTestSecret(t, s, sign, owner, 1, data)
+	require.Empty(t, old.Group)
+
+	legacy, err := legacySecrets(1, SecretMigrateBatch)
+	require.NoError(t, err)
+	require.Len(t, legacy, 1)
+
+	// 成员在两个密钥中的序号不同
+	members := []*model.KeySwitchMember{{From: 0, To: 1}, {From: 1, To: 2}, {From: 3, To: 0}}
+	req := migrateReq(members, owner, map[uint64]*model.SecretStore{1: old})
+
+	// 不是成员的节点无法计算份额
+	_, err = migrateShares(req, sign.pri.Eval(2), enc.pri.Eval(3))
+	require.Error(t, err)
+
+	saveMigrate(t, s, collectShares(t, req, sign, enc))
+
+	stores, err := s.GetSecrets(owner, []uint64{1})
+	require.NoError(t, err)
+	store := stores[1]
+	require.Equal(t, EncryptKeyGroup, store.Group)
+	require.Equal(t, old.RawEncCmt, store.RawEncCmt)
+	require.Equal(t, old.Payload, store.Payload)
+
+	got, err := openStore(t, enc, store)
+	require.NoError(t, err)
+	require.Equal(t, data, got)
+	_, err = openStore(t, sign, store)
+	require.Error(t, err)
+
+	legacy, err = legacySecrets(1, SecretMigrateBatch)
+	require.NoError(t, err)
+	require.Empty(t, legacy)
+
+	// 已迁移的 secret 不再计算份额，重复提交不改变 secret
+	resp, err := migrateShares(req, sign.pri.Eval(0), enc.pri.Eval(1))
+	require.NoError(t, err)
+	require.Empty(t, resp.Shares[0].V)
+	saveMigrate(t, s, collectShares(t, req, sign, enc))
+	stores, err = s.GetSecrets(owner, []uint64{1})
+	require.NoError(t, err)
+	require.Equal(t, store.RawEncScrt, stores[1].RawEncScrt)
+}
+
+func TestSecretMigrateRejectsTampered(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	sign := newTestDkg(t, 4, 3)
+	enc := newTestKey(t, 4, 3)
+	enc.saveEncryptKey(t)
+
+	owner := types.H160{1}
+	data := []byte("legacy secret")
+	old := saveTestSecret(t, s, sign, owner, 1, data)
+	members := []*model.KeySwitchMember{{From: 0, To: 1}, {From: 1, To: 2}, {From: 3, To: 0}}
+	req := migrateReq(members, owner, map[uint64]*model.SecretStore{1: old})
+
+	unchanged := func() {
+		t.Helper()
+		stores, err := s.GetSecrets(owner, []uint64{1})
+		require.NoError(t, err)
+		require.Empty(t, stores[1].Group)
+		require.Equal(t, old.RawEncScrt, stores[1].RawEncScrt)
+		got, err := openStore(t, sign, stores[1])
+		require.NoError(t, err)
+		require.Equal(t, data, got)
+	}
+
+	// 篡改一个成员的份额
+	suite := suites.MustFind("Ed25519")
+	m := collectShares(t, req, sign, enc)
+	v, err := suite.Point().Pick(suite.RandomStream()).MarshalBinary()
+	require.NoError(t, err)
+	m.Secrets[0].Shares[1].V = v
+	saveMigrate(t, s, m)
+	unchanged()
+
+	// 缺少成员的份额
+	m = collectShares(t, req, sign, enc)
+	m.Secrets[0].Shares = m.Secrets[0].Shares[:2]
+	saveMigrate(t, s, m)
+	unchanged()
+
+	// 成员数量低于门限
+	m = collectShares(t, migrateReq(members[:2], owner, map[uint64]*model.SecretStore{1: old}), sign, enc)
+	saveMigrate(t, s, m)
+	unchanged()
+
+	// 切换到其他密钥的份额
+	other := newTestKey(t, 4, 3)
+	saveMigrate(t, s, collectShares(t, req, sign, other))
+	unchanged()
+
+	// secret 在迁移期间被修改
+	m = collectShares(t, req, sign, enc)
+	saveTestSecret(t, s, sign, owner, 1, []byte("updated"))
+	saveMigrate(t, s, m)
+	stores, err := s.GetSecrets(owner, []uint64{1})
+	require.NoError(t, err)
+	require.Empty(t, stores[1].Group)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/wetee-dao/tee-dsecret/pkg/model"
 	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
+	"go.dedis.ch/kyber/v4"
 	"go.dedis.ch/kyber/v4/share"
 	"go.dedis.ch/kyber/v4/suites"
 )
@@ -66,3 +67,32 @@ func DecodeDecryptShare(req *model.DecryptShare, ste suites.Suite) (*proxy_reenc
 
 	return reply, nil
 }
+
+// OpenSecret 读者使用私钥 sk 解密重加密的 secret，dkgPub 为响应中的 DKG 公钥
+func OpenSecret(secret *model.Secret, dkgPub kyber.Point, sk kyber.Scalar) ([]byte, error) {
+	suite := suites.MustFind("Ed25519")
+	key, err := openDataKey(suite, secret, dkgPub, sk)
+	if err != nil {
+		return nil, err
+	}
+	if len(secret.Payload) == 0 {
+		return key, nil
+	}
+	return proxy_reenc.OpenPayload(key, secret.Payload)
+}
+
+// openDataKey 使用临时密钥解密重加密的 data key，没有 payload 的 secret 为 secret 本身
+func openDataKey(suite suites.Suite, secret *model.Secret, dkgPub kyber.Point, sk kyber.Scalar) ([]byte, error) {
+	xncCmt := suite.Point()
+	if err := xncCmt.UnmarshalBinary(secret.XncCmt); err != nil {
+		return nil, fmt.Errorf("unmarshal xnc cmt: %w", err)
+	}
+	encScrt := make([]kyber.Point, len(secret.EncScrt))
+	for i, raw := range secret.EncScrt {
+		encScrt[i] = suite.Point()
+		if err := encScrt[i].UnmarshalBinary(raw); err != nil {
+			return nil, fmt.Errorf("unmarshal enc scrt: %w", err)
+		}
+	}
+	return proxy_reenc.DecryptSecret(suite, encScrt, dkgPub, xncCmt, sk)
+}
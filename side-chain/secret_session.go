@@ -0,0 +1,221 @@
+package sidechain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"golang.org/x/crypto/blake2b"
+)
+
+// 重加密会话的超时时间
+var reencryptSessionTimeout = 30 * time.Second
+
+// ReencryptMetrics 重加密会话统计
+type ReencryptMetrics struct {
+	Started   uint64 `json:"started"`
+	Deduped   uint64 `json:"deduped"`
+	Succeeded uint64 `json:"succeeded"`
+	Failed    uint64 `json:"failed"`
+	TimedOut  uint64 `json:"timed_out"`
+	Canceled  uint64 `json:"canceled"`
+	// 未知或已结束会话的响应，以及同一节点重复的响应
+	Dropped uint64 `json:"dropped"`
+	Active  int64  `json:"active"`
+}
+
+// reencryptSession 一次重加密请求
+type reencryptSession struct {
+	id     string
+	key    string
+	ctx    context.Context
+	cancel context.CancelFunc
+	req    *model.PodStart
+	shares chan *verifiedShares
+	// 已收到响应的节点，每个节点只接收一次响应
+	responded map[string]bool
+	// 等待结果的调用数量，全部离开后取消会话
+	waiters int
+
+	// 会话结束后关闭 done，resp/err 为结果，供重复请求读取
+	done chan struct{}
+	resp []*model.DecryptResp
+	err  error
+}
+
+// reencryptSessions 管理进行中的重加密会话
+type reencryptSessions struct {
+	mu      sync.Mutex
+	byId    map[string]*reencryptSession
+	byKey   map[string]*reencryptSession
+	metrics ReencryptMetrics
+}
+
+func newReencryptSessions() *reencryptSessions {
+	return &reencryptSessions{
+		byId:  make(map[string]*reencryptSession),
+		byKey: make(map[string]*reencryptSession),
+	}
+}
+
+// sessionKey 相同的请求使用相同的 key
+func sessionKey(req *model.PodStart) (string, error) {
+	bt, err := req.Marshal()
+	if err != nil {
+		return "", err
+	}
+	h := blake2b.Sum256(bt)
+	return string(h[:]), nil
+}
+
+// start 创建会话，已有相同请求进行中时返回该会话且 leader 为 false
+// 会话不使用调用方的 ctx，某个调用方断开时其他等待相同请求的调用不受影响
+func (m *reencryptSessions) start(req *model.PodStart, size int) (*reencryptSession, bool, error) {
+	key, err := sessionKey(req)
+	if err != nil {
+		return nil, false, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if sess, ok := m.byKey[key]; ok {
+		m.metrics.Deduped++
+		sess.waiters++
+		return sess, false, nil
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, false, err
+	}
+
+	sctx, cancel := context.WithTimeout(context.Background(), reencryptSessionTimeout)
+	sess := &reencryptSession{
+		id:        hex.EncodeToString(id),
+		key:       key,
+		ctx:       sctx,
+		cancel:    cancel,
+		req:       req,
+		shares:    make(chan *verifiedShares, size),
+		responded: make(map[string]bool, size),
+		done:      make(chan struct{}),
+		waiters:   1,
+	}
+	m.byId[sess.id] = sess
+	m.byKey[key] = sess
+	m.metrics.Started++
+	m.metrics.Active++
+
+	return sess, true, nil
+}
+
+// request 获取进行中会话的请求
+func (m *reencryptSessions) request(id string) (*model.PodStart, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sess, ok := m.byId[id]
+	if !ok {
+		m.metrics.Dropped++
+		return nil, false
+	}
+	return sess.req, true
+}
+
+// deliver 将节点的响应交给会话，未知会话、节点已响应过或缓冲已满时丢弃
+// 重放的响应不能占用其他节点的缓冲，也不能多次计入门限
+func (m *reencryptSessions) deliver(id string, shares *verifiedShares) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sess, ok := m.byId[id]
+	if !ok || sess.responded[shares.from] {
+		m.metrics.Dropped++
+		return false
+	}
+
+	select {
+	case sess.shares <- shares:
+		sess.responded[shares.from] = true
+		return true
+	default:
+		m.metrics.Dropped++
+		return false
+	}
+}
+
+// finish 结束会话并通知等待相同请求的调用
+func (m *reencryptSessions) finish(sess *reencryptSession, resp []*model.DecryptResp, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.byId[sess.id]; !ok {
+		return
+	}
+	delete(m.byId, sess.id)
+	delete(m.byKey, sess.key)
+	m.metrics.Active--
+
+	switch {
+	case err == nil:
+		m.metrics.Succeeded++
+	case errors.Is(err, context.DeadlineExceeded):
+		m.metrics.TimedOut++
+	case errors.Is(err, context.Canceled):
+		m.metrics.Canceled++
+	default:
+		m.metrics.Failed++
+	}
+
+	sess.resp = resp
+	sess.err = err
+	sess.cancel()
+	close(sess.done)
+}
+
+// leave 调用方不再等待会话结果，所有调用方都离开后取消会话
+func (m *reencryptSessions) leave(sess *reencryptSession) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.byId[sess.id]; !ok {
+		return
+	}
+	sess.waiters--
+	if sess.waiters <= 0 {
+		sess.cancel()
+	}
+}
+
+// cancelSession 取消进行中的会话
+func (m *reencryptSessions) cancelSession(id string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sess, ok := m.byId[id]
+	if ok {
+		sess.cancel()
+	}
+	return ok
+}
+
+func (m *reencryptSessions) snapshot() ReencryptMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.metrics
+}
+
+// CancelReencrypt 取消重加密会话
+func (s *SideChain) CancelReencrypt(id string) bool {
+	return s.reencrypt.cancelSession(id)
+}
+
+// ReencryptMetrics 获取重加密会话统计
+func (s *SideChain) ReencryptMetrics() ReencryptMetrics {
+	return s.reencrypt.snapshot()
+}
@@ -0,0 +1,162 @@
+package sidechain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+func TestReencryptSessionDedup(t *testing.T) {
+	m := newReencryptSessions()
+	req := &model.PodStart{Id: 1}
+
+	sess, leader, err := m.start(req, 3)
+	require.NoError(t, err)
+	require.True(t, leader)
+
+	// 相同请求复用会话
+	same, leader, err := m.start(&model.PodStart{Id: 1}, 3)
+	require.NoError(t, err)
+	require.False(t, leader)
+	require.Equal(t, sess.id, same.id)
+
+	// 不同请求创建新会话
+	other, leader, err := m.start(&model.PodStart{Id: 2}, 3)
+	require.NoError(t, err)
+	require.True(t, leader)
+	require.NotEqual(t, sess.id, other.id)
+
+	got, ok := m.request(sess.id)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), got.Id)
+
+	metrics := m.snapshot()
+	require.Equal(t, uint64(2), metrics.Started)
+	require.Equal(t, uint64(1), metrics.Deduped)
+	require.Equal(t, int64(2), metrics.Active)
+}
+
+func TestReencryptSessionDeliver(t *testing.T) {
+	m := newReencryptSessions()
+	sess, _, err := m.start(&model.PodStart{Id: 1}, 1)
+	require.NoError(t, err)
+
+	// 未知会话的响应被丢弃
+	require.False(t, m.deliver("unknown", &verifiedShares{from: "a"}))
+	_, ok := m.request("unknown")
+	require.False(t, ok)
+
+	require.True(t, m.deliver(sess.id, &verifiedShares{from: "a"}))
+	// 缓冲已满
+	require.False(t, m.deliver(sess.id, &verifiedShares{from: "b"}))
+	require.Equal(t, "a", (<-sess.shares).from)
+
+	require.Equal(t, uint64(3), m.snapshot().Dropped)
+}
+
+func TestReencryptSessionDeliverOncePerNode(t *testing.T) {
+	m := newReencryptSessions()
+	sess, _, err := m.start(&model.PodStart{Id: 1}, 2)
+	require.NoError(t, err)
+
+	require.True(t, m.deliver(sess.id, &verifiedShares{from: "a"}))
+	// 重放的响应不占用其他节点的缓冲
+	require.False(t, m.deliver(sess.id, &verifiedShares{from: "a"}))
+	require.True(t, m.deliver(sess.id, &verifiedShares{from: "b"}))
+
+	require.Equal(t, "a", (<-sess.shares).from)
+	require.Equal(t, "b", (<-sess.shares).from)
+	require.Equal(t, uint64(1), m.snapshot().Dropped)
+}
+
+func TestReencryptSessionFinish(t *testing.T) {
+	m := newReencryptSessions()
+	sess, _, err := m.start(&model.PodStart{Id: 1}, 1)
+	require.NoError(t, err)
+	follower, _, err := m.start(&model.PodStart{Id: 1}, 1)
+	require.NoError(t, err)
+
+	resp := []*model.DecryptResp{{DkgKey: []byte("key")}}
+	m.finish(sess, resp, nil)
+
+	// 等待相同请求的调用读取同一个结果
+	select {
+	case <-follower.done:
+	default:
+		t.Fatal("session is not done")
+	}
+	require.Equal(t, resp, follower.resp)
+	require.NoError(t, follower.err)
+	require.Error(t, sess.ctx.Err())
+
+	// 结束后的会话不再接收响应，重复 finish 无效
+	require.False(t, m.deliver(sess.id, &verifiedShares{from: "a"}))
+	require.False(t, m.cancelSession(sess.id))
+	m.finish(sess, nil, errors.New("again"))
+
+	// 结束后相同请求创建新会话
+	next, leader, err := m.start(&model.PodStart{Id: 1}, 1)
+	require.NoError(t, err)
+	require.True(t, leader)
+	require.NotEqual(t, sess.id, next.id)
+
+	metrics := m.snapshot()
+	require.Equal(t, uint64(1), metrics.Succeeded)
+	require.Equal(t, uint64(0), metrics.Failed)
+	require.Equal(t, int64(1), metrics.Active)
+}
+
+func TestReencryptSessionCancel(t *testing.T) {
+	m := newReencryptSessions()
+	sess, _, err := m.start(&model.PodStart{Id: 1}, 1)
+	require.NoError(t, err)
+
+	require.False(t, m.cancelSession("unknown"))
+	require.True(t, m.cancelSession(sess.id))
+	<-sess.ctx.Done()
+	m.finish(sess, nil, sess.ctx.Err())
+
+	failed, _, err := m.start(&model.PodStart{Id: 2}, 1)
+	require.NoError(t, err)
+	m.finish(failed, nil, errors.New("not enough shares"))
+
+	metrics := m.snapshot()
+	require.Equal(t, uint64(1), metrics.Canceled)
+	require.Equal(t, uint64(1), metrics.Failed)
+	require.Equal(t, int64(0), metrics.Active)
+}
+
+func TestReencryptSessionLeave(t *testing.T) {
+	m := newReencryptSessions()
+	sess, _, err := m.start(&model.PodStart{Id: 1}, 1)
+	require.NoError(t, err)
+	_, _, err = m.start(&model.PodStart{Id: 1}, 1)
+	require.NoError(t, err)
+
+	// 一个调用方离开，会话继续
+	m.leave(sess)
+	require.NoError(t, sess.ctx.Err())
+
+	// 所有调用方离开后取消会话
+	m.leave(sess)
+	require.ErrorIs(t, sess.ctx.Err(), context.Canceled)
+}
+
+func TestReencryptSessionTimeout(t *testing.T) {
+	timeout := reencryptSessionTimeout
+	reencryptSessionTimeout = 10 * time.Millisecond
+	defer func() { reencryptSessionTimeout = timeout }()
+
+	m := newReencryptSessions()
+	sess, _, err := m.start(&model.PodStart{Id: 1}, 1)
+	require.NoError(t, err)
+	<-sess.ctx.Done()
+	m.finish(sess, nil, sess.ctx.Err())
+
+	require.Equal(t, uint64(1), m.snapshot().TimedOut)
+}
@@ -2,13 +2,16 @@ package sidechain
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/cockroachdb/pebble"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/model/protoio"
 	proxy_reenc "github.com/wetee-dao/tee-dsecret/pkg/proxy-reenc"
 	"go.dedis.ch/kyber/v4/suites"
 )
@@ -18,17 +21,46 @@ const (
 	DiskSpace   = "disk"
 )
 
-func (s *SideChain) Encrypt(data []byte) ([]byte, error) {
-	// 获取DKG的公钥，用于加密过程
-	suite := suites.MustFind("Ed25519")
-	dkgPubKey, err := GetDkgPubkey()
+// Encrypt 使用 DKG 加密密钥加密 secret，window 为可选的有效期
+// group 不为空时使用密钥组的公钥，只有密钥组的成员可以重加密，加密密钥生成之前返回错误
+func (s *SideChain) Encrypt(data []byte, window *model.SecretWindow, group string) ([]byte, error) {
+	if err := window.Validate(); err != nil {
+		return nil, err
+	}
+	if group == "" {
+		group = EncryptKeyGroup
+	}
+
+	dkgPubKey, _, err := keyGroupKeys(group)
 	if err != nil {
 		return nil, fmt.Errorf("get dkg pubkey: %w", err)
 	}
-	dkgPub := model.PubKeyFromByte(dkgPubKey.ToBytes())
 
-	// 加密秘密环境变量
-	encCmt, encScrt := proxy_reenc.EncryptSecret(suite, dkgPub.Point(), data)
+	secretStore, err := SealSecret(model.PubKeyFromByte(dkgPubKey), data, window, group)
+	if err != nil {
+		return nil, err
+	}
+
+	// save to db
+	err = s.storePayload(secretStore)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	abci.WriteMessage(secretStore, buf)
+
+	return buf.Bytes(), nil
+}
+
+// SealSecret 使用密钥组 group 的 DKG 公钥信封加密 data，空 group 为签名密钥
+func SealSecret(dkgPub *model.PubKey, data []byte, window *model.SecretWindow, group string) (*model.SecretStore, error) {
+	// 信封加密：数据使用随机 data key 加密，只有 data key 使用 DKG 公钥加密
+	suite := suites.MustFind("Ed25519")
+	encCmt, encScrt, payload, err := proxy_reenc.EncryptEnvelope(suite, dkgPub.Point(), data)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt envelope: %w", err)
+	}
 
 	// 将加密的承诺（encCmt）转换为字节切片格式
 	rawEncCmt, err := encCmt.MarshalBinary()
@@ -46,20 +78,55 @@ func (s *SideChain) Encrypt(data []byte) ([]byte, error) {
 		rawEncScrt[i] = rawEncScrti
 	}
 
-	// save to db
-	secretStore := &model.SecretStore{
+	return &model.SecretStore{
 		RawEncCmt:  rawEncCmt,
 		RawEncScrt: rawEncScrt,
+		Payload:    payload,
+		Window:     window,
+		Group:      group,
+	}, nil
+}
+
+func (s *SideChain) SaveSecret(user types.H160, index uint64, data []byte, txn *model.Txn) error {
+	key := model.ComboNamespaceKey(SecretSpace, user.Hex()+"_"+fmt.Sprint(index))
+	if err := txn.Set(key, data); err != nil {
+		return err
 	}
+	return indexSecretExpiry(key, data, txn)
+}
 
-	buf := new(bytes.Buffer)
-	abci.WriteMessage(secretStore, buf)
+// SaveGeneratedSecret 保存节点生成的密钥，序号已被使用时返回错误，保存的 secret 记录密钥类型
+func (s *SideChain) SaveGeneratedSecret(user types.H160, index uint64, keyType string, data []byte, txn *model.Txn) error {
+	key := model.ComboNamespaceKey(SecretSpace, user.Hex()+"_"+fmt.Sprint(index))
+	if _, err := txn.Get(key); err == nil {
+		return fmt.Errorf("secret index %d already exists", index)
+	} else if !errors.Is(err, pebble.ErrNotFound) {
+		return err
+	}
 
-	return buf.Bytes(), nil
+	store := new(model.SecretStore)
+	if err := protoio.ReadMessage(bytes.NewBuffer(data), store); err != nil {
+		return fmt.Errorf("read secret: %w", err)
+	}
+	store.KeyType = keyType
+
+	buf := new(bytes.Buffer)
+	if err := abci.WriteMessage(store, buf); err != nil {
+		return err
+	}
+	return s.SaveSecret(user, index, buf.Bytes(), txn)
 }
 
-func (s *SideChain) SaveSecret(user types.H160, index uint64, data []byte, txn *model.Txn) error {
-	return txn.Set(model.ComboNamespaceKey(SecretSpace, user.Hex()+"_"+fmt.Sprint(index)), data)
+// SecretExists 用户的 secret 序号是否已被使用
+func SecretExists(user types.H160, index uint64) (bool, error) {
+	_, err := model.GetKey(SecretSpace, user.Hex()+"_"+fmt.Sprint(index))
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
 }
 
 func (s *SideChain) GetSecrets(user types.H160, indexs []uint64) (map[uint64]*model.SecretStore, error) {
@@ -89,7 +156,11 @@ func (s *SideChain) GetSecrets(user types.H160, indexs []uint64) (map[uint64]*mo
 }
 
 func (s *SideChain) SaveDiskKey(user types.H160, index uint64, data []byte, txn *model.Txn) error {
-	return txn.Set(model.ComboNamespaceKey(DiskSpace, user.Hex()+"_"+fmt.Sprint(index)), data)
+	key := model.ComboNamespaceKey(DiskSpace, user.Hex()+"_"+fmt.Sprint(index))
+	if err := txn.Set(key, data); err != nil {
+		return err
+	}
+	return indexSecretExpiry(key, data, txn)
 }
 
 func (s *SideChain) GetDiskKeys(user types.H160, indexs []uint64) (map[uint64]*model.SecretStore, error) {
@@ -0,0 +1,60 @@
+package sidechain
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/model/protoio"
+)
+
+func TestGeneratedSecretIndexUnique(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	d := newTestDkg(t, 4, 3)
+
+	owner := types.H160{1}
+	upload := func(data string, keyType string) *model.TeeCall {
+		store, err := SealSecret(d.key, []byte(data), nil, "")
+		require.NoError(t, err)
+		buf := new(bytes.Buffer)
+		require.NoError(t, abci.WriteMessage(store, buf))
+		return &model.TeeCall{Tx: &model.TeeCall_UploadSecret{UploadSecret: &model.UploadSecret{
+			User: owner[:], Index: 1, Data: buf.Bytes(), KeyType: keyType,
+		}}}
+	}
+	first := upload("first", model.KeyTypeEd25519)
+
+	// 同一区块中两次生成同一个序号，只保存第一次
+	txn := model.DBINS.NewTransaction()
+	hub := &model.HubCall{Call: []*model.TeeCall{first, upload("second", model.KeyTypeSecp256k1)}}
+	require.NoError(t, s.finalizeHubCall(hub, 1, txn))
+	require.NoError(t, txn.Commit())
+
+	stores, err := s.GetSecrets(owner, []uint64{1})
+	require.NoError(t, err)
+	require.Equal(t, model.KeyTypeEd25519, stores[1].KeyType)
+	firstStore := new(model.SecretStore)
+	require.NoError(t, protoio.ReadMessage(bytes.NewBuffer(first.GetUploadSecret().Data), firstStore))
+	require.Equal(t, firstStore.RawEncCmt, stores[1].RawEncCmt)
+
+	// 之后的区块中也不能覆盖
+	txn = model.DBINS.NewTransaction()
+	require.NoError(t, s.finalizeHubCall(&model.HubCall{Call: []*model.TeeCall{upload("third", model.KeyTypeX509)}}, 2, txn))
+	require.NoError(t, txn.Commit())
+	stores, err = s.GetSecrets(owner, []uint64{1})
+	require.NoError(t, err)
+	require.Equal(t, model.KeyTypeEd25519, stores[1].KeyType)
+
+	// 用户上传的 secret 可以覆盖，不记录密钥类型
+	txn = model.DBINS.NewTransaction()
+	require.NoError(t, s.finalizeHubCall(&model.HubCall{Call: []*model.TeeCall{upload("uploaded", "")}}, 3, txn))
+	require.NoError(t, txn.Commit())
+	stores, err = s.GetSecrets(owner, []uint64{1})
+	require.NoError(t, err)
+	require.Empty(t, stores[1].KeyType)
+}
@@ -0,0 +1,195 @@
+package sidechain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/model/protoio"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	"github.com/wetee-dao/tee-dsecret/side-chain/pallets/dao"
+)
+
+// 过期 secret 的清理配置由治理设置，保存在侧链状态中，所有验证节点使用相同的配置
+// 清理按过期索引进行：保存 secret 时写入 <SecretExpirySpace>_<h|t>_<not_after>_<secret key>，
+// 清理时只遍历已超过清理延迟的索引，payload 在区块提交后删除
+
+const (
+	SecretExpirySpace = "expiry"
+
+	secretSweepKey = "secret_sweep"
+	// 旧版本保存的 secret 没有过期索引，第一次清理时补建
+	secretExpiryIndexedKey = "secret_expiry_indexed"
+
+	// not_after 补零到固定长度，索引按过期时间排序
+	expiryWidth = 20
+)
+
+// SetSecretSweepConfig 由 DAO gov/sudo 账户设置过期 secret 的清理配置
+func (s *SideChain) SetSecretSweepConfig(caller []byte, cfg *model.SecretSweepConfig, txn *model.Txn) error {
+	if !dao.IsSudo(caller, txn) {
+		return errors.New("secret sweep: must call by gov/sudo")
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	util.LogWithYellow("SecretSweep", "set interval", cfg.Interval, "delay", cfg.DelayBlocks, "blocks", cfg.DelaySeconds, "seconds")
+	return model.TxnSetJson(txn, model.ComboNamespaceKey(GLOABL_STATE, secretSweepKey), cfg)
+}
+
+// GetSecretSweepConfig 获取治理设置的清理配置，未设置时使用默认配置
+// 读取已提交的状态，区块中修改的配置从下一个区块生效
+func GetSecretSweepConfig() model.SecretSweepConfig {
+	cfg, err := model.GetJson[model.SecretSweepConfig](GLOABL_STATE, secretSweepKey)
+	if err != nil || cfg == nil || cfg.Validate() != nil {
+		return model.DefaultSecretSweepConfig()
+	}
+	return *cfg
+}
+
+// VerifySecretSweepTx 提交前检查治理交易，避免无效交易进入区块
+func VerifySecretSweepTx(tx *model.Tx) error {
+	if err := model.VerifyTxSigner(tx); err != nil {
+		return err
+	}
+	cfg := tx.GetSecretSweep()
+	if cfg == nil {
+		return errors.New("secret sweep: invalid tx type")
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	txn := model.DBINS.NewTransaction()
+	defer txn.Rollback()
+	if !dao.IsSudo(tx.GetCaller(), txn) {
+		return errors.New("secret sweep: must call by gov/sudo")
+	}
+	return nil
+}
+
+// expiryPrefix 按高度或按时间过期的索引前缀
+func expiryPrefix(byHeight bool) string {
+	if byHeight {
+		return "h_"
+	}
+	return "t_"
+}
+
+// indexSecretExpiry 为设置了过期时间的 secret 写入过期索引
+// 覆盖 secret 留下的旧索引在清理时跳过并删除
+func indexSecretExpiry(key []byte, data []byte, txn *model.Txn) error {
+	store := new(model.SecretStore)
+	if err := protoio.ReadMessage(bytes.NewBuffer(data), store); err != nil {
+		return nil
+	}
+	return putSecretExpiry(key, store, txn)
+}
+
+func putSecretExpiry(key []byte, store *model.SecretStore, txn *model.Txn) error {
+	w := store.Window
+	if w == nil || w.NotAfter == 0 {
+		return nil
+	}
+	ikey := fmt.Sprintf("%s%0*d_%s", expiryPrefix(w.ByHeight), expiryWidth, w.NotAfter, key)
+	return txn.Set(model.ComboNamespaceKey(SecretExpirySpace, ikey), []byte{1})
+}
+
+// backfillSecretExpiry 为没有过期索引的旧 secret 补建索引，只执行一次
+func backfillSecretExpiry(txn *model.Txn) error {
+	done, err := txn.Get(model.ComboNamespaceKey(GLOABL_STATE, secretExpiryIndexedKey))
+	if err == nil && len(done) > 0 {
+		return nil
+	}
+
+	for _, space := range []string{SecretSpace, DiskSpace} {
+		// 只匹配 <space>_0x<user>_<index>，不包括授权等其他储存
+		list, keys, err := model.GetProtoMessageList[model.SecretStore](space, "0x")
+		if err != nil {
+			return err
+		}
+		for i, store := range list {
+			if err := putSecretExpiry(keys[i], store, txn); err != nil {
+				return err
+			}
+		}
+	}
+	return txn.Set(model.ComboNamespaceKey(GLOABL_STATE, secretExpiryIndexedKey), []byte{1})
+}
+
+// SweepExpiredSecrets 删除过期已超过清理延迟的 secret 和 disk key，返回需要在区块提交后删除 payload 的 secret
+// height 和 now 使用区块的高度和时间，保证所有节点清理结果一致
+func (s *SideChain) SweepExpiredSecrets(height int64, now int64, cfg model.SecretSweepConfig, txn *model.Txn) ([]*model.SecretStore, error) {
+	if err := backfillSecretExpiry(txn); err != nil {
+		return nil, err
+	}
+
+	swept := []*model.SecretStore{}
+	for _, byHeight := range []bool{true, false} {
+		current, delay := uint64(max(now, 0)), cfg.DelaySeconds
+		if byHeight {
+			current, delay = uint64(max(height, 0)), cfg.DelayBlocks
+		}
+		if current < delay {
+			continue
+		}
+
+		// 遍历 not_after <= current - delay 的索引
+		prefix := SecretExpirySpace + "_" + expiryPrefix(byHeight)
+		upper := fmt.Sprintf("%s%0*d", prefix, expiryWidth, current-delay+1)
+		ikeys, err := txn.KeysInRange([]byte(prefix), []byte(upper))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ikey := range ikeys {
+			key := ikey[len(prefix)+expiryWidth+1:]
+			store, err := txnSecretStore(key, txn)
+			if err != nil {
+				return nil, err
+			}
+			if err := txn.Delete(ikey); err != nil {
+				return nil, err
+			}
+			// secret 已删除或被覆盖为其他有效期
+			if store == nil || !store.Window.Expired(height, now, cfg.DelayBlocks, cfg.DelaySeconds) {
+				continue
+			}
+
+			if err := txn.Delete(key); err != nil {
+				return nil, err
+			}
+			swept = append(swept, store)
+			util.LogWithGray("SweepExpiredSecrets", string(key))
+		}
+	}
+	return swept, nil
+}
+
+// txnSecretStore 读取交易中的 secret，不存在时返回 nil
+func txnSecretStore(key []byte, txn *model.Txn) (*model.SecretStore, error) {
+	v, err := txn.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	store := new(model.SecretStore)
+	if err := protoio.ReadMessage(bytes.NewBuffer(v), store); err != nil {
+		return nil, nil
+	}
+	return store, nil
+}
+
+// deletePayloads 区块提交后删除已清理 secret 的 payload
+func (s *SideChain) deletePayloads(stores []*model.SecretStore) {
+	for _, store := range stores {
+		s.deletePayload(store)
+	}
+}
@@ -0,0 +1,132 @@
+package sidechain
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+// saveWindowSecret 保存有效期为 window 的 secret，payload 保存在本地 blob 储存
+func saveWindowSecret(t *testing.T, s *SideChain, d *testDkg, owner types.H160, index uint64, window *model.SecretWindow) *model.SecretStore {
+	data := bytes.Repeat([]byte(fmt.Sprint(index)), BlobInlineSize+1)
+	store, err := SealSecret(d.key, data, window, "")
+	require.NoError(t, err)
+	require.NoError(t, s.storePayload(store))
+	buf := new(bytes.Buffer)
+	require.NoError(t, abci.WriteMessage(store, buf))
+
+	txn := model.DBINS.NewTransaction()
+	require.NoError(t, s.SaveSecret(owner, index, buf.Bytes(), txn))
+	require.NoError(t, txn.Commit())
+	return store
+}
+
+func hasPayload(t *testing.T, s *SideChain, store *model.SecretStore) bool {
+	c, err := cid.Cast(store.PayloadCid)
+	require.NoError(t, err)
+	ok, err := s.blobs.Has(c)
+	require.NoError(t, err)
+	return ok
+}
+
+func sweep(t *testing.T, s *SideChain, height, now int64, cfg model.SecretSweepConfig) []*model.SecretStore {
+	txn := model.DBINS.NewTransaction()
+	swept, err := s.SweepExpiredSecrets(height, now, cfg, txn)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+	return swept
+}
+
+func TestSweepExpiredSecrets(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	d := newTestDkg(t, 4, 3)
+	cfg := model.SecretSweepConfig{Interval: 1, DelayBlocks: 5, DelaySeconds: 60}
+
+	owner := types.H160{1}
+	byHeight := saveWindowSecret(t, s, d, owner, 1, &model.SecretWindow{NotAfter: 10, ByHeight: true})
+	byTime := saveWindowSecret(t, s, d, owner, 2, &model.SecretWindow{NotAfter: 1000})
+	later := saveWindowSecret(t, s, d, owner, 3, &model.SecretWindow{NotAfter: 100, ByHeight: true})
+	saveWindowSecret(t, s, d, owner, 4, nil)
+
+	// 过期但未超过清理延迟
+	require.Empty(t, sweep(t, s, 14, 1059, cfg))
+
+	swept := sweep(t, s, 15, 1060, cfg)
+	require.Len(t, swept, 2)
+	stores, err := s.GetSecrets(owner, []uint64{1, 2, 3, 4})
+	require.NoError(t, err)
+	require.Nil(t, stores[1].Window)
+	require.Nil(t, stores[2].Window)
+	require.Equal(t, later.Window, stores[3].Window)
+	require.NotEmpty(t, stores[4].RawEncCmt)
+
+	// 清理的索引已删除，不会再次清理
+	require.Empty(t, sweep(t, s, 16, 1061, cfg))
+
+	// payload 在区块提交后才删除
+	require.True(t, hasPayload(t, s, byHeight))
+	s.deletePayloads(swept)
+	require.False(t, hasPayload(t, s, byHeight))
+	require.False(t, hasPayload(t, s, byTime))
+	require.True(t, hasPayload(t, s, later))
+}
+
+func TestSweepOverwrittenSecret(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	d := newTestDkg(t, 4, 3)
+	cfg := model.SecretSweepConfig{Interval: 1, DelayBlocks: 5, DelaySeconds: 60}
+
+	// 覆盖为更晚过期的 secret，旧的过期索引不会删除新的 secret
+	owner := types.H160{1}
+	saveWindowSecret(t, s, d, owner, 1, &model.SecretWindow{NotAfter: 10, ByHeight: true})
+	saveWindowSecret(t, s, d, owner, 1, &model.SecretWindow{NotAfter: 100, ByHeight: true})
+
+	require.Empty(t, sweep(t, s, 20, 0, cfg))
+	require.Len(t, sweep(t, s, 105, 0, cfg), 1)
+}
+
+func TestSweepBackfillsLegacySecrets(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+	d := newTestDkg(t, 4, 3)
+	cfg := model.SecretSweepConfig{Interval: 1, DelayBlocks: 5, DelaySeconds: 60}
+
+	// 旧版本直接保存的 secret 没有过期索引
+	owner := types.H160{1}
+	store, err := SealSecret(d.key, []byte("legacy"), &model.SecretWindow{NotAfter: 10, ByHeight: true}, "")
+	require.NoError(t, err)
+	buf := new(bytes.Buffer)
+	require.NoError(t, abci.WriteMessage(store, buf))
+	txn := model.DBINS.NewTransaction()
+	require.NoError(t, txn.Set(model.ComboNamespaceKey(DiskSpace, owner.Hex()+"_1"), buf.Bytes()))
+	require.NoError(t, txn.Commit())
+
+	require.Empty(t, sweep(t, s, 14, 0, cfg))
+	require.Len(t, sweep(t, s, 15, 0, cfg), 1)
+	keys, err := s.GetDiskKeys(owner, []uint64{1})
+	require.NoError(t, err)
+	require.Nil(t, keys[1].Window)
+}
+
+func TestSecretSweepConfig(t *testing.T) {
+	openTestDB(t)
+	s := newTestSideChain(t)
+
+	require.Equal(t, model.DefaultSecretSweepConfig(), GetSecretSweepConfig())
+	require.Error(t, (&model.SecretSweepConfig{}).Validate())
+
+	// 只有 gov/sudo 账户可以修改
+	txn := model.DBINS.NewTransaction()
+	defer txn.Rollback()
+	cfg := &model.SecretSweepConfig{Interval: 10, DelayBlocks: 1, DelaySeconds: 1}
+	require.Error(t, s.SetSecretSweepConfig([]byte("not sudo"), cfg, txn))
+}
@@ -0,0 +1,72 @@
+package sidechain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+// 份额灾难恢复，由本节点的验证人账户或 DAO gov/sudo 账户操作
+// 托管文件由运维人员导出保存，恢复时新的 enclave 先生成恢复请求，收集运维人员批准后恢复份额
+
+// ShareEscrow 导出本节点最新的份额托管
+func (s *SideChain) ShareEscrow(caller []byte) (*model.ShareEscrow, error) {
+	if err := s.checkDkgOperator(caller); err != nil {
+		return nil, err
+	}
+	escrow, err := s.dkg.ShareEscrow()
+	if err != nil {
+		return nil, err
+	}
+	if escrow == nil {
+		return nil, errors.New("share recovery is not enabled or no share escrow yet")
+	}
+	return escrow, nil
+}
+
+// ShareRecoveryRequest 为托管文件生成恢复请求，只能恢复当前 epoch 的份额
+func (s *SideChain) ShareRecoveryRequest(caller []byte, escrow *model.ShareEscrow) (*model.RecoveryRequest, error) {
+	if err := s.checkDkgOperator(caller); err != nil {
+		return nil, err
+	}
+	if err := s.checkEscrowEpoch(escrow); err != nil {
+		return nil, err
+	}
+	return s.dkg.NewRecoveryRequest(escrow)
+}
+
+// RecoverShare 使用运维人员的批准恢复份额，并使用链上的份额承诺验证
+func (s *SideChain) RecoverShare(caller []byte, escrow *model.ShareEscrow, approvals []*model.RecoveryApproval) error {
+	if err := s.checkDkgOperator(caller); err != nil {
+		return err
+	}
+	if err := s.checkEscrowEpoch(escrow); err != nil {
+		return err
+	}
+
+	commits, err := GetDkgCommits()
+	if err != nil {
+		return err
+	}
+	return s.dkg.RecoverShare(escrow, approvals, commits)
+}
+
+// checkEscrowEpoch 份额刷新或 epoch 切换后旧的托管文件失效
+func (s *SideChain) checkEscrowEpoch(escrow *model.ShareEscrow) error {
+	epoch := s.GetEpoch()
+	latest, _, err := GetShareRefresh()
+	if err != nil {
+		return err
+	}
+
+	refresh := uint64(0)
+	if latest != nil && latest.Epoch == epoch {
+		refresh = latest.Refresh
+	}
+	if escrow.Epoch != epoch || escrow.Refresh != refresh {
+		return fmt.Errorf("share escrow of epoch %d refresh %d is stale, chain is at epoch %d refresh %d",
+			escrow.Epoch, escrow.Refresh, epoch, refresh)
+	}
+	return nil
+}
@@ -0,0 +1,357 @@
+package sidechain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cockroachdb/pebble"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/model/protoio"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	"github.com/wetee-dao/tee-dsecret/side-chain/pallets/dao"
+)
+
+// 主动份额刷新：epoch 很长时，相同的验证节点定期重新随机化份额，DKG 公钥不变
+// 1. 每隔 ShareRefreshInterval 个区块或由治理交易开始一次刷新，保存在状态中
+// 2. 区块提交后由确定的发起节点开始 DKG 刷新，上一次未完成时由下一个节点重新发起
+// 3. 发起者收到 quorum 个节点完成后提交 ShareRefresh 交易，交易带有节点对 (epoch, refresh, 承诺哈希) 的签名
+// 4. 链上验证交易由发起节点提交，且至少 threshold 个验证节点签名确认，t 个节点合谋本来就可以恢复密钥
+// 5. 交易提交后更新链上的份额承诺，所有节点切换到新份额，并重新收集进行中的门限份额
+
+const (
+	ShareRefreshSpace = "share_refresh"
+	// ABCI 事件类型
+	ShareRefreshEventType = "share_refresh"
+	// 每隔多少个区块刷新一次份额，0 表示只由治理发起
+	// 影响共识，所有节点必须一致，不能通过环境变量配置
+	ShareRefreshInterval int64 = 0
+)
+
+var (
+	shareRefreshPendingKey = model.ComboNamespaceKey(ShareRefreshSpace, "pending")
+	shareRefreshLatestKey  = model.ComboNamespaceKey(ShareRefreshSpace, "latest")
+)
+
+// StartShareRefresh 到达间隔高度时开始份额刷新
+func (s *SideChain) StartShareRefresh(height int64, txn *model.Txn) error {
+	interval := ShareRefreshInterval
+	if interval <= 0 || height%interval != 0 {
+		return nil
+	}
+	return s.startShareRefresh(height, txn)
+}
+
+// GovStartShareRefresh 由 DAO gov/sudo 账户发起份额刷新
+func (s *SideChain) GovStartShareRefresh(caller []byte, height int64, txn *model.Txn) error {
+	if !dao.IsSudo(caller, txn) {
+		return errors.New("share refresh: must call by gov/sudo")
+	}
+	return s.startShareRefresh(height, txn)
+}
+
+func (s *SideChain) startShareRefresh(height int64, txn *model.Txn) error {
+	// DKG 完成之前没有可以刷新的份额
+	if _, err := txn.GetKey(GLOABL_STATE, "dkg_pub_commits", nil); err != nil {
+		return nil
+	}
+
+	epoch := s.GetEpoch()
+	pending, err := txnGetShareRefresh(txn, shareRefreshPendingKey)
+	if err != nil {
+		return err
+	}
+	if pending != nil && pending.Epoch == epoch {
+		// 上一次刷新尚未完成，由下一个节点重新发起
+		pending.Attempt++
+		pending.Height = height
+	} else {
+		latest, err := txnGetShareRefresh(txn, shareRefreshLatestKey)
+		if err != nil {
+			return err
+		}
+		pending = &model.ShareRefresh{Epoch: epoch, Refresh: 1, Height: height}
+		if latest != nil && latest.Epoch == epoch {
+			pending.Refresh = latest.Refresh + 1
+		}
+	}
+
+	util.LogWithYellow("ShareRefresh", "start epoch", pending.Epoch, "refresh", pending.Refresh, "attempt", pending.Attempt)
+	s.pendingRefresh = pending
+	return model.TxnSetProtoMessage(txn, shareRefreshPendingKey, pending)
+}
+
+// refreshSponsor 刷新的发起节点，由链上的验证节点、刷新序号和重新发起的次数决定
+func refreshSponsor(validators []*model.SideValidator, r *model.ShareRefresh) *model.SideValidator {
+	return rotateSponsor(validators, r.Refresh+uint64(r.Attempt))
+}
+
+// rotateSponsor 按轮次在有投票权的验证节点中轮换发起节点
+func rotateSponsor(validators []*model.SideValidator, round uint64) *model.SideValidator {
+	active := make([]*model.SideValidator, 0, len(validators))
+	for _, v := range validators {
+		if v.Power > 0 {
+			active = append(active, v)
+		}
+	}
+	if len(active) == 0 {
+		return nil
+	}
+	return active[round%uint64(len(active))]
+}
+
+// sponsorShareRefresh 本节点是发起节点时开始 DKG 刷新
+func (s *SideChain) sponsorShareRefresh(r *model.ShareRefresh) {
+	if s.dkg == nil || s.dkg.DkgKeyShare == nil || s.dkg.Epoch != r.Epoch {
+		return
+	}
+	validators, _, err := s.GetValidators()
+	if err != nil {
+		util.LogWithRed("ShareRefresh", "GetValidators error:", err.Error())
+		return
+	}
+	sponsor := refreshSponsor(validators, r)
+	if sponsor == nil || !bytes.Equal(sponsor.Pubkey, s.dkg.Signer.GetPublic().Byte()) {
+		return
+	}
+
+	if err := s.dkg.TryShareRefresh(r.Refresh); err != nil {
+		util.LogWithRed("ShareRefresh", "TryShareRefresh error:", err.Error())
+	}
+}
+
+// shareRefreshDone DKG 刷新完成后由发起者提交刷新交易
+func (s *SideChain) shareRefreshDone(r *model.ShareRefresh) {
+	_, err := SubmitTx(&model.Tx{
+		Payload: &model.Tx_ShareRefresh{ShareRefresh: r},
+	})
+	if err != nil {
+		util.LogWithRed("ShareRefresh", "SubmitTx error:", err.Error())
+	}
+}
+
+// SaveShareRefresh 验证刷新后的份额承诺并保存，刷新必须保持 DKG 公钥和门限不变
+// 交易必须由发起节点提交，并带有至少 threshold 个验证节点对承诺的签名确认
+// 无效的交易只记录日志，不影响区块中的其他交易
+func (s *SideChain) SaveShareRefresh(r *model.ShareRefresh, caller []byte, height int64, txn *model.Txn) ([]abci.Event, error) {
+	pending, err := txnGetShareRefresh(txn, shareRefreshPendingKey)
+	if err != nil {
+		return nil, err
+	}
+	if pending == nil || pending.Epoch != r.Epoch || pending.Refresh != r.Refresh || r.Epoch != s.GetEpoch() {
+		util.LogWithYellow("SaveShareRefresh", "share refresh is not pending, skip", r.Epoch, r.Refresh)
+		return nil, nil
+	}
+
+	validators, _, err := s.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+	sponsor := refreshSponsor(validators, pending)
+	if sponsor == nil || !bytes.Equal(sponsor.P2PId, caller) {
+		util.LogWithYellow("SaveShareRefresh", "share refresh is not submitted by sponsor, skip", r.Epoch, r.Refresh)
+		return nil, nil
+	}
+
+	threshold, err := verifyRefreshCommits(r.Commits, txn)
+	if err != nil {
+		util.LogWithYellow("SaveShareRefresh", err.Error())
+		return nil, nil
+	}
+	acks := model.CountRoundAcks(r.Acks, r.AckBytes(), func(v []byte) bool {
+		return isSideValidator(validators, v)
+	})
+	if acks < threshold {
+		util.LogWithYellow("SaveShareRefresh", "not enough acks, skip", r.Epoch, r.Refresh, acks, "<", threshold)
+		return nil, nil
+	}
+
+	done := &model.ShareRefresh{
+		Epoch:     pending.Epoch,
+		Refresh:   pending.Refresh,
+		Commits:   r.Commits,
+		Height:    pending.Height,
+		Finalized: height,
+		Attempt:   pending.Attempt,
+		Acks:      r.Acks,
+	}
+	if err := txn.SetKey(GLOABL_STATE, "dkg_pub_commits", r.Commits); err != nil {
+		return nil, err
+	}
+	if err := model.TxnSetProtoMessage(txn, shareRefreshLatestKey, done); err != nil {
+		return nil, err
+	}
+	if err := txn.Delete(shareRefreshPendingKey); err != nil {
+		return nil, err
+	}
+
+	s.doneRefresh = done
+	return []abci.Event{shareRefreshEvent(done)}, nil
+}
+
+// verifyRefreshCommits 刷新后的承诺必须和当前的 DKG 公钥、门限一致，返回门限
+func verifyRefreshCommits(commits []byte, txn *model.Txn) (int, error) {
+	points := &model.KyberPoints{}
+	if err := json.Unmarshal(commits, points); err != nil {
+		return 0, fmt.Errorf("share refresh commits: %w", err)
+	}
+
+	bt, err := txn.GetKey(GLOABL_STATE, "dkg_pub_commits", nil)
+	if err != nil {
+		return 0, fmt.Errorf("get dkg_pub_commits: %w", err)
+	}
+	current := &model.KyberPoints{}
+	if err := json.Unmarshal(bt, current); err != nil {
+		return 0, fmt.Errorf("get dkg_pub_commits: %w", err)
+	}
+	if len(points.Public) == 0 || len(points.Public) != len(current.Public) {
+		return 0, errors.New("share refresh changed threshold")
+	}
+	if !points.Public[0].Equal(current.Public[0]) {
+		return 0, errors.New("share refresh changed dkg pub key")
+	}
+	return len(current.Public), nil
+}
+
+// ApplyShareRefresh 区块中的交易执行完成后切换到新份额
+// 用旧份额收集的信标、条件解密和加密交易份额无法和新份额一起恢复，清空后重新提交
+func (s *SideChain) ApplyShareRefresh(txn *model.Txn) error {
+	done := s.doneRefresh
+	if done == nil {
+		return nil
+	}
+
+	round, err := txnGetBeaconRound(txn)
+	if err != nil {
+		return err
+	}
+	if round != nil && len(round.Shares) > 0 {
+		round.Shares = nil
+		if err := model.TxnSetProtoMessage(txn, beaconPendingKey, round); err != nil {
+			return err
+		}
+		s.pendingBeacon = round.Round
+	}
+
+	_, keys, err := model.GetProtoMessageList[model.Disclosure](DisclosureSpace, "0x")
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		d, err := txnGetDisclosure(txn, key)
+		if err != nil {
+			return err
+		}
+		if d == nil || d.Status != model.DisclosureReady || len(d.Shares) == 0 {
+			continue
+		}
+		d.Shares = nil
+		if err := model.TxnSetProtoMessage(txn, key, d); err != nil {
+			return err
+		}
+		s.readyDisclosures = append(s.readyDisclosures, key)
+	}
+
+	_, keys, err = model.GetProtoMessageList[model.EncryptedTx](EncryptedTxSpace, "")
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		etx, err := txnGetEncryptedTx(txn, key)
+		if err != nil {
+			return err
+		}
+		if etx == nil || etx.Decrypted || len(etx.Shares) == 0 {
+			continue
+		}
+		etx.Shares = nil
+		if err := model.TxnSetProtoMessage(txn, key, etx); err != nil {
+			return err
+		}
+		s.pendingEncryptedTxs = append(s.pendingEncryptedTxs, key)
+	}
+
+	if s.dkg != nil {
+		if err := s.dkg.ApplyRefresh(done.Epoch, done.Refresh, done.Commits); err != nil {
+			util.LogWithRed("ApplyShareRefresh", err.Error())
+		}
+	}
+	util.LogWithGreen("ShareRefresh", "finalized epoch", done.Epoch, "refresh", done.Refresh)
+	return nil
+}
+
+func shareRefreshEvent(r *model.ShareRefresh) abci.Event {
+	return abci.Event{
+		Type: ShareRefreshEventType,
+		Attributes: []abci.EventAttribute{
+			{Key: "epoch", Value: fmt.Sprint(r.Epoch), Index: true},
+			{Key: "refresh", Value: fmt.Sprint(r.Refresh)},
+		},
+	}
+}
+
+// GetShareRefresh 最近完成的和进行中的份额刷新
+func GetShareRefresh() (latest *model.ShareRefresh, pending *model.ShareRefresh, err error) {
+	latest, err = getShareRefresh("latest")
+	if err != nil {
+		return nil, nil, err
+	}
+	pending, err = getShareRefresh("pending")
+	if err != nil {
+		return nil, nil, err
+	}
+	return latest, pending, nil
+}
+
+// VerifyShareRefreshStartTx 提交前检查治理交易，避免无效交易进入区块
+func VerifyShareRefreshStartTx(tx *model.Tx) error {
+	if err := model.VerifyTxSigner(tx); err != nil {
+		return err
+	}
+	if _, ok := tx.Payload.(*model.Tx_ShareRefreshStart); !ok {
+		return errors.New("share refresh: invalid tx type")
+	}
+
+	txn := model.DBINS.NewTransaction()
+	defer txn.Rollback()
+	if !dao.IsSudo(tx.GetCaller(), txn) {
+		return errors.New("share refresh: must call by gov/sudo")
+	}
+	return nil
+}
+
+func getShareRefresh(key string) (*model.ShareRefresh, error) {
+	v, err := model.GetKey(ShareRefreshSpace, key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeShareRefresh(v)
+}
+
+func txnGetShareRefresh(txn *model.Txn, key []byte) (*model.ShareRefresh, error) {
+	v, err := txn.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeShareRefresh(v)
+}
+
+func decodeShareRefresh(v []byte) (*model.ShareRefresh, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+	r := new(model.ShareRefresh)
+	if err := protoio.ReadMessage(bytes.NewBuffer(v), r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
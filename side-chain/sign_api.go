@@ -0,0 +1,432 @@
+package sidechain
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.dedis.ch/kyber/v4"
+	"go.dedis.ch/kyber/v4/share"
+	"go.dedis.ch/kyber/v4/suites"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/dkg"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+const (
+	// 签名请求的最大消息长度
+	MaxSignMessageSize = 64 * 1024
+	// key id 的最大长度
+	MaxSignKeyIdSize = 128
+	// 签名请求时间允许的最大偏差（秒）
+	signCallTimeSkew = 300
+)
+
+// 门限签名会话的超时时间，也是未使用 nonce 的保存时间
+var signSessionTimeout = 30 * time.Second
+
+// signNonce 本节点为签名请求生成的 nonce，只能使用一次
+type signNonce struct {
+	nonce   *dkg.SignNonce
+	call    *model.TeeCall
+	created time.Time
+}
+
+// signSessions 发起的签名会话和本节点未使用的 nonce
+type signSessions struct {
+	mu       sync.Mutex
+	sessions map[string]chan *model.SignBox
+	nonces   map[string]*signNonce
+}
+
+func newSignSessions() *signSessions {
+	return &signSessions{
+		sessions: make(map[string]chan *model.SignBox),
+		nonces:   make(map[string]*signNonce),
+	}
+}
+
+// Recive msg from p2p
+func (s *SideChain) revSign(m any) error {
+	box := m.(*model.SignBox)
+	switch msg := box.Payload.(type) {
+	case *model.SignBox_Req:
+		return s.handleSignReq(msg.Req, box.ReqId, box.From)
+	case *model.SignBox_Round:
+		return s.handleSignRound(msg.Round, box.ReqId, box.From)
+	case *model.SignBox_Commit, *model.SignBox_Partial:
+		s.signs.mu.Lock()
+		ch, ok := s.signs.sessions[box.ReqId]
+		s.signs.mu.Unlock()
+		if !ok {
+			return nil
+		}
+		select {
+		case ch <- box:
+		default:
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown sign message type")
+	}
+}
+
+// ThresholdSign 由验证节点为通过 TEE 验证的应用生成门限签名，返回派生的公钥和 ed25519 签名
+func (s *SideChain) ThresholdSign(ctx context.Context, call *model.TeeCall) ([]byte, []byte, error) {
+	if s.dkg == nil || s.dkg.DkgKeyShare == nil {
+		return nil, nil, errors.New("dkg is not ready")
+	}
+	req, identity, err := verifySignCall(call)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	suite := suites.MustFind("Ed25519")
+	nodes := s.dkg.Nodes
+	threshold := s.dkg.Threshold
+	tweak, signPub, err := dkg.DeriveSignKey(suite, s.dkg.DkgPubKey.Point(), identity, req.KeyId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 注册签名会话
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, nil, err
+	}
+	reqId := hex.EncodeToString(id)
+	ch := make(chan *model.SignBox, 2*len(nodes))
+	s.signs.mu.Lock()
+	s.signs.sessions[reqId] = ch
+	s.signs.mu.Unlock()
+	defer func() {
+		s.signs.mu.Lock()
+		delete(s.signs.sessions, reqId)
+		s.signs.mu.Unlock()
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, signSessionTimeout)
+	defer cancel()
+
+	// 第一轮：收集 threshold 个节点的 nonce 承诺
+	p2pIds := make([]*model.PubKey, 0, len(nodes))
+	for _, n := range nodes {
+		p2pIds = append(p2pIds, &n.P2pId)
+	}
+	err = s.p2p.Send(model.SendToNodes(p2pIds), &model.SignBox{
+		ReqId:   reqId,
+		Payload: &model.SignBox_Req{Req: call},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("send sign req: %w", err)
+	}
+
+	commits := make([]*dkg.SignCommitment, 0, threshold)
+	rawCommits := make([]*model.SignCommit, 0, threshold)
+	signers := make([]*model.PubKey, 0, threshold)
+	responded := make(map[string]bool, len(nodes))
+	for len(commits) < threshold {
+		if len(responded) == len(nodes) {
+			return nil, nil, fmt.Errorf("sign %s: got %d commitments, need %d", reqId, len(commits), threshold)
+		}
+
+		var box *model.SignBox
+		select {
+		case box = <-ch:
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("sign %s: wait commitments: %w", reqId, ctx.Err())
+		}
+		commit := box.GetCommit()
+		if commit == nil || responded[box.From] {
+			continue
+		}
+		responded[box.From] = true
+
+		if len(commit.Error) > 0 {
+			util.LogWithYellow("ThresholdSign", "node", box.From, "error:", string(commit.Error))
+			continue
+		}
+		// 承诺的份额序号必须属于发送节点
+		if int(commit.Index) >= len(nodes) || nodes[commit.Index].P2pId.String() != box.From {
+			util.LogWithYellow("ThresholdSign", "node", box.From, "invalid share index", commit.Index)
+			continue
+		}
+		c, err := decodeSignCommit(suite, commit)
+		if err != nil {
+			util.LogWithYellow("ThresholdSign", "node", box.From, "invalid commitment:", err.Error())
+			continue
+		}
+		commits = append(commits, c)
+		rawCommits = append(rawCommits, commit)
+		signers = append(signers, &nodes[commit.Index].P2pId)
+	}
+
+	// 第二轮：签名集合中的节点生成部分签名
+	err = s.p2p.Send(model.SendToNodes(signers), &model.SignBox{
+		ReqId:   reqId,
+		Payload: &model.SignBox_Round{Round: &model.SignRound{Commits: rawCommits}},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("send sign round: %w", err)
+	}
+
+	pubPoly := share.NewPubPoly(suite, nil, s.dkg.DkgKeyShare.Commitments())
+	partials := make(map[uint32]kyber.Scalar, threshold)
+	for len(partials) < threshold {
+		var box *model.SignBox
+		select {
+		case box = <-ch:
+		case <-ctx.Done():
+			return nil, nil, fmt.Errorf("sign %s: wait partial signatures: %w", reqId, ctx.Err())
+		}
+		partial := box.GetPartial()
+		if partial == nil {
+			continue
+		}
+		if int(partial.Index) >= len(nodes) || nodes[partial.Index].P2pId.String() != box.From {
+			continue
+		}
+		if _, ok := partials[partial.Index]; ok {
+			continue
+		}
+
+		// 签名集合已确定，任何节点失败都需要重新发起请求
+		if len(partial.Error) > 0 {
+			return nil, nil, fmt.Errorf("sign %s: node %s: %s", reqId, box.From, partial.Error)
+		}
+		z := suite.Scalar()
+		if err := z.UnmarshalBinary(partial.Z); err != nil {
+			return nil, nil, fmt.Errorf("sign %s: node %s: %w", reqId, box.From, err)
+		}
+		err := dkg.VerifyPartialSign(suite, pubPoly, tweak, signPub, req.Message, commits, partial.Index, z)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sign %s: node %s: %w", reqId, box.From, err)
+		}
+		partials[partial.Index] = z
+	}
+
+	zs := make([]kyber.Scalar, 0, len(commits))
+	for _, c := range commits {
+		zs = append(zs, partials[c.Index])
+	}
+	sig, err := dkg.AggregateSign(suite, signPub, req.Message, commits, zs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pub, err := signPub.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+	return pub, sig, nil
+}
+
+// AppSignKey 获取应用签名密钥的公钥
+func (s *SideChain) AppSignKey(call *model.TeeCall) ([]byte, error) {
+	if s.dkg == nil || s.dkg.DkgPubKey == nil {
+		return nil, errors.New("dkg is not ready")
+	}
+	req, identity, err := verifySignCall(call)
+	if err != nil {
+		return nil, err
+	}
+
+	_, signPub, err := dkg.DeriveSignKey(suites.MustFind("Ed25519"), s.dkg.DkgPubKey.Point(), identity, req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+	return signPub.MarshalBinary()
+}
+
+// handleSignReq 验证应用的签名请求并返回本节点的 nonce 承诺
+func (s *SideChain) handleSignReq(call *model.TeeCall, reqId string, from string) error {
+	fromKey, err := model.PubKeyFromHex(from)
+	if err != nil {
+		return fmt.Errorf("pubkey from hex: %w", err)
+	}
+
+	commit, rerr := s.newSignNonce(call, signNonceKey(from, reqId))
+	if rerr != nil {
+		commit = &model.SignCommit{Error: []byte(rerr.Error())}
+	}
+
+	err = s.p2p.Send(model.SendToNode(fromKey), &model.SignBox{
+		ReqId:   reqId,
+		Payload: &model.SignBox_Commit{Commit: commit},
+	})
+	if err != nil {
+		return fmt.Errorf("send sign commit: %w", err)
+	}
+	return rerr
+}
+
+// newSignNonce 为签名请求生成一次性 nonce
+func (s *SideChain) newSignNonce(call *model.TeeCall, key string) (*model.SignCommit, error) {
+	if s.dkg == nil || s.dkg.DkgKeyShare == nil {
+		return nil, errors.New("dkg is not ready")
+	}
+	if _, _, err := verifySignCall(call); err != nil {
+		return nil, err
+	}
+
+	suite := suites.MustFind("Ed25519")
+	nonce := dkg.NewSignNonce(suite, s.dkg.DkgKeyShare.PriShare().I)
+	hiding, err := nonce.Commit.Hiding.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	binding, err := nonce.Commit.Binding.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	s.signs.mu.Lock()
+	defer s.signs.mu.Unlock()
+	for id, n := range s.signs.nonces {
+		if now.Sub(n.created) > signSessionTimeout {
+			delete(s.signs.nonces, id)
+		}
+	}
+	if _, ok := s.signs.nonces[key]; ok {
+		return nil, errors.New("duplicate sign request")
+	}
+	s.signs.nonces[key] = &signNonce{nonce: nonce, call: call, created: now}
+
+	return &model.SignCommit{
+		Index:   nonce.Commit.Index,
+		Hiding:  hiding,
+		Binding: binding,
+	}, nil
+}
+
+// handleSignRound 使用签名集合生成部分签名，nonce 使用后删除
+func (s *SideChain) handleSignRound(round *model.SignRound, reqId string, from string) error {
+	fromKey, err := model.PubKeyFromHex(from)
+	if err != nil {
+		return fmt.Errorf("pubkey from hex: %w", err)
+	}
+
+	partial, rerr := s.partialSign(round, signNonceKey(from, reqId))
+	if rerr != nil {
+		partial = &model.SignPartial{Error: []byte(rerr.Error())}
+		if s.dkg != nil && s.dkg.DkgKeyShare != nil {
+			partial.Index = s.dkg.DkgKeyShare.PriShare().I
+		}
+	}
+
+	err = s.p2p.Send(model.SendToNode(fromKey), &model.SignBox{
+		ReqId:   reqId,
+		Payload: &model.SignBox_Partial{Partial: partial},
+	})
+	if err != nil {
+		return fmt.Errorf("send sign partial: %w", err)
+	}
+	return rerr
+}
+
+func (s *SideChain) partialSign(round *model.SignRound, key string) (*model.SignPartial, error) {
+	s.signs.mu.Lock()
+	n, ok := s.signs.nonces[key]
+	delete(s.signs.nonces, key)
+	s.signs.mu.Unlock()
+	if !ok || time.Since(n.created) > signSessionTimeout {
+		return nil, errors.New("sign nonce not found")
+	}
+
+	suite := suites.MustFind("Ed25519")
+	req, identity, err := verifySignCall(n.call)
+	if err != nil {
+		return nil, err
+	}
+	tweak, signPub, err := dkg.DeriveSignKey(suite, s.dkg.DkgPubKey.Point(), identity, req.KeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]*dkg.SignCommitment, 0, len(round.Commits))
+	for _, c := range round.Commits {
+		commit, err := decodeSignCommit(suite, c)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, commit)
+	}
+
+	priShare := s.dkg.DkgKeyShare.PriShare()
+	z, err := dkg.ThresholdPartialSign(suite, priShare, tweak, n.nonce, signPub, req.Message, commits)
+	if err != nil {
+		return nil, err
+	}
+	bt, err := z.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SignPartial{Index: priShare.I, Z: bt}, nil
+}
+
+// verifySignCall 验证签名请求的 TEE report，返回请求和应用身份
+// 应用身份为 TEE 的代码度量，同一应用的不同实例使用相同的签名密钥；没有 TEE 时为调用方公钥
+func verifySignCall(call *model.TeeCall) (*model.ThresholdSign, []byte, error) {
+	req := call.GetThresholdSign()
+	if req == nil {
+		return nil, nil, errors.New("not a threshold sign call")
+	}
+	if len(req.Message) == 0 || len(req.Message) > MaxSignMessageSize {
+		return nil, nil, fmt.Errorf("message size must be 1-%d bytes", MaxSignMessageSize)
+	}
+	if len(req.KeyId) > MaxSignKeyIdSize {
+		return nil, nil, fmt.Errorf("key id longer than %d bytes", MaxSignKeyIdSize)
+	}
+
+	now := time.Now().Unix()
+	if call.Time < now-signCallTimeSkew || call.Time > now+signCallTimeSkew {
+		return nil, nil, errors.New("sign call time out of range")
+	}
+	// 没有 TEE 的请求无法证明身份，只在同样没有 TEE 的开发节点上接受
+	if call.TeeType == 9999 && model.TeeType != 9999 {
+		return nil, nil, errors.New("sign call without tee report")
+	}
+
+	result, err := model.VerifyReport(call)
+	if err != nil {
+		return nil, nil, fmt.Errorf("verify report: %w", err)
+	}
+
+	var identity bytes.Buffer
+	identity.Write(binary.BigEndian.AppendUint32(nil, call.TeeType))
+	if len(result.CodeSigner) == 0 && len(result.CodeSignature) == 0 && len(result.CodeProductId) == 0 {
+		identity.Write(call.Caller)
+	} else {
+		for _, part := range [][]byte{result.CodeSigner, result.CodeSignature, result.CodeProductId} {
+			identity.Write(binary.BigEndian.AppendUint32(nil, uint32(len(part))))
+			identity.Write(part)
+		}
+	}
+	return req, identity.Bytes(), nil
+}
+
+// signNonceKey nonce 按发起节点和请求 id 保存，其他节点无法使用本次请求的 nonce
+func signNonceKey(from string, reqId string) string {
+	return from + "_" + reqId
+}
+
+func decodeSignCommit(suite suites.Suite, c *model.SignCommit) (*dkg.SignCommitment, error) {
+	hiding := suite.Point()
+	if err := hiding.UnmarshalBinary(c.Hiding); err != nil {
+		return nil, fmt.Errorf("hiding: %w", err)
+	}
+	binding := suite.Point()
+	if err := binding.UnmarshalBinary(c.Binding); err != nil {
+		return nil, fmt.Errorf("binding: %w", err)
+	}
+	return &dkg.SignCommitment{Index: c.Index, Hiding: hiding, Binding: binding}, nil
+}
@@ -0,0 +1,65 @@
+package sidechain
+
+import (
+	"errors"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+	"github.com/wetee-dao/tee-dsecret/side-chain/pallets/dao"
+)
+
+// 门限策略由治理设置，保存在侧链状态中，在下一次 DKG 共识时随 epoch 生效
+// DKG、DSS、重加密恢复和 hub 同步都使用 DKG 中保存的当前 epoch 策略
+
+const thresholdPolicyKey = "threshold_policy"
+
+// SetThresholdPolicy 由 DAO gov/sudo 账户设置下一个 epoch 的门限策略
+func (s *SideChain) SetThresholdPolicy(caller []byte, policy *model.ThresholdPolicy, txn *model.Txn) error {
+	if !dao.IsSudo(caller, txn) {
+		return errors.New("threshold policy: must call by gov/sudo")
+	}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	util.LogWithYellow("ThresholdPolicy", "set", policy.Num, "/", policy.Den, "quorum", policy.QuorumNum, "/", policy.QuorumDen)
+	return model.TxnSetJson(txn, model.ComboNamespaceKey(GLOABL_STATE, thresholdPolicyKey), policy)
+}
+
+// GetThresholdPolicy 获取治理设置的门限策略，未设置时使用默认策略
+func GetThresholdPolicy() model.ThresholdPolicy {
+	policy, err := model.GetJson[model.ThresholdPolicy](GLOABL_STATE, thresholdPolicyKey)
+	if err != nil || policy == nil {
+		return model.DefaultThresholdPolicy()
+	}
+	return policy.OrDefault()
+}
+
+// CurrentThresholdPolicy 当前 epoch 使用的门限策略、验证节点数量和门限
+func (s *SideChain) CurrentThresholdPolicy() (model.ThresholdPolicy, int, int, error) {
+	if s.dkg == nil {
+		return model.ThresholdPolicy{}, 0, 0, errors.New("dkg is not ready")
+	}
+	return s.dkg.Policy.OrDefault(), len(s.dkg.Nodes), s.dkg.Threshold, nil
+}
+
+// VerifyThresholdPolicyTx 提交前检查治理交易，避免无效交易进入区块
+func VerifyThresholdPolicyTx(tx *model.Tx) error {
+	if err := model.VerifyTxSigner(tx); err != nil {
+		return err
+	}
+	policy := tx.GetThresholdPolicy()
+	if policy == nil {
+		return errors.New("threshold policy: invalid tx type")
+	}
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	txn := model.DBINS.NewTransaction()
+	defer txn.Rollback()
+	if !dao.IsSudo(tx.GetCaller(), txn) {
+		return errors.New("threshold policy: must call by gov/sudo")
+	}
+	return nil
+}
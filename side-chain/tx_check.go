@@ -26,6 +26,11 @@ func (app *SideChain) checkTx(txbt []byte) uint32 {
 		return CodeTypeInvalidTxFormat
 	}
 
+	// 只能由验证节点提交的交易
+	if validatorOnlyTx(innerTx) && !app.isValidatorNode(innerTx.GetCaller()) {
+		return CodeInvalidNode
+	}
+
 	if len(txbox.Org) == 0 {
 		fmt.Println("invalid node1")
 		return CodeInvalidNode
@@ -46,3 +51,12 @@ func (app *SideChain) checkTx(txbt []byte) uint32 {
 
 	return CodeTypeOK
 }
+
+// validatorOnlyTx 只能由当前验证节点使用 p2p key 签名提交的交易
+func validatorOnlyTx(tx *model.Tx) bool {
+	switch tx.Payload.(type) {
+	case *model.Tx_AuditLog, *model.Tx_DealerFault, *model.Tx_ShareRefresh, *model.Tx_KeyGroupEpoch, *model.Tx_SecretMigrate:
+		return true
+	}
+	return false
+}
@@ -12,12 +12,12 @@ import (
 	"github.com/wetee-dao/tee-dsecret/side-chain/pallets/dao"
 )
 
-func (app *SideChain) FinalizeTx(txs [][]byte, txn *model.Txn, height int64, proposer []byte) ([]*abci.ExecTxResult, error) {
+func (app *SideChain) FinalizeTx(txs [][]byte, txn *model.Txn, height int64, blockTime int64, proposer []byte) ([]*abci.ExecTxResult, error) {
 	res := []*abci.ExecTxResult{}
 	hubCalls := make([]*model.HubCall, 0, len(txs))
 	var txIndex int64 = 0
 
-	for _, txbt := range txs {
+	for i, txbt := range txs {
 		txbox := new(model.TxBox)
 		err := protoio.ReadMessage(bytes.NewBuffer(txbt), txbox)
 		if err != nil {
@@ -35,6 +35,13 @@ func (app *SideChain) FinalizeTx(txs [][]byte, txn *model.Txn, height int64, pro
 			return nil, errors.Wrap(err, "verify tx signer")
 		}
 
+		// 只能由验证节点提交的交易，其他节点提交的交易不执行
+		if validatorOnlyTx(tx) && !app.isValidatorNode(tx.GetCaller()) {
+			res = append(res, &abci.ExecTxResult{Code: CodeInvalidNode, Log: "caller is not validator"})
+			continue
+		}
+
+		var events []abci.Event
 		switch p := tx.Payload.(type) {
 		case *model.Tx_Empty:
 			LogWithTime("Empty TX:", p.Empty)
@@ -53,20 +60,33 @@ func (app *SideChain) FinalizeTx(txs [][]byte, txn *model.Txn, height int64, pro
 			if err != nil {
 				return nil, err
 			}
+			err = app.updateEncryptKey(p.EpochEnd, txn) // reshare encryption key
+			if err != nil {
+				return nil, err
+			}
 		case *model.Tx_SyncTxStart: // start hub sync tx
 			txIndex = p.SyncTxStart
 			err = HubSyncStep2(p.SyncTxStart, txn)
 			if err != nil {
 				return nil, err
 			}
+			err = txStartStatus(p.SyncTxStart, blockTime, txn)
+			if err != nil {
+				return nil, err
+			}
 		case *model.Tx_SyncTxEnd: // end hub sync tx
 			err = HubSyncEnd(p.SyncTxEnd, txn)
 			if err != nil {
 				return nil, err
 			}
-			// 所有节点在处理 SyncTxEnd 时统一清理 tx_index_ 储存
+			err = txEndStatus(p.SyncTxEnd, blockTime, txn)
+			if err != nil {
+				return nil, err
+			}
+			// 所有节点在处理 SyncTxEnd 时统一清理 tx_index_ 储存和本节点的重试记录
 			deleteTxIndexStore(p.SyncTxEnd)
-		case *model.Tx_SyncTxRetry: // retry hub sync tx，重新收集签名
+			deleteLocalTxStatus(p.SyncTxEnd)
+		case *model.Tx_SyncTxRetry: // retry hub sync tx，RetryManager 没有足够的部分签名时重新收集签名
 			if app.dkg == nil {
 				LogWithTime("SyncTxRetry", "dkg is nil, skipping retry for txIndex:", p.SyncTxRetry)
 				break
@@ -84,16 +104,18 @@ func (app *SideChain) FinalizeTx(txs [][]byte, txn *model.Txn, height int64, pro
 			_ = app.DeleteSigOfTx(p.SyncTxRetry)
 
 			// 重新发起部分签名收集：本节点向当前 proposer 发送部分签名，其他节点同样会在 FinalizeTx 中发送
-			err = app.sendPartialSign(stored.HubCalls[0].ChainId, p.SyncTxRetry, stored.HubCalls, app.ProposerAddressToNodeKey(proposer))
+			err = app.SendPartialSign(stored.HubCalls[0].ChainId, p.SyncTxRetry, stored.HubCalls, app.ProposerAddressToNodeKey(proposer))
 			if err != nil {
-				return nil, errors.Wrap(err, "SyncTxRetry: sendPartialSign")
+				return nil, errors.Wrap(err, "SyncTxRetry: SendPartialSign")
 			}
 		case *model.Tx_HubCall: // add hub call
-			err := app.finalizeHubCall(p.HubCall, txn)
+			err := app.finalizeHubCall(p.HubCall, height, txn)
 			if err != nil {
 				return nil, err
 			}
-			hubCalls = append(hubCalls, p.HubCall)
+			if hasHubChainCall(p.HubCall) {
+				hubCalls = append(hubCalls, p.HubCall)
+			}
 		case *model.Tx_DaoCall: // DAO 治理/成员/代币/提案/国库
 			caller := tx.GetCaller()
 			if len(caller) == 0 {
@@ -106,16 +128,81 @@ func (app *SideChain) FinalizeTx(txs [][]byte, txn *model.Txn, height int64, pro
 			if err != nil {
 				return nil, err
 			}
+		case *model.Tx_AuditLog: // 重加密审计记录
+			events, err = app.SaveAuditLog(p.AuditLog, height, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SaveAuditLog")
+			}
+		case *model.Tx_DisclosureShare: // 条件解密份额
+			events, err = app.SaveDisclosureShare(p.DisclosureShare, height, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SaveDisclosureShare")
+			}
+		case *model.Tx_EncryptedTx: // 加密交易，按区块中的位置排序
+			err = app.SaveEncryptedTx(p.EncryptedTx, height, uint32(i), txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SaveEncryptedTx")
+			}
+		case *model.Tx_EncryptedTxShare: // 加密交易解密份额
+			err = app.SaveEncryptedTxShare(p.EncryptedTxShare, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SaveEncryptedTxShare")
+			}
+		case *model.Tx_ThresholdPolicy: // 治理设置门限策略
+			err = app.SetThresholdPolicy(tx.GetCaller(), p.ThresholdPolicy, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SetThresholdPolicy")
+			}
+		case *model.Tx_SecretSweep: // 治理设置过期 secret 的清理配置
+			err = app.SetSecretSweepConfig(tx.GetCaller(), p.SecretSweep, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SetSecretSweepConfig")
+			}
+		case *model.Tx_BeaconShare: // 随机数信标份额
+			events, err = app.SaveBeaconShare(p.BeaconShare, height, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SaveBeaconShare")
+			}
+		case *model.Tx_ShareRefresh: // 份额刷新完成
+			events, err = app.SaveShareRefresh(p.ShareRefresh, tx.GetCaller(), height, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SaveShareRefresh")
+			}
+		case *model.Tx_ShareRefreshStart: // 治理发起份额刷新
+			err = app.GovStartShareRefresh(tx.GetCaller(), height, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "GovStartShareRefresh")
+			}
+		case *model.Tx_KeyGroup: // 治理设置密钥组
+			err = app.SetKeyGroup(tx.GetCaller(), p.KeyGroup, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SetKeyGroup")
+			}
+		case *model.Tx_KeyGroupEpoch: // 密钥组 DKG 完成
+			events, err = app.SaveKeyGroupEpoch(p.KeyGroupEpoch, tx.GetCaller(), txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SaveKeyGroupEpoch")
+			}
+		case *model.Tx_DealerFault: // 验证节点报告作恶的 dealer
+			events, err = app.SaveDealerFaults(p.DealerFault, tx.GetCaller(), height, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SaveDealerFaults")
+			}
+		case *model.Tx_SecretMigrate: // 旧 secret 迁移到加密密钥
+			err = app.SaveSecretMigrate(p.SecretMigrate, txn)
+			if err != nil {
+				return nil, errors.Wrap(err, "SaveSecretMigrate")
+			}
 		default:
 			return nil, errors.New("invalid tx type")
 		}
 
-		res = append(res, &abci.ExecTxResult{Code: uint32(abci.CodeTypeOK)})
+		res = append(res, &abci.ExecTxResult{Code: uint32(abci.CodeTypeOK), Events: events})
 	}
 
 	// if hub tx, send partial sign
 	if txIndex > 0 && len(hubCalls) > 0 && app.dkg != nil {
-		err := app.sendPartialSign(hubCalls[0].ChainId, txIndex, hubCalls, app.ProposerAddressToNodeKey(proposer))
+		err := app.SendPartialSign(hubCalls[0].ChainId, txIndex, hubCalls, app.ProposerAddressToNodeKey(proposer))
 		if err != nil {
 			return nil, err
 		}
@@ -123,7 +210,7 @@ func (app *SideChain) FinalizeTx(txs [][]byte, txn *model.Txn, height int64, pro
 	return res, nil
 }
 
-func (app *SideChain) finalizeHubCall(hub *model.HubCall, txn *model.Txn) error {
+func (app *SideChain) finalizeHubCall(hub *model.HubCall, height int64, txn *model.Txn) error {
 	for _, callWrap := range hub.Call {
 		switch tx := callWrap.Tx.(type) {
 		case *model.TeeCall_PodStart:
@@ -133,10 +220,20 @@ func (app *SideChain) finalizeHubCall(hub *model.HubCall, txn *model.Txn) error
 		case *model.TeeCall_UploadSecret:
 			upload := tx.UploadSecret
 			user := types.H160(upload.User)
+			if upload.KeyType != "" {
+				// 节点生成的密钥不能覆盖已有的 secret
+				if err := app.SaveGeneratedSecret(user, upload.Index, upload.KeyType, upload.Data, txn); err != nil {
+					LogWithTime("finalizeHubCall GenerateSecret", err.Error())
+					continue
+				}
+				app.syncPayload(upload.Data)
+				continue
+			}
 			err := app.SaveSecret(user, upload.Index, upload.Data, txn)
 			if err != nil {
 				return errors.Wrap(err, "finalizeHubCall SaveSecret")
 			}
+			app.syncPayload(upload.Data)
 		case *model.TeeCall_InitDisk:
 			initDisk := tx.InitDisk
 			user := types.H160(initDisk.User)
@@ -144,6 +241,46 @@ func (app *SideChain) finalizeHubCall(hub *model.HubCall, txn *model.Txn) error
 			if err != nil {
 				return errors.Wrap(err, "finalizeHubCall InitDisk")
 			}
+		case *model.TeeCall_GrantSecret:
+			grant := tx.GrantSecret
+			if err := grant.VerifyOwner(); err != nil {
+				LogWithTime("finalizeHubCall GrantSecret", err.Error())
+				continue
+			}
+			err := app.SaveGrant(grant, txn)
+			if err != nil {
+				return errors.Wrap(err, "finalizeHubCall GrantSecret")
+			}
+		case *model.TeeCall_RevokeSecret:
+			revoke := tx.RevokeSecret
+			if err := revoke.VerifyOwner(); err != nil {
+				LogWithTime("finalizeHubCall RevokeSecret", err.Error())
+				continue
+			}
+			err := app.RevokeGrant(revoke, txn)
+			if err != nil {
+				return errors.Wrap(err, "finalizeHubCall RevokeSecret")
+			}
+		case *model.TeeCall_SealDisclosure:
+			seal := tx.SealDisclosure
+			if err := seal.VerifyOwner(); err != nil {
+				LogWithTime("finalizeHubCall SealDisclosure", err.Error())
+				continue
+			}
+			err := app.SealDisclosure(seal, txn)
+			if err != nil {
+				return errors.Wrap(err, "finalizeHubCall SealDisclosure")
+			}
+		case *model.TeeCall_ReleaseDisclosure:
+			release := tx.ReleaseDisclosure
+			if err := release.VerifyOwner(); err != nil {
+				LogWithTime("finalizeHubCall ReleaseDisclosure", err.Error())
+				continue
+			}
+			err := app.ReleaseDisclosure(release, height, txn)
+			if err != nil {
+				return errors.Wrap(err, "finalizeHubCall ReleaseDisclosure")
+			}
 		default:
 			return errors.New("finalizeHubCall invalid tx type")
 		}
@@ -22,7 +22,7 @@ type hubCallsStore struct {
 	HubCalls []*model.HubCall `json:"hub_calls"`
 }
 
-// sendPartialSign sends partial signatures of a batch call to a specified proposer.
+// SendPartialSign sends partial signatures of a batch call to a specified proposer.
 // It constructs a batch call from the provided hub calls, partially signs it,
 // and then sends the partial signature to the proposer via P2P.
 //
@@ -30,7 +30,7 @@ type hubCallsStore struct {
 // tx_index - The index of the transaction.
 // hubs - A slice of pointers to model.HubCall objects containing the calls to be batched.
 // proposer - A pointer to a model.PubKey object representing the proposer's public key.
-func (s *SideChain) sendPartialSign(chainId uint32, tx_index int64, hubs []*model.HubCall, proposer *model.PubKey) error {
+func (s *SideChain) SendPartialSign(chainId uint32, tx_index int64, hubs []*model.HubCall, proposer *model.PubKey) error {
 	// Check if the list of hub calls is empty. If so, exit the function early.
 	if len(hubs) == 0 {
 		return errors.New("hubs is empty")
@@ -47,7 +47,7 @@ func (s *SideChain) sendPartialSign(chainId uint32, tx_index int64, hubs []*mode
 	for _, hub := range hubs {
 		// Check if the hub call is nil. If so, log an error and skip to the next iteration.
 		if hub == nil {
-			util.LogWithRed("sendPartialSign", "hub is nil")
+			util.LogWithRed("SendPartialSign", "hub is nil")
 			continue
 		}
 		teeCalls = append(teeCalls, hub.Call...)
@@ -62,6 +62,9 @@ func (s *SideChain) sendPartialSign(chainId uint32, tx_index int64, hubs []*mode
 	calls := make([]types.Call, 0, len(teeCalls))
 	// Iterate through each index call and decode it into a types.Call object.
 	for _, c := range teeCalls {
+		if isSideChainCall(c) {
+			continue
+		}
 		call, err := chain.TEECallToCall(c, s.dkg.DkgPubKey.AccountID())
 		if err != nil {
 			return errors.Wrap(err, "TEECallToHubCall error")
@@ -73,7 +76,7 @@ func (s *SideChain) sendPartialSign(chainId uint32, tx_index int64, hubs []*mode
 	}
 
 	// Get the client for the main chain.
-	client := chains.MainChain.GetClient()
+	client := chains.MainChain
 	// Create a batch call using the decoded calls.
 	call, err := client.BatchCall("batch_all", calls)
 	if err != nil {
@@ -150,8 +153,9 @@ func (s *SideChain) handlePartialSign(msg *model.BlockPartialSign) error {
 	}
 
 	// 5. 检查是否收集到足够的签名
-	if len(sigs) < s.dkg.Threshold+1 || len(sigs) > s.dkg.Threshold+1 {
-		util.LogWithGray("PartialSign", "ALL =", len(sigs), "TH[+1] =", s.dkg.Threshold+1)
+	// 只在刚好达到门限时同步一次
+	if len(sigs) != s.dkg.Threshold {
+		util.LogWithGray("PartialSign", "ALL =", len(sigs), "TH =", s.dkg.Threshold)
 		return nil
 	}
 
@@ -41,12 +41,22 @@ func (s *SideChain) PrepareTx(txs [][]byte, finaltx *[][]byte, height int64, add
 		case *model.Tx_SyncTxRetry:
 			*finaltx = append(*finaltx, txbt)
 		case *model.Tx_HubCall:
-			if addMainChainTx {
+			// 只在侧链执行的调用不需要同步到主链
+			if !hasHubChainCall(tx.GetHubCall()) {
+				*finaltx = append(*finaltx, txbt)
+			} else if addMainChainTx {
 				hubCall := tx.GetHubCall()
 				hubCalls = append(hubCalls, hubCall)
 				hubtx = append(hubtx, txbt)
 			}
-		case *model.Tx_DaoCall:
+		case *model.Tx_DaoCall, *model.Tx_ThresholdPolicy, *model.Tx_ShareRefreshStart, *model.Tx_KeyGroup, *model.Tx_SecretSweep:
+			*finaltx = append(*finaltx, txbt)
+		case *model.Tx_AuditLog, *model.Tx_DealerFault, *model.Tx_SecretMigrate:
+			*finaltx = append(*finaltx, txbt)
+		case *model.Tx_DisclosureShare, *model.Tx_BeaconShare, *model.Tx_ShareRefresh, *model.Tx_KeyGroupEpoch:
+			*finaltx = append(*finaltx, txbt)
+		case *model.Tx_EncryptedTx, *model.Tx_EncryptedTxShare:
+			// 加密交易只能看到密文，按 mempool 顺序打包
 			*finaltx = append(*finaltx, txbt)
 		default:
 			break
@@ -64,8 +74,8 @@ func (s *SideChain) PrepareTx(txs [][]byte, finaltx *[][]byte, height int64, add
 			return
 		}
 
-		if s.dkg.AvailableNodeLen() < s.dkg.Threshold+1 {
-			util.LogWithRed("PrepareTx", "exapect validator node:", s.dkg.Threshold+1, ", got:", s.dkg.AvailableNodeLen())
+		if s.dkg.AvailableNodeLen() < s.dkg.Threshold {
+			util.LogWithRed("PrepareTx", "exapect validator node:", s.dkg.Threshold, ", got:", s.dkg.AvailableNodeLen())
 			time.Sleep(time.Second * 2)
 			return
 		}
@@ -76,6 +86,29 @@ func (s *SideChain) PrepareTx(txs [][]byte, finaltx *[][]byte, height int64, add
 	}
 }
 
+// isSideChainCall 只在侧链执行、不提交到主链的调用
+func isSideChainCall(call *model.TeeCall) bool {
+	switch call.Tx.(type) {
+	case *model.TeeCall_GrantSecret, *model.TeeCall_RevokeSecret, *model.TeeCall_ThresholdSign,
+		*model.TeeCall_SealDisclosure, *model.TeeCall_ReleaseDisclosure:
+		return true
+	}
+	return false
+}
+
+// hasHubChainCall 检查 HubCall 中是否有需要提交到主链的调用
+func hasHubChainCall(hub *model.HubCall) bool {
+	if hub == nil {
+		return false
+	}
+	for _, call := range hub.Call {
+		if call != nil && !isSideChainCall(call) {
+			return true
+		}
+	}
+	return false
+}
+
 // extractCallersFromHubCall 从HubCall中提取所有caller
 func extractCallersFromHubCall(hub *model.HubCall) map[string]bool {
 	callers := make(map[string]bool)
@@ -33,6 +33,19 @@ func (app *SideChain) ProcessTx(txs [][]byte) abci.ProcessProposalStatus {
 		case *model.Tx_Empty:
 		case *model.Tx_HubCall:
 		case *model.Tx_DaoCall:
+		case *model.Tx_AuditLog:
+		case *model.Tx_DisclosureShare:
+		case *model.Tx_EncryptedTx:
+		case *model.Tx_EncryptedTxShare:
+		case *model.Tx_BeaconShare:
+		case *model.Tx_ThresholdPolicy:
+		case *model.Tx_ShareRefresh:
+		case *model.Tx_ShareRefreshStart:
+		case *model.Tx_KeyGroup:
+		case *model.Tx_KeyGroupEpoch:
+		case *model.Tx_DealerFault:
+		case *model.Tx_SecretMigrate:
+		case *model.Tx_SecretSweep:
 		default:
 			fmt.Println("Payload is not set")
 		}
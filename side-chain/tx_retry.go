@@ -0,0 +1,198 @@
+package sidechain
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
+)
+
+const (
+	// 最大重试次数，超过后进入死信状态
+	MaxRetryCount = 10
+	// 初始重试延迟
+	InitialRetryDelay = 5 * time.Second
+	// 最大重试延迟
+	MaxRetryDelay = 5 * time.Minute
+	// 退避因子，每次重试延迟翻倍
+	RetryBackoffFactor = 2.0
+	// 每次检查最近的批量交易数量
+	MaxRetryScan = 100
+)
+
+// RetryManager 重试提交到主链失败的批量交易
+// 不使用定时器，由 PrepareProposal 在每个区块检查，按指数退避重试
+type RetryManager struct {
+	sideChain *SideChain
+	// 同一时间只重试一个批量交易
+	running sync.Mutex
+}
+
+func NewRetryManager(sideChain *SideChain) *RetryManager {
+	return &RetryManager{sideChain: sideChain}
+}
+
+// CheckAndRetryFailedTxs 检查最近的批量交易，重试到达重试时间的失败交易
+func (rm *RetryManager) CheckAndRetryFailedTxs() {
+	if rm.sideChain.dkg == nil {
+		return
+	}
+
+	state, err := GetHubSyncState()
+	if err != nil || state.Going == 0 {
+		return
+	}
+
+	// 已经在重试
+	if !rm.running.TryLock() {
+		return
+	}
+
+	status := nextRetryTx(state, time.Now())
+	if status == nil {
+		rm.running.Unlock()
+		return
+	}
+
+	status.Status = TxStatusRetrying
+	if err := SaveTxStatus(status); err != nil {
+		util.LogWithRed("RetryManager", "SaveTxStatus error:", err)
+		rm.running.Unlock()
+		return
+	}
+
+	util.LogWithYellow("RetryManager", "retrying tx", status.TxIndex, "retry count:", status.RetryCount)
+	go func(txIndex int64) {
+		defer rm.running.Unlock()
+		if err := rm.retrySubmitTx(txIndex, nil); err != nil {
+			util.LogWithRed("RetryManager", "retry failed for tx", txIndex, ":", err)
+			return
+		}
+		util.LogWithGreen("RetryManager", "retry success for tx", txIndex)
+	}(status.TxIndex)
+}
+
+// nextRetryTx 扫描最近的批量交易，超过最大重试次数的失败交易进入死信，
+// 按顺序返回第一个到达重试时间的失败交易，没有时返回 nil
+func nextRetryTx(state *AsyncBatchState, now time.Time) *TxSubmissionStatus {
+	from := max(state.Going-MaxRetryScan+1, 1)
+	var next *TxSubmissionStatus
+	failed := 0
+	for i := from; i <= state.Going; i++ {
+		status, err := LoadTxStatus(i)
+		if err != nil || status == nil {
+			continue
+		}
+
+		// 重试中节点重启，重新进入等待重试
+		if status.Status == TxStatusRetrying && now.Unix()-status.UpdatedAt > int64(MaxRetryDelay/time.Second) {
+			status.Status = TxStatusFailed
+		}
+		if status.Status != TxStatusFailed {
+			continue
+		}
+
+		if !shouldRetry(status) {
+			util.LogWithRed("RetryManager", "tx", i, "exceeded max retry count")
+			status.Status = TxStatusDead
+			status.NextRetryAt = 0
+			_ = SaveTxStatus(status)
+			continue
+		}
+
+		failed++
+		if next == nil && now.Unix() >= status.NextRetryAt {
+			next = status
+		}
+	}
+
+	if failed > 0 {
+		util.LogWithYellow("RetryManager", "found", failed, "failed transactions to retry")
+	}
+	return next
+}
+
+// shouldRetry 失败且未超过最大重试次数，不可重试的错误在 onSyncFailed 中已经进入死信
+func shouldRetry(status *TxSubmissionStatus) bool {
+	return status.Status == TxStatusFailed && status.RetryCount < MaxRetryCount
+}
+
+// calculateRetryDelay 指数退避：InitialRetryDelay * RetryBackoffFactor^retryCount，最大 MaxRetryDelay
+func calculateRetryDelay(retryCount int) time.Duration {
+	delay := float64(InitialRetryDelay) * math.Pow(RetryBackoffFactor, float64(retryCount))
+	if delay > float64(MaxRetryDelay) {
+		delay = float64(MaxRetryDelay)
+	}
+	return time.Duration(delay)
+}
+
+// retrySubmitTx 使用本节点保存的部分签名重新提交，签名不足时提交 SyncTxRetry 让所有节点重新签名
+func (rm *RetryManager) retrySubmitTx(txIndex int64, sigs [][]byte) error {
+	s := rm.sideChain
+	if len(sigs) == 0 {
+		sigList, err := s.SigListOfTx(txIndex)
+		if err != nil {
+			return err
+		}
+		for _, sig := range sigList {
+			sigs = append(sigs, sig.HubSig)
+		}
+	}
+
+	if len(sigs) < s.dkg.Threshold {
+		util.LogWithYellow("RetryManager", "not enough partial sigs, submitting SyncTxRetry", "txIndex:", txIndex)
+		_, err := SubmitTx(&model.Tx{
+			Payload: &model.Tx_SyncTxRetry{
+				SyncTxRetry: txIndex,
+			},
+		})
+		return err
+	}
+
+	return s.SyncToHub(txIndex, sigs[:s.dkg.Threshold])
+}
+
+// onSyncFailed 更新提交失败的状态：可重试的错误按指数退避等待重试，其余进入死信
+func onSyncFailed(txIndex int64, err error) {
+	status, lerr := LoadTxStatus(txIndex)
+	if lerr != nil || status == nil {
+		status = &TxSubmissionStatus{TxIndex: txIndex, CreatedAt: time.Now().Unix()}
+	}
+
+	status.RetryCount++
+	status.LastError = err.Error()
+	if !IsRetryableError(err) || status.RetryCount >= MaxRetryCount {
+		util.LogWithRed("Sync to polkadot hub", "tx", txIndex, "moved to dead letter after", status.RetryCount, "attempts")
+		status.Status = TxStatusDead
+		status.NextRetryAt = 0
+	} else {
+		delay := calculateRetryDelay(status.RetryCount - 1)
+		util.LogWithYellow("Sync to polkadot hub", "retryable error, will retry after", delay.String())
+		status.Status = TxStatusFailed
+		status.NextRetryAt = time.Now().Add(delay).Unix()
+	}
+
+	if serr := SaveTxStatus(status); serr != nil {
+		util.LogWithRed("Sync to polkadot hub", "SaveTxStatus error:", serr)
+	}
+}
+
+// onSyncSubmitted 更新提交成功的状态，等待 SyncTxEnd 打包
+func onSyncSubmitted(txIndex int64) {
+	status, err := LoadTxStatus(txIndex)
+	if err != nil || status == nil {
+		status = &TxSubmissionStatus{TxIndex: txIndex, CreatedAt: time.Now().Unix()}
+	}
+	// SyncTxEnd 已经打包
+	if status.Status == TxStatusFinalized {
+		return
+	}
+
+	status.Status = TxStatusSubmitted
+	status.NextRetryAt = 0
+	if err := SaveTxStatus(status); err != nil {
+		util.LogWithRed("Sync to polkadot hub", "SaveTxStatus error:", err)
+	}
+}
@@ -2,19 +2,27 @@ package sidechain
 
 import (
 	"bytes"
+	"errors"
 
 	abcicli "github.com/cometbft/cometbft/abci/client"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/wetee-dao/tee-dsecret/pkg/model"
+	"github.com/wetee-dao/tee-dsecret/pkg/util"
 )
 
 // Submit tx to sidechain
 func SubmitTx(tx *model.Tx) (*abcicli.ReqRes, error) {
+	// 没有启动 BFT 节点时无法提交，例如进程内的模拟集群
+	if SideChainNode == nil {
+		return nil, errors.New("side chain node is not started")
+	}
 	return SideChainNode.Mempool().CheckTx(GetTxBytes(tx), SideChainNode.NodeInfo().ID())
 }
 
 // Get tx bytes
 func GetTxBytes(tx *model.Tx) []byte {
+	signNodeTx(tx)
+
 	buf := new(bytes.Buffer)
 	abci.WriteMessage(tx, buf)
 
@@ -29,3 +37,23 @@ func GetTxBytes(tx *model.Tx) []byte {
 
 	return boxbuf.Bytes()
 }
+
+// signNodeTx 没有 caller 的交易由本节点发起，使用 p2p key 签名
+func signNodeTx(tx *model.Tx) {
+	if len(tx.Caller) > 0 || p2pSigner == nil {
+		return
+	}
+
+	tx.Caller = P2PKey.Byte()
+	msg, err := model.TxBytesForSigning(tx)
+	if err != nil {
+		util.LogWithRed("signNodeTx", err.Error())
+		return
+	}
+	sig, err := p2pSigner.Sign(msg)
+	if err != nil {
+		util.LogWithRed("signNodeTx", err.Error())
+		return
+	}
+	tx.Signature = sig
+}
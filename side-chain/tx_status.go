@@ -0,0 +1,184 @@
+package sidechain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/chains"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+// TxStatusPrefix 提交到主链的批量交易状态，key 为 tx_status_<txIndex>
+const TxStatusPrefix = "tx_status_"
+
+// TxStatus 批量交易提交到主链的状态
+type TxStatus string
+
+const (
+	// 批量交易已打包（SyncTxStart），等待收集部分签名
+	TxStatusPending TxStatus = "PENDING"
+	// 已提交到主链，等待 SyncTxEnd 打包
+	TxStatusSubmitted TxStatus = "SUBMITTED"
+	// 提交失败，等待重试
+	TxStatusFailed TxStatus = "FAILED"
+	// 正在重试
+	TxStatusRetrying TxStatus = "RETRYING"
+	// SyncTxEnd 已打包，所有节点确认提交成功
+	TxStatusFinalized TxStatus = "FINALIZED"
+	// 死信：不可重试的错误或超过最大重试次数，不再重试，保留 call 和 hubCalls 用于排查
+	TxStatusDead TxStatus = "DEAD"
+)
+
+// TxSubmissionStatus 批量交易的提交状态
+// PENDING 和 FINALIZED 由所有节点在区块中写入 GLOABL_STATE，时间为区块时间，
+// 其余状态是提交交易的节点的重试记录，只写入本节点的 LOCAL_STATE
+type TxSubmissionStatus struct {
+	TxIndex     int64    `json:"tx_index"`
+	Status      TxStatus `json:"status"`
+	RetryCount  int      `json:"retry_count"`
+	LastError   string   `json:"last_error,omitempty"`
+	CreatedAt   int64    `json:"created_at"`
+	UpdatedAt   int64    `json:"updated_at"`
+	NextRetryAt int64    `json:"next_retry_at,omitempty"`
+}
+
+func txStatusKey(txIndex int64) string {
+	return TxStatusPrefix + fmt.Sprint(txIndex)
+}
+
+// SaveTxStatus 保存本节点的重试记录
+func SaveTxStatus(status *TxSubmissionStatus) error {
+	status.UpdatedAt = time.Now().Unix()
+	return model.SetJson(LOCAL_STATE, txStatusKey(status.TxIndex), status)
+}
+
+// LoadTxStatus 读取批量交易状态，已完成时返回区块中的状态，否则优先返回本节点的重试记录，不存在时返回 nil
+func LoadTxStatus(txIndex int64) (*TxSubmissionStatus, error) {
+	status, err := model.GetJson[TxSubmissionStatus](GLOABL_STATE, txStatusKey(txIndex))
+	if err != nil {
+		return nil, err
+	}
+	if status != nil && status.Status == TxStatusFinalized {
+		return status, nil
+	}
+
+	local, err := model.GetJson[TxSubmissionStatus](LOCAL_STATE, txStatusKey(txIndex))
+	if err != nil {
+		return nil, err
+	}
+	if local != nil {
+		return local, nil
+	}
+	return status, nil
+}
+
+// txStartStatus 区块中开始批量交易时写入 PENDING
+func txStartStatus(txIndex int64, blockTime int64, txn *model.Txn) error {
+	return model.TxnSetJson(txn, model.ComboNamespaceKey(GLOABL_STATE, txStatusKey(txIndex)), &TxSubmissionStatus{
+		TxIndex:   txIndex,
+		Status:    TxStatusPending,
+		CreatedAt: blockTime,
+		UpdatedAt: blockTime,
+	})
+}
+
+// txEndStatus 区块中结束批量交易时写入 FINALIZED，并删除重试窗口之外的已完成状态
+func txEndStatus(txIndex int64, blockTime int64, txn *model.Txn) error {
+	key := model.ComboNamespaceKey(GLOABL_STATE, txStatusKey(txIndex))
+	status, err := model.TxnGetJson[TxSubmissionStatus](txn, key)
+	if err != nil {
+		return err
+	}
+	if status == nil {
+		status = &TxSubmissionStatus{TxIndex: txIndex, CreatedAt: blockTime}
+	}
+	status.Status = TxStatusFinalized
+	status.UpdatedAt = blockTime
+	err = model.TxnSetJson(txn, key, status)
+	if err != nil {
+		return err
+	}
+
+	oldKey := model.ComboNamespaceKey(GLOABL_STATE, txStatusKey(txIndex-MaxRetryScan))
+	old, err := model.TxnGetJson[TxSubmissionStatus](txn, oldKey)
+	if err != nil || old == nil || old.Status != TxStatusFinalized {
+		return nil
+	}
+	return txn.Delete(oldKey)
+}
+
+// deleteLocalTxStatus 批量交易完成后删除本节点的重试记录，和 deleteTxIndexStore 一样不经过区块
+func deleteLocalTxStatus(txIndex int64) {
+	_ = model.DeleteKey(LOCAL_STATE, txStatusKey(txIndex))
+}
+
+// GetStuckTxs 获取未完成的批量交易：等待签名，以及本节点记录的失败、重试中和死信
+func GetStuckTxs() ([]*TxSubmissionStatus, error) {
+	list, _, err := model.GetJsonList[TxSubmissionStatus](GLOABL_STATE, TxStatusPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	stuck := make([]*TxSubmissionStatus, 0, len(list))
+	for _, status := range list {
+		if status.Status == TxStatusFinalized {
+			continue
+		}
+		local, err := model.GetJson[TxSubmissionStatus](LOCAL_STATE, txStatusKey(status.TxIndex))
+		if err != nil {
+			return nil, err
+		}
+		if local != nil {
+			status = local
+		}
+		stuck = append(stuck, status)
+	}
+	return stuck, nil
+}
+
+// GetHubSyncState 获取提交到主链的同步进度
+func GetHubSyncState() (*AsyncBatchState, error) {
+	tx, err := model.GetJson[AsyncBatchState](GLOABL_STATE, HubSyncIndexKey)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		tx = &AsyncBatchState{}
+	}
+	return tx, nil
+}
+
+// retryableErrors 主链暂时性的错误：主链实现返回的 chains.ErrTemporary、超时和连接断开
+var retryableErrors = []error{
+	chains.ErrTemporary,
+	context.DeadlineExceeded,
+	io.EOF,
+	io.ErrUnexpectedEOF,
+	net.ErrClosed,
+	syscall.ECONNREFUSED,
+	syscall.ECONNRESET,
+	syscall.EPIPE,
+}
+
+// IsRetryableError 是否是可重试的主链错误：超时、网络和节点暂时不可用
+// 交易格式、签名、权限和余额等错误重试也不会成功
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	for _, retryable := range retryableErrors {
+		if errors.Is(err, retryable) {
+			return true
+		}
+	}
+
+	// 网络错误：连接失败、域名解析失败和读写超时
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
@@ -0,0 +1,149 @@
+package sidechain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wetee-dao/tee-dsecret/pkg/chains/mock"
+	"github.com/wetee-dao/tee-dsecret/pkg/model"
+)
+
+// applyTxStatus 在区块中写入批量交易的状态
+func applyTxStatus(t *testing.T, fn func(txn *model.Txn) error) {
+	txn := model.DBINS.NewTransaction()
+	require.NoError(t, fn(txn))
+	require.NoError(t, txn.Commit())
+}
+
+func loadTxStatus(t *testing.T, txIndex int64) *TxSubmissionStatus {
+	status, err := LoadTxStatus(txIndex)
+	require.NoError(t, err)
+	require.NotNil(t, status)
+	return status
+}
+
+// blockTxStatus 区块中写入的状态
+func blockTxStatus(t *testing.T, txIndex int64) *TxSubmissionStatus {
+	status, err := model.GetJson[TxSubmissionStatus](GLOABL_STATE, txStatusKey(txIndex))
+	require.NoError(t, err)
+	return status
+}
+
+func TestTxStatus(t *testing.T) {
+	openTestDB(t)
+
+	applyTxStatus(t, func(txn *model.Txn) error { return txStartStatus(1, 1000, txn) })
+	status := loadTxStatus(t, 1)
+	require.Equal(t, TxStatusPending, status.Status)
+	require.Equal(t, int64(1000), status.CreatedAt)
+	require.Equal(t, int64(1000), status.UpdatedAt)
+
+	// 可重试的错误等待重试，重试记录不改变区块中的状态
+	onSyncFailed(1, fmt.Errorf("SignAndSubmit: %w", mock.ErrInjected))
+	status = loadTxStatus(t, 1)
+	require.Equal(t, TxStatusFailed, status.Status)
+	require.Equal(t, 1, status.RetryCount)
+	require.Greater(t, status.NextRetryAt, time.Now().Unix())
+	require.Equal(t, &TxSubmissionStatus{TxIndex: 1, Status: TxStatusPending, CreatedAt: 1000, UpdatedAt: 1000}, blockTxStatus(t, 1))
+
+	stuck, err := GetStuckTxs()
+	require.NoError(t, err)
+	require.Len(t, stuck, 1)
+	require.Equal(t, TxStatusFailed, stuck[0].Status)
+
+	onSyncSubmitted(1)
+	require.Equal(t, TxStatusSubmitted, loadTxStatus(t, 1).Status)
+
+	// SyncTxEnd 打包后删除重试记录
+	applyTxStatus(t, func(txn *model.Txn) error { return txEndStatus(1, 1010, txn) })
+	deleteLocalTxStatus(1)
+	status = loadTxStatus(t, 1)
+	require.Equal(t, TxStatusFinalized, status.Status)
+	require.Equal(t, int64(1000), status.CreatedAt)
+	require.Equal(t, int64(1010), status.UpdatedAt)
+	onSyncSubmitted(1)
+	require.Equal(t, TxStatusFinalized, loadTxStatus(t, 1).Status)
+	stuck, err = GetStuckTxs()
+	require.NoError(t, err)
+	require.Empty(t, stuck)
+
+	// 不可重试的错误直接进入死信
+	applyTxStatus(t, func(txn *model.Txn) error { return txStartStatus(2, 1020, txn) })
+	onSyncFailed(2, errors.New("1010: invalid transaction"))
+	status = loadTxStatus(t, 2)
+	require.Equal(t, TxStatusDead, status.Status)
+	require.Zero(t, status.NextRetryAt)
+	require.Equal(t, TxStatusPending, blockTxStatus(t, 2).Status)
+
+	// 重试窗口之外的已完成状态被删除，未完成的保留
+	end := int64(1 + MaxRetryScan)
+	applyTxStatus(t, func(txn *model.Txn) error { return txEndStatus(end, 1030, txn) })
+	applyTxStatus(t, func(txn *model.Txn) error { return txEndStatus(end+1, 1030, txn) })
+	require.Nil(t, blockTxStatus(t, 1))
+	require.NotNil(t, blockTxStatus(t, 2))
+}
+
+func TestIsRetryableError(t *testing.T) {
+	cases := []struct {
+		err       error
+		retryable bool
+	}{
+		{nil, false},
+		{mock.ErrInjected, true},
+		{fmt.Errorf("submit: %w", context.DeadlineExceeded), true},
+		{&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}, true},
+		{&net.DNSError{Err: "no such host", Name: "chain", IsNotFound: true}, true},
+		{fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		// 只按错误类型判断，不匹配错误信息
+		{errors.New("connection timeout"), false},
+		{errors.New("1010: invalid transaction: bad signature"), false},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.retryable, IsRetryableError(c.err), "%v", c.err)
+	}
+}
+
+func TestNextRetryTx(t *testing.T) {
+	openTestDB(t)
+	now := time.Now()
+
+	// 写入本节点的重试记录，不修改 UpdatedAt
+	save := func(status *TxSubmissionStatus) {
+		require.NoError(t, model.SetJson(LOCAL_STATE, txStatusKey(status.TxIndex), status))
+	}
+	save(&TxSubmissionStatus{TxIndex: 1, Status: TxStatusFailed, RetryCount: 1, NextRetryAt: now.Unix() + 60, UpdatedAt: now.Unix()})
+	save(&TxSubmissionStatus{TxIndex: 2, Status: TxStatusFailed, RetryCount: MaxRetryCount, UpdatedAt: now.Unix()})
+	// 重试中节点重启
+	save(&TxSubmissionStatus{TxIndex: 3, Status: TxStatusRetrying, RetryCount: 2, UpdatedAt: now.Add(-2 * MaxRetryDelay).Unix()})
+	save(&TxSubmissionStatus{TxIndex: 4, Status: TxStatusRetrying, RetryCount: 2, UpdatedAt: now.Unix()})
+	state := &AsyncBatchState{Going: 4}
+
+	next := nextRetryTx(state, now)
+	require.NotNil(t, next)
+	require.Equal(t, int64(3), next.TxIndex)
+	require.Equal(t, TxStatusFailed, next.Status)
+
+	// 超过最大重试次数进入死信
+	require.Equal(t, TxStatusDead, loadTxStatus(t, 2).Status)
+	require.Equal(t, TxStatusRetrying, loadTxStatus(t, 4).Status)
+
+	// 按顺序重试第一个到达重试时间的交易
+	next = nextRetryTx(state, now.Add(61*time.Second))
+	require.NotNil(t, next)
+	require.Equal(t, int64(1), next.TxIndex)
+
+	require.Nil(t, nextRetryTx(&AsyncBatchState{Going: 2}, now))
+}
+
+func TestCalculateRetryDelay(t *testing.T) {
+	require.Equal(t, InitialRetryDelay, calculateRetryDelay(0))
+	require.Equal(t, 4*InitialRetryDelay, calculateRetryDelay(2))
+	require.Equal(t, MaxRetryDelay, calculateRetryDelay(MaxRetryCount))
+}
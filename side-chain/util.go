@@ -23,6 +23,8 @@ const (
 
 const (
 	GLOABL_STATE = "G"
+	// 本节点的记录，不在区块中写入，各节点的内容不同
+	LOCAL_STATE = "L"
 )
 
 func LogWithTime(a ...any) {